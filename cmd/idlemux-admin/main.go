@@ -0,0 +1,156 @@
+// Command idlemux-admin runs one-shot maintenance tasks against the video library.
+//
+// Usage:
+//
+//	idlemux-admin backfill
+//	idlemux-admin reconcile [-fix] [-format json|table]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const usage = `usage: idlemux-admin <command> [flags]
+
+commands:
+  backfill   hydrate stored videos with their Mux.com asset state
+  reconcile  report the differences between videos and Mux.com assets
+             -fix            delete orphaned assets and mark broken videos
+             -format string  report format, json or table (default "json")
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	// Logs go to stderr, reports to stdout
+	logger := logrus.New()
+	logger.Formatter = &logrus.JSONFormatter{}
+	logger.Out = os.Stderr
+
+	config := idlemux.AppConfig{
+		MongoURI:       os.Getenv("MONGO_STRING"),
+		MuxTokenID:     os.Getenv("MUX_TOKEN_ID"),
+		MuxTokenSecret: os.Getenv("MUX_TOKEN_SECRET"),
+		MuxKeyID:       os.Getenv("MUX_KEY_ID"),
+		MuxKeySecret:   os.Getenv("MUX_KEY_SECRET"),
+		Namespace:      os.Getenv("IDLEMUX_NAMESPACE"),
+	}
+
+	ctx := context.Background()
+
+	switch os.Args[1] {
+	case "backfill":
+		maintenance := idlemux.NewMaintenance(config, logger)
+
+		report, err := maintenance.Backfill(ctx)
+		if err != nil {
+			logger.Fatal(err)
+		}
+
+		if err := writeJSON(os.Stdout, report); err != nil {
+			logger.Fatal(err)
+		}
+	case "reconcile":
+		flags := flag.NewFlagSet("reconcile", flag.ExitOnError)
+		fix := flags.Bool("fix", false, "delete orphaned assets and mark broken videos")
+		format := flags.String("format", "json", "report format, json or table")
+		flags.Parse(os.Args[2:])
+
+		if *format != "json" && *format != "table" {
+			fmt.Fprint(os.Stderr, usage)
+			os.Exit(2)
+		}
+
+		maintenance := idlemux.NewMaintenance(config, logger)
+
+		report, err := maintenance.Reconcile(ctx, *fix)
+		if err != nil {
+			logger.Fatal(err)
+		}
+
+		if *format == "table" {
+			err = writeReconcileTable(os.Stdout, report)
+		} else {
+			err = writeJSON(os.Stdout, report)
+		}
+		if err != nil {
+			logger.Fatal(err)
+		}
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+// writeJSON writes an indented report
+func writeJSON(w io.Writer, report interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+// writeReconcileTable writes one row per mismatch followed by the totals
+func writeReconcileTable(w io.Writer, report model.ReconcileReport) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "KIND\tVIDEO\tASSET\tDETAIL\tFIXED")
+
+	sections := []struct {
+		kind       string
+		mismatches []model.Mismatch
+	}{
+		{"orphaned asset", report.OrphanedAssets},
+		{"foreign asset", report.ForeignAssets},
+		{"missing asset", report.MissingAssets},
+		{"errored asset", report.ErroredAssets},
+		{"policy mismatch", report.PolicyMismatches},
+	}
+	for _, section := range sections {
+		for _, m := range section.mismatches {
+			fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%t\n", section.kind, dash(m.VideoID), m.AssetID, m.Detail, m.Fixed)
+		}
+	}
+
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(
+		w,
+		"\nassets: %d  videos: %d  orphaned: %d  foreign: %d  missing: %d  errored: %d  policy: %d  fixed: %d  failed: %d\n",
+		report.Assets,
+		report.Videos,
+		len(report.OrphanedAssets),
+		len(report.ForeignAssets),
+		len(report.MissingAssets),
+		len(report.ErroredAssets),
+		len(report.PolicyMismatches),
+		report.Fixed,
+		report.Failed,
+	)
+
+	return err
+}
+
+// dash fills the empty cells of a table
+func dash(value string) string {
+	if len(value) == 0 {
+		return "-"
+	}
+
+	return value
+}
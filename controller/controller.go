@@ -2,27 +2,85 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/javiertlopez/idlemux/model"
 )
 
 // Delivery usecase
 type Delivery interface {
-	GetByID(ctx context.Context, id string) (model.Video, error)
-	List(ctx context.Context, page, limit int) ([]model.Video, error)
+	GetByID(ctx context.Context, id string, ttl time.Duration) (model.Video, error)
+	ChaptersVTT(ctx context.Context, id string) (string, error)
+	Images(ctx context.Context, id string, opts model.ImageOptions) (model.Image, error)
+	PlaybackToken(ctx context.Context, id string, request model.TokenRequest) (model.PlaybackToken, error)
+	List(ctx context.Context, opts model.ListOptions) (model.VideoList, error)
+	ListTrash(ctx context.Context, page, limit int) ([]model.Video, error)
+	ListClips(ctx context.Context, id, cursor string, limit int) (model.VideoList, error)
+	Search(ctx context.Context, query string, limit int) (model.SearchResult, error)
 }
 
 // Ingestion usecase
 type Ingestion interface {
 	Create(ctx context.Context, anyVideo model.Video) (model.Video, error)
+	Update(ctx context.Context, anyVideo model.Video) (model.Video, error)
+	Patch(ctx context.Context, id string, patch []byte) (model.Video, error)
+	Delete(ctx context.Context, id string, permanent bool) error
+	Restore(ctx context.Context, id string) (model.Video, error)
+	RetryIngestion(ctx context.Context, id string) (model.Video, error)
+	EnableDownload(ctx context.Context, id string) (model.Video, error)
+	CreateClip(ctx context.Context, id string, request model.ClipRequest) (model.Video, error)
+}
+
+// Notifications usecase
+type Notifications interface {
+	Receive(ctx context.Context, payload []byte, signature string) error
+}
+
+// Collections usecase
+type Collections interface {
+	Create(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)
+	GetByID(ctx context.Context, id string) (model.Playlist, error)
+	List(ctx context.Context, page, limit int) ([]model.Playlist, error)
+	Update(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)
+	Delete(ctx context.Context, id string) error
+	AddVideo(ctx context.Context, id, videoID string, position int) (model.Playlist, error)
+	RemoveVideo(ctx context.Context, id, videoID string) (model.Playlist, error)
+}
+
+// Uploader usecase
+type Uploader interface {
+	Create(ctx context.Context, request model.UploadRequest) (model.Upload, error)
+	GetByID(ctx context.Context, id string) (model.Upload, error)
+}
+
+// Tracks usecase
+type Tracks interface {
+	Create(ctx context.Context, id string, request model.TrackRequest) (model.Track, error)
+	List(ctx context.Context, id string) ([]model.Track, error)
+	Delete(ctx context.Context, id, trackID string) error
+	Caption(ctx context.Context, id string) (model.Caption, error)
+}
+
+// Broadcaster usecase
+type Broadcaster interface {
+	Create(ctx context.Context, request model.LiveStream) (model.LiveStream, error)
+	GetByID(ctx context.Context, id string) (model.LiveStream, error)
+	List(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error)
+	Disable(ctx context.Context, id string) (model.LiveStream, error)
+	Delete(ctx context.Context, id string) error
 }
 
 // controller struct holds the usecase
 type controller struct {
-	commit    string
-	version   string
-	delivery  Delivery
-	ingestion Ingestion
+	commit        string
+	version       string
+	delivery      Delivery
+	ingestion     Ingestion
+	notifications Notifications
+	collections   Collections
+	uploader      Uploader
+	tracks        Tracks
+	broadcaster   Broadcaster
 }
 
 // New returns a controller
@@ -31,12 +89,22 @@ func New(
 	version string,
 	delivery Delivery,
 	ingestion Ingestion,
+	notifications Notifications,
+	collections Collections,
+	uploader Uploader,
+	tracks Tracks,
+	broadcaster Broadcaster,
 ) controller {
 	return controller{
 		commit: commit,
 
-		version:   version,
-		delivery:  delivery,
-		ingestion: ingestion,
+		version:       version,
+		delivery:      delivery,
+		ingestion:     ingestion,
+		notifications: notifications,
+		collections:   collections,
+		uploader:      uploader,
+		tracks:        tracks,
+		broadcaster:   broadcaster,
 	}
 }
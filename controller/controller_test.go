@@ -13,9 +13,14 @@ func TestNew(t *testing.T) {
 	version := "1.0.0"
 	delivery := NewMockDelivery(t)
 	ingestion := NewMockIngestion(t)
+	notifications := NewMockNotifications(t)
+	collections := NewMockCollections(t)
+	uploader := NewMockUploader(t)
+	tracks := NewMockTracks(t)
+	broadcaster := NewMockBroadcaster(t)
 
 	// Act
-	ctrl := New(commit, version, delivery, ingestion)
+	ctrl := New(commit, version, delivery, ingestion, notifications, collections, uploader, tracks, broadcaster)
 
 	// Assert
 	assert.NotNil(t, ctrl)
@@ -23,6 +28,11 @@ func TestNew(t *testing.T) {
 	assert.Equal(t, version, ctrl.version)
 	assert.Equal(t, delivery, ctrl.delivery)
 	assert.Equal(t, ingestion, ctrl.ingestion)
+	assert.Equal(t, notifications, ctrl.notifications)
+	assert.Equal(t, collections, ctrl.collections)
+	assert.Equal(t, uploader, ctrl.uploader)
+	assert.Equal(t, tracks, ctrl.tracks)
+	assert.Equal(t, broadcaster, ctrl.broadcaster)
 }
 
 // MockDeliveryWithFields is used to expose fields for test assertions
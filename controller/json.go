@@ -14,9 +14,7 @@ type Response struct {
 // JSONResponse writter
 func JSONResponse(w http.ResponseWriter, code int, response interface{}) {
 	// Set CORS headers
-	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	corsHeaders(w)
 
 	// Convert our interface to JSON
 	output, err := json.Marshal(response)
@@ -35,3 +33,16 @@ func JSONResponse(w http.ResponseWriter, code int, response interface{}) {
 	w.WriteHeader(code)
 	w.Write(output)
 }
+
+// NoContentResponse writes a 204 without body
+func NoContentResponse(w http.ResponseWriter) {
+	corsHeaders(w)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// corsHeaders sets the CORS headers shared by every response
+func corsHeaders(w http.ResponseWriter) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, GET, PUT, PATCH, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Idempotency-Key")
+}
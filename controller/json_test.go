@@ -47,3 +47,17 @@ func TestJSONResponse(t *testing.T) {
 		assert.Contains(t, w.Body.String(), "Internal server error")
 	})
 }
+
+// TestNoContentResponse tests the NoContentResponse helper function
+func TestNoContentResponse(t *testing.T) {
+	// Arrange
+	w := httptest.NewRecorder()
+
+	// Act
+	NoContentResponse(w)
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Body.String())
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// CreateLiveStream controller creates a live stream, the response carries its stream key
+func (c controller) CreateLiveStream(w http.ResponseWriter, r *http.Request) {
+	var request model.LiveStream
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&request); err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+	defer r.Body.Close()
+
+	response, err := c.broadcaster.Create(r.Context(), request)
+	if err != nil {
+		if err == errorcodes.ErrLiveStreamsDisabled {
+			JSONResponse(
+				w, http.StatusServiceUnavailable,
+				Response{
+					Message: "Service unavailable",
+					Status:  http.StatusServiceUnavailable,
+				},
+			)
+			return
+		}
+
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusCreated,
+		response,
+	)
+}
+
+// GetLiveStream controller returns a live stream with its stream key
+func (c controller) GetLiveStream(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	response, err := c.broadcaster.GetByID(r.Context(), id)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// ListLiveStreams controller returns a page of the live streams, with the
+// cursor and limit query parameters and the Link headers of the video list
+func (c controller) ListLiveStreams(w http.ResponseWriter, r *http.Request) {
+	cursor, limit := cursorPagination(r)
+	list, err := c.broadcaster.List(r.Context(), cursor, limit)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	setLinks(w, r, list.NextCursor, limit)
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		list,
+	)
+}
+
+// DisableLiveStream controller stops a live stream from accepting broadcasts
+func (c controller) DisableLiveStream(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	response, err := c.broadcaster.Disable(r.Context(), id)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// DeleteLiveStream controller removes a live stream, the videos of its recordings are kept
+func (c controller) DeleteLiveStream(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if err := c.broadcaster.Delete(r.Context(), id); err != nil {
+		updateError(w, err)
+		return
+	}
+
+	NoContentResponse(w)
+}
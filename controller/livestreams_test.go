@@ -0,0 +1,207 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const liveStreamID = "ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag"
+
+func TestLiveStreamController_CreateLiveStream(t *testing.T) {
+	stream := model.LiveStream{ID: liveStreamID, Title: "Weekly webinar", Status: "idle", StreamKey: "key"}
+	request := model.LiveStream{Title: "Weekly webinar", Description: "Britpop 101", Policy: "signed"}
+
+	tests := []struct {
+		name         string
+		body         string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", `{"title":"Weekly webinar","description":"Britpop 101","policy":"signed"}`, true, nil, http.StatusCreated, `{"id":"ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag","title":"Weekly webinar","status":"idle","stream_key":"key"}`},
+		{"Bad body", `{"title":`, false, nil, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Unprocessable", `{"title":"Weekly webinar","description":"Britpop 101","policy":"signed"}`, true, errorcodes.ErrVideoUnprocessable, http.StatusUnprocessableEntity, `{"message":"Unprocessable entity","status":422}`},
+		{"No secret", `{"title":"Weekly webinar","description":"Britpop 101","policy":"signed"}`, true, errorcodes.ErrLiveStreamsDisabled, http.StatusServiceUnavailable, `{"message":"Service unavailable","status":503}`},
+		{"Internal error", `{"title":"Weekly webinar","description":"Britpop 101","policy":"signed"}`, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broadcaster := NewMockBroadcaster(t)
+			controller := &controller{
+				broadcaster: broadcaster,
+			}
+
+			r, _ := http.NewRequest("POST", "/livestreams", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			if tt.callUsecase {
+				broadcaster.On("Create", r.Context(), request).Return(stream, tt.wantedError)
+			}
+
+			controller.CreateLiveStream(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestLiveStreamController_GetLiveStream(t *testing.T) {
+	stream := model.LiveStream{ID: liveStreamID, Status: "active", StreamKey: "key"}
+
+	tests := []struct {
+		name         string
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", nil, http.StatusOK, `{"id":"ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag","status":"active","stream_key":"key"}`},
+		{"Not found", errorcodes.ErrLiveStreamNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broadcaster := NewMockBroadcaster(t)
+			controller := &controller{
+				broadcaster: broadcaster,
+			}
+
+			r, _ := http.NewRequest("GET", "/livestreams/abcd", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": liveStreamID,
+			})
+
+			broadcaster.On("GetByID", r.Context(), liveStreamID).Return(stream, tt.wantedError)
+
+			controller.GetLiveStream(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestLiveStreamController_ListLiveStreams(t *testing.T) {
+	streams := model.LiveStreamList{Items: []model.LiveStream{{ID: liveStreamID, Title: "Weekly webinar"}}}
+	next := model.LiveStreamList{Items: streams.Items, NextCursor: "next"}
+
+	tests := []struct {
+		name         string
+		query        string
+		cursor       string
+		limit        int
+		list         model.LiveStreamList
+		wantedError  error
+		expectedCode int
+		expectedBody string
+		expectedLink string
+	}{
+		{"Success", "", "", 10, streams, nil, http.StatusOK, `{"items":[{"id":"ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag","title":"Weekly webinar"}]}`, `</livestreams?limit=10>; rel="first"`},
+		{"Next page", "?cursor=abc&limit=5", "abc", 5, next, nil, http.StatusOK, `{"items":[{"id":"ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag","title":"Weekly webinar"}],"next_cursor":"next"}`, `</livestreams?limit=5>; rel="first", </livestreams?cursor=next&limit=5>; rel="next"`},
+		{"Limit capped", "?limit=500", "", 100, streams, nil, http.StatusOK, `{"items":[{"id":"ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag","title":"Weekly webinar"}]}`, `</livestreams?limit=100>; rel="first"`},
+		{"Invalid cursor", "?cursor=bad", "bad", 10, model.LiveStreamList{}, errorcodes.ErrInvalidCursor, http.StatusBadRequest, `{"message":"Bad request","status":400}`, ""},
+		{"Internal error", "", "", 10, model.LiveStreamList{}, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broadcaster := NewMockBroadcaster(t)
+			controller := &controller{
+				broadcaster: broadcaster,
+			}
+
+			r, _ := http.NewRequest("GET", "/livestreams"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			broadcaster.On("List", r.Context(), tt.cursor, tt.limit).Return(tt.list, tt.wantedError)
+
+			controller.ListLiveStreams(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+			assert.Equal(t, tt.expectedLink, w.Header().Get("Link"), "Link header should match")
+		})
+	}
+}
+
+func TestLiveStreamController_DisableLiveStream(t *testing.T) {
+	stream := model.LiveStream{ID: liveStreamID, Status: "disabled"}
+
+	tests := []struct {
+		name         string
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", nil, http.StatusOK, `{"id":"ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag","status":"disabled"}`},
+		{"Not found", errorcodes.ErrLiveStreamNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broadcaster := NewMockBroadcaster(t)
+			controller := &controller{
+				broadcaster: broadcaster,
+			}
+
+			r, _ := http.NewRequest("POST", "/livestreams/abcd/disable", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": liveStreamID,
+			})
+
+			broadcaster.On("Disable", r.Context(), liveStreamID).Return(stream, tt.wantedError)
+
+			controller.DisableLiveStream(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestLiveStreamController_DeleteLiveStream(t *testing.T) {
+	tests := []struct {
+		name         string
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", nil, http.StatusNoContent, ""},
+		{"Not found", errorcodes.ErrLiveStreamNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broadcaster := NewMockBroadcaster(t)
+			controller := &controller{
+				broadcaster: broadcaster,
+			}
+
+			r, _ := http.NewRequest("DELETE", "/livestreams/abcd", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": liveStreamID,
+			})
+
+			broadcaster.On("Delete", r.Context(), liveStreamID).Return(tt.wantedError)
+
+			controller.DeleteLiveStream(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
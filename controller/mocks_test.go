@@ -6,18 +6,19 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/javiertlopez/idlemux/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockDelivery creates a new instance of MockDelivery. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockBroadcaster creates a new instance of MockBroadcaster. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockDelivery(t interface {
+func NewMockBroadcaster(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockDelivery {
-	mock := &MockDelivery{}
+}) *MockBroadcaster {
+	mock := &MockBroadcaster{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -25,36 +26,225 @@ func NewMockDelivery(t interface {
 	return mock
 }
 
-// MockDelivery is an autogenerated mock type for the Delivery type
-type MockDelivery struct {
+// MockBroadcaster is an autogenerated mock type for the Broadcaster type
+type MockBroadcaster struct {
 	mock.Mock
 }
 
-type MockDelivery_Expecter struct {
+type MockBroadcaster_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockDelivery) EXPECT() *MockDelivery_Expecter {
-	return &MockDelivery_Expecter{mock: &_m.Mock}
+func (_m *MockBroadcaster) EXPECT() *MockBroadcaster_Expecter {
+	return &MockBroadcaster_Expecter{mock: &_m.Mock}
 }
 
-// GetByID provides a mock function for the type MockDelivery
-func (_mock *MockDelivery) GetByID(ctx context.Context, id string) (model.Video, error) {
+// Create provides a mock function for the type MockBroadcaster
+func (_mock *MockBroadcaster) Create(ctx context.Context, request model.LiveStream) (model.LiveStream, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.LiveStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.LiveStream) (model.LiveStream, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.LiveStream) model.LiveStream); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(model.LiveStream)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.LiveStream) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBroadcaster_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockBroadcaster_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request model.LiveStream
+func (_e *MockBroadcaster_Expecter) Create(ctx interface{}, request interface{}) *MockBroadcaster_Create_Call {
+	return &MockBroadcaster_Create_Call{Call: _e.mock.On("Create", ctx, request)}
+}
+
+func (_c *MockBroadcaster_Create_Call) Run(run func(ctx context.Context, request model.LiveStream)) *MockBroadcaster_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.LiveStream
+		if args[1] != nil {
+			arg1 = args[1].(model.LiveStream)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBroadcaster_Create_Call) Return(liveStream model.LiveStream, err error) *MockBroadcaster_Create_Call {
+	_c.Call.Return(liveStream, err)
+	return _c
+}
+
+func (_c *MockBroadcaster_Create_Call) RunAndReturn(run func(ctx context.Context, request model.LiveStream) (model.LiveStream, error)) *MockBroadcaster_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockBroadcaster
+func (_mock *MockBroadcaster) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBroadcaster_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBroadcaster_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockBroadcaster_Expecter) Delete(ctx interface{}, id interface{}) *MockBroadcaster_Delete_Call {
+	return &MockBroadcaster_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockBroadcaster_Delete_Call) Run(run func(ctx context.Context, id string)) *MockBroadcaster_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBroadcaster_Delete_Call) Return(err error) *MockBroadcaster_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBroadcaster_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockBroadcaster_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Disable provides a mock function for the type MockBroadcaster
+func (_mock *MockBroadcaster) Disable(ctx context.Context, id string) (model.LiveStream, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disable")
+	}
+
+	var r0 model.LiveStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.LiveStream, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.LiveStream); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.LiveStream)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBroadcaster_Disable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disable'
+type MockBroadcaster_Disable_Call struct {
+	*mock.Call
+}
+
+// Disable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockBroadcaster_Expecter) Disable(ctx interface{}, id interface{}) *MockBroadcaster_Disable_Call {
+	return &MockBroadcaster_Disable_Call{Call: _e.mock.On("Disable", ctx, id)}
+}
+
+func (_c *MockBroadcaster_Disable_Call) Run(run func(ctx context.Context, id string)) *MockBroadcaster_Disable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBroadcaster_Disable_Call) Return(liveStream model.LiveStream, err error) *MockBroadcaster_Disable_Call {
+	_c.Call.Return(liveStream, err)
+	return _c
+}
+
+func (_c *MockBroadcaster_Disable_Call) RunAndReturn(run func(ctx context.Context, id string) (model.LiveStream, error)) *MockBroadcaster_Disable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockBroadcaster
+func (_mock *MockBroadcaster) GetByID(ctx context.Context, id string) (model.LiveStream, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
 	}
 
-	var r0 model.Video
+	var r0 model.LiveStream
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.LiveStream, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.LiveStream); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(model.Video)
+		r0 = ret.Get(0).(model.LiveStream)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = returnFunc(ctx, id)
@@ -64,19 +254,19 @@ func (_mock *MockDelivery) GetByID(ctx context.Context, id string) (model.Video,
 	return r0, r1
 }
 
-// MockDelivery_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockDelivery_GetByID_Call struct {
+// MockBroadcaster_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockBroadcaster_GetByID_Call struct {
 	*mock.Call
 }
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockDelivery_Expecter) GetByID(ctx interface{}, id interface{}) *MockDelivery_GetByID_Call {
-	return &MockDelivery_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockBroadcaster_Expecter) GetByID(ctx interface{}, id interface{}) *MockBroadcaster_GetByID_Call {
+	return &MockBroadcaster_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *MockDelivery_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockDelivery_GetByID_Call {
+func (_c *MockBroadcaster_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockBroadcaster_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -94,66 +284,64 @@ func (_c *MockDelivery_GetByID_Call) Run(run func(ctx context.Context, id string
 	return _c
 }
 
-func (_c *MockDelivery_GetByID_Call) Return(video model.Video, err error) *MockDelivery_GetByID_Call {
-	_c.Call.Return(video, err)
+func (_c *MockBroadcaster_GetByID_Call) Return(liveStream model.LiveStream, err error) *MockBroadcaster_GetByID_Call {
+	_c.Call.Return(liveStream, err)
 	return _c
 }
 
-func (_c *MockDelivery_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockDelivery_GetByID_Call {
+func (_c *MockBroadcaster_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.LiveStream, error)) *MockBroadcaster_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockDelivery
-func (_mock *MockDelivery) List(ctx context.Context, page int, limit int) ([]model.Video, error) {
-	ret := _mock.Called(ctx, page, limit)
+// List provides a mock function for the type MockBroadcaster
+func (_mock *MockBroadcaster) List(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error) {
+	ret := _mock.Called(ctx, cursor, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
 	}
 
-	var r0 []model.Video
+	var r0 model.LiveStreamList
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]model.Video, error)); ok {
-		return returnFunc(ctx, page, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (model.LiveStreamList, error)); ok {
+		return returnFunc(ctx, cursor, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []model.Video); ok {
-		r0 = returnFunc(ctx, page, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) model.LiveStreamList); ok {
+		r0 = returnFunc(ctx, cursor, limit)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Video)
-		}
+		r0 = ret.Get(0).(model.LiveStreamList)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
-		r1 = returnFunc(ctx, page, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, cursor, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDelivery_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockDelivery_List_Call struct {
+// MockBroadcaster_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockBroadcaster_List_Call struct {
 	*mock.Call
 }
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
+//   - cursor string
 //   - limit int
-func (_e *MockDelivery_Expecter) List(ctx interface{}, page interface{}, limit interface{}) *MockDelivery_List_Call {
-	return &MockDelivery_List_Call{Call: _e.mock.On("List", ctx, page, limit)}
+func (_e *MockBroadcaster_Expecter) List(ctx interface{}, cursor interface{}, limit interface{}) *MockBroadcaster_List_Call {
+	return &MockBroadcaster_List_Call{Call: _e.mock.On("List", ctx, cursor, limit)}
 }
 
-func (_c *MockDelivery_List_Call) Run(run func(ctx context.Context, page int, limit int)) *MockDelivery_List_Call {
+func (_c *MockBroadcaster_List_Call) Run(run func(ctx context.Context, cursor string, limit int)) *MockBroadcaster_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(string)
 		}
 		var arg2 int
 		if args[2] != nil {
@@ -168,23 +356,23 @@ func (_c *MockDelivery_List_Call) Run(run func(ctx context.Context, page int, li
 	return _c
 }
 
-func (_c *MockDelivery_List_Call) Return(videos []model.Video, err error) *MockDelivery_List_Call {
-	_c.Call.Return(videos, err)
+func (_c *MockBroadcaster_List_Call) Return(liveStreamList model.LiveStreamList, err error) *MockBroadcaster_List_Call {
+	_c.Call.Return(liveStreamList, err)
 	return _c
 }
 
-func (_c *MockDelivery_List_Call) RunAndReturn(run func(ctx context.Context, page int, limit int) ([]model.Video, error)) *MockDelivery_List_Call {
+func (_c *MockBroadcaster_List_Call) RunAndReturn(run func(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error)) *MockBroadcaster_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIngestion creates a new instance of MockIngestion. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCollections creates a new instance of MockCollections. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockIngestion(t interface {
+func NewMockCollections(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockIngestion {
-	mock := &MockIngestion{}
+}) *MockCollections {
+	mock := &MockCollections{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -192,66 +380,144 @@ func NewMockIngestion(t interface {
 	return mock
 }
 
-// MockIngestion is an autogenerated mock type for the Ingestion type
-type MockIngestion struct {
+// MockCollections is an autogenerated mock type for the Collections type
+type MockCollections struct {
 	mock.Mock
 }
 
-type MockIngestion_Expecter struct {
+type MockCollections_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockIngestion) EXPECT() *MockIngestion_Expecter {
-	return &MockIngestion_Expecter{mock: &_m.Mock}
+func (_m *MockCollections) EXPECT() *MockCollections_Expecter {
+	return &MockCollections_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockIngestion
-func (_mock *MockIngestion) Create(ctx context.Context, anyVideo model.Video) (model.Video, error) {
-	ret := _mock.Called(ctx, anyVideo)
+// AddVideo provides a mock function for the type MockCollections
+func (_mock *MockCollections) AddVideo(ctx context.Context, id string, videoID string, position int) (model.Playlist, error) {
+	ret := _mock.Called(ctx, id, videoID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVideo")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) (model.Playlist, error)); ok {
+		return returnFunc(ctx, id, videoID, position)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) model.Playlist); ok {
+		r0 = returnFunc(ctx, id, videoID, position)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = returnFunc(ctx, id, videoID, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollections_AddVideo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVideo'
+type MockCollections_AddVideo_Call struct {
+	*mock.Call
+}
+
+// AddVideo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - videoID string
+//   - position int
+func (_e *MockCollections_Expecter) AddVideo(ctx interface{}, id interface{}, videoID interface{}, position interface{}) *MockCollections_AddVideo_Call {
+	return &MockCollections_AddVideo_Call{Call: _e.mock.On("AddVideo", ctx, id, videoID, position)}
+}
+
+func (_c *MockCollections_AddVideo_Call) Run(run func(ctx context.Context, id string, videoID string, position int)) *MockCollections_AddVideo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollections_AddVideo_Call) Return(playlist model.Playlist, err error) *MockCollections_AddVideo_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockCollections_AddVideo_Call) RunAndReturn(run func(ctx context.Context, id string, videoID string, position int) (model.Playlist, error)) *MockCollections_AddVideo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockCollections
+func (_mock *MockCollections) Create(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	ret := _mock.Called(ctx, anyPlaylist)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
-	var r0 model.Video
+	var r0 model.Playlist
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) (model.Video, error)); ok {
-		return returnFunc(ctx, anyVideo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) (model.Playlist, error)); ok {
+		return returnFunc(ctx, anyPlaylist)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) model.Video); ok {
-		r0 = returnFunc(ctx, anyVideo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) model.Playlist); ok {
+		r0 = returnFunc(ctx, anyPlaylist)
 	} else {
-		r0 = ret.Get(0).(model.Video)
+		r0 = ret.Get(0).(model.Playlist)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video) error); ok {
-		r1 = returnFunc(ctx, anyVideo)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Playlist) error); ok {
+		r1 = returnFunc(ctx, anyPlaylist)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIngestion_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIngestion_Create_Call struct {
+// MockCollections_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCollections_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - anyVideo model.Video
-func (_e *MockIngestion_Expecter) Create(ctx interface{}, anyVideo interface{}) *MockIngestion_Create_Call {
-	return &MockIngestion_Create_Call{Call: _e.mock.On("Create", ctx, anyVideo)}
+//   - anyPlaylist model.Playlist
+func (_e *MockCollections_Expecter) Create(ctx interface{}, anyPlaylist interface{}) *MockCollections_Create_Call {
+	return &MockCollections_Create_Call{Call: _e.mock.On("Create", ctx, anyPlaylist)}
 }
 
-func (_c *MockIngestion_Create_Call) Run(run func(ctx context.Context, anyVideo model.Video)) *MockIngestion_Create_Call {
+func (_c *MockCollections_Create_Call) Run(run func(ctx context.Context, anyPlaylist model.Playlist)) *MockCollections_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 model.Video
+		var arg1 model.Playlist
 		if args[1] != nil {
-			arg1 = args[1].(model.Video)
+			arg1 = args[1].(model.Playlist)
 		}
 		run(
 			arg0,
@@ -261,12 +527,2055 @@ func (_c *MockIngestion_Create_Call) Run(run func(ctx context.Context, anyVideo
 	return _c
 }
 
-func (_c *MockIngestion_Create_Call) Return(video model.Video, err error) *MockIngestion_Create_Call {
-	_c.Call.Return(video, err)
+func (_c *MockCollections_Create_Call) Return(playlist model.Playlist, err error) *MockCollections_Create_Call {
+	_c.Call.Return(playlist, err)
 	return _c
 }
 
-func (_c *MockIngestion_Create_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video) (model.Video, error)) *MockIngestion_Create_Call {
+func (_c *MockCollections_Create_Call) RunAndReturn(run func(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)) *MockCollections_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockCollections
+func (_mock *MockCollections) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCollections_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCollections_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCollections_Expecter) Delete(ctx interface{}, id interface{}) *MockCollections_Delete_Call {
+	return &MockCollections_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockCollections_Delete_Call) Run(run func(ctx context.Context, id string)) *MockCollections_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollections_Delete_Call) Return(err error) *MockCollections_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCollections_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockCollections_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockCollections
+func (_mock *MockCollections) GetByID(ctx context.Context, id string) (model.Playlist, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Playlist, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Playlist); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollections_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCollections_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCollections_Expecter) GetByID(ctx interface{}, id interface{}) *MockCollections_GetByID_Call {
+	return &MockCollections_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockCollections_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockCollections_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollections_GetByID_Call) Return(playlist model.Playlist, err error) *MockCollections_GetByID_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockCollections_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Playlist, error)) *MockCollections_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockCollections
+func (_mock *MockCollections) List(ctx context.Context, page int, limit int) ([]model.Playlist, error) {
+	ret := _mock.Called(ctx, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]model.Playlist, error)); ok {
+		return returnFunc(ctx, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []model.Playlist); ok {
+		r0 = returnFunc(ctx, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Playlist)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollections_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCollections_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - limit int
+func (_e *MockCollections_Expecter) List(ctx interface{}, page interface{}, limit interface{}) *MockCollections_List_Call {
+	return &MockCollections_List_Call{Call: _e.mock.On("List", ctx, page, limit)}
+}
+
+func (_c *MockCollections_List_Call) Run(run func(ctx context.Context, page int, limit int)) *MockCollections_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollections_List_Call) Return(playlists []model.Playlist, err error) *MockCollections_List_Call {
+	_c.Call.Return(playlists, err)
+	return _c
+}
+
+func (_c *MockCollections_List_Call) RunAndReturn(run func(ctx context.Context, page int, limit int) ([]model.Playlist, error)) *MockCollections_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveVideo provides a mock function for the type MockCollections
+func (_mock *MockCollections) RemoveVideo(ctx context.Context, id string, videoID string) (model.Playlist, error) {
+	ret := _mock.Called(ctx, id, videoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveVideo")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Playlist, error)); ok {
+		return returnFunc(ctx, id, videoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Playlist); ok {
+		r0 = returnFunc(ctx, id, videoID)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, videoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollections_RemoveVideo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveVideo'
+type MockCollections_RemoveVideo_Call struct {
+	*mock.Call
+}
+
+// RemoveVideo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - videoID string
+func (_e *MockCollections_Expecter) RemoveVideo(ctx interface{}, id interface{}, videoID interface{}) *MockCollections_RemoveVideo_Call {
+	return &MockCollections_RemoveVideo_Call{Call: _e.mock.On("RemoveVideo", ctx, id, videoID)}
+}
+
+func (_c *MockCollections_RemoveVideo_Call) Run(run func(ctx context.Context, id string, videoID string)) *MockCollections_RemoveVideo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollections_RemoveVideo_Call) Return(playlist model.Playlist, err error) *MockCollections_RemoveVideo_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockCollections_RemoveVideo_Call) RunAndReturn(run func(ctx context.Context, id string, videoID string) (model.Playlist, error)) *MockCollections_RemoveVideo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockCollections
+func (_mock *MockCollections) Update(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	ret := _mock.Called(ctx, anyPlaylist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) (model.Playlist, error)); ok {
+		return returnFunc(ctx, anyPlaylist)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) model.Playlist); ok {
+		r0 = returnFunc(ctx, anyPlaylist)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Playlist) error); ok {
+		r1 = returnFunc(ctx, anyPlaylist)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollections_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCollections_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyPlaylist model.Playlist
+func (_e *MockCollections_Expecter) Update(ctx interface{}, anyPlaylist interface{}) *MockCollections_Update_Call {
+	return &MockCollections_Update_Call{Call: _e.mock.On("Update", ctx, anyPlaylist)}
+}
+
+func (_c *MockCollections_Update_Call) Run(run func(ctx context.Context, anyPlaylist model.Playlist)) *MockCollections_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Playlist
+		if args[1] != nil {
+			arg1 = args[1].(model.Playlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollections_Update_Call) Return(playlist model.Playlist, err error) *MockCollections_Update_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockCollections_Update_Call) RunAndReturn(run func(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)) *MockCollections_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDelivery creates a new instance of MockDelivery. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDelivery(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDelivery {
+	mock := &MockDelivery{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDelivery is an autogenerated mock type for the Delivery type
+type MockDelivery struct {
+	mock.Mock
+}
+
+type MockDelivery_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDelivery) EXPECT() *MockDelivery_Expecter {
+	return &MockDelivery_Expecter{mock: &_m.Mock}
+}
+
+// ChaptersVTT provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) ChaptersVTT(ctx context.Context, id string) (string, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChaptersVTT")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_ChaptersVTT_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChaptersVTT'
+type MockDelivery_ChaptersVTT_Call struct {
+	*mock.Call
+}
+
+// ChaptersVTT is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDelivery_Expecter) ChaptersVTT(ctx interface{}, id interface{}) *MockDelivery_ChaptersVTT_Call {
+	return &MockDelivery_ChaptersVTT_Call{Call: _e.mock.On("ChaptersVTT", ctx, id)}
+}
+
+func (_c *MockDelivery_ChaptersVTT_Call) Run(run func(ctx context.Context, id string)) *MockDelivery_ChaptersVTT_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_ChaptersVTT_Call) Return(s string, err error) *MockDelivery_ChaptersVTT_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockDelivery_ChaptersVTT_Call) RunAndReturn(run func(ctx context.Context, id string) (string, error)) *MockDelivery_ChaptersVTT_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) GetByID(ctx context.Context, id string, ttl time.Duration) (model.Video, error) {
+	ret := _mock.Called(ctx, id, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) (model.Video, error)); ok {
+		return returnFunc(ctx, id, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration) model.Video); ok {
+		r0 = returnFunc(ctx, id, ttl)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, id, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockDelivery_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - ttl time.Duration
+func (_e *MockDelivery_Expecter) GetByID(ctx interface{}, id interface{}, ttl interface{}) *MockDelivery_GetByID_Call {
+	return &MockDelivery_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, ttl)}
+}
+
+func (_c *MockDelivery_GetByID_Call) Run(run func(ctx context.Context, id string, ttl time.Duration)) *MockDelivery_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_GetByID_Call) Return(video model.Video, err error) *MockDelivery_GetByID_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockDelivery_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string, ttl time.Duration) (model.Video, error)) *MockDelivery_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Images provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) Images(ctx context.Context, id string, opts model.ImageOptions) (model.Image, error) {
+	ret := _mock.Called(ctx, id, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Images")
+	}
+
+	var r0 model.Image
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.ImageOptions) (model.Image, error)); ok {
+		return returnFunc(ctx, id, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.ImageOptions) model.Image); ok {
+		r0 = returnFunc(ctx, id, opts)
+	} else {
+		r0 = ret.Get(0).(model.Image)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.ImageOptions) error); ok {
+		r1 = returnFunc(ctx, id, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_Images_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Images'
+type MockDelivery_Images_Call struct {
+	*mock.Call
+}
+
+// Images is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - opts model.ImageOptions
+func (_e *MockDelivery_Expecter) Images(ctx interface{}, id interface{}, opts interface{}) *MockDelivery_Images_Call {
+	return &MockDelivery_Images_Call{Call: _e.mock.On("Images", ctx, id, opts)}
+}
+
+func (_c *MockDelivery_Images_Call) Run(run func(ctx context.Context, id string, opts model.ImageOptions)) *MockDelivery_Images_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.ImageOptions
+		if args[2] != nil {
+			arg2 = args[2].(model.ImageOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_Images_Call) Return(image model.Image, err error) *MockDelivery_Images_Call {
+	_c.Call.Return(image, err)
+	return _c
+}
+
+func (_c *MockDelivery_Images_Call) RunAndReturn(run func(ctx context.Context, id string, opts model.ImageOptions) (model.Image, error)) *MockDelivery_Images_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) List(ctx context.Context, opts model.ListOptions) (model.VideoList, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 model.VideoList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ListOptions) (model.VideoList, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ListOptions) model.VideoList); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(model.VideoList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.ListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockDelivery_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts model.ListOptions
+func (_e *MockDelivery_Expecter) List(ctx interface{}, opts interface{}) *MockDelivery_List_Call {
+	return &MockDelivery_List_Call{Call: _e.mock.On("List", ctx, opts)}
+}
+
+func (_c *MockDelivery_List_Call) Run(run func(ctx context.Context, opts model.ListOptions)) *MockDelivery_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(model.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_List_Call) Return(videoList model.VideoList, err error) *MockDelivery_List_Call {
+	_c.Call.Return(videoList, err)
+	return _c
+}
+
+func (_c *MockDelivery_List_Call) RunAndReturn(run func(ctx context.Context, opts model.ListOptions) (model.VideoList, error)) *MockDelivery_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListClips provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) ListClips(ctx context.Context, id string, cursor string, limit int) (model.VideoList, error) {
+	ret := _mock.Called(ctx, id, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListClips")
+	}
+
+	var r0 model.VideoList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) (model.VideoList, error)); ok {
+		return returnFunc(ctx, id, cursor, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) model.VideoList); ok {
+		r0 = returnFunc(ctx, id, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(model.VideoList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = returnFunc(ctx, id, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_ListClips_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListClips'
+type MockDelivery_ListClips_Call struct {
+	*mock.Call
+}
+
+// ListClips is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - cursor string
+//   - limit int
+func (_e *MockDelivery_Expecter) ListClips(ctx interface{}, id interface{}, cursor interface{}, limit interface{}) *MockDelivery_ListClips_Call {
+	return &MockDelivery_ListClips_Call{Call: _e.mock.On("ListClips", ctx, id, cursor, limit)}
+}
+
+func (_c *MockDelivery_ListClips_Call) Run(run func(ctx context.Context, id string, cursor string, limit int)) *MockDelivery_ListClips_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_ListClips_Call) Return(videoList model.VideoList, err error) *MockDelivery_ListClips_Call {
+	_c.Call.Return(videoList, err)
+	return _c
+}
+
+func (_c *MockDelivery_ListClips_Call) RunAndReturn(run func(ctx context.Context, id string, cursor string, limit int) (model.VideoList, error)) *MockDelivery_ListClips_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrash provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) ListTrash(ctx context.Context, page int, limit int) ([]model.Video, error) {
+	ret := _mock.Called(ctx, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 []model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]model.Video, error)); ok {
+		return returnFunc(ctx, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []model.Video); ok {
+		r0 = returnFunc(ctx, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Video)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type MockDelivery_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - limit int
+func (_e *MockDelivery_Expecter) ListTrash(ctx interface{}, page interface{}, limit interface{}) *MockDelivery_ListTrash_Call {
+	return &MockDelivery_ListTrash_Call{Call: _e.mock.On("ListTrash", ctx, page, limit)}
+}
+
+func (_c *MockDelivery_ListTrash_Call) Run(run func(ctx context.Context, page int, limit int)) *MockDelivery_ListTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_ListTrash_Call) Return(videos []model.Video, err error) *MockDelivery_ListTrash_Call {
+	_c.Call.Return(videos, err)
+	return _c
+}
+
+func (_c *MockDelivery_ListTrash_Call) RunAndReturn(run func(ctx context.Context, page int, limit int) ([]model.Video, error)) *MockDelivery_ListTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaybackToken provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) PlaybackToken(ctx context.Context, id string, request model.TokenRequest) (model.PlaybackToken, error) {
+	ret := _mock.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaybackToken")
+	}
+
+	var r0 model.PlaybackToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.TokenRequest) (model.PlaybackToken, error)); ok {
+		return returnFunc(ctx, id, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.TokenRequest) model.PlaybackToken); ok {
+		r0 = returnFunc(ctx, id, request)
+	} else {
+		r0 = ret.Get(0).(model.PlaybackToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.TokenRequest) error); ok {
+		r1 = returnFunc(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_PlaybackToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaybackToken'
+type MockDelivery_PlaybackToken_Call struct {
+	*mock.Call
+}
+
+// PlaybackToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - request model.TokenRequest
+func (_e *MockDelivery_Expecter) PlaybackToken(ctx interface{}, id interface{}, request interface{}) *MockDelivery_PlaybackToken_Call {
+	return &MockDelivery_PlaybackToken_Call{Call: _e.mock.On("PlaybackToken", ctx, id, request)}
+}
+
+func (_c *MockDelivery_PlaybackToken_Call) Run(run func(ctx context.Context, id string, request model.TokenRequest)) *MockDelivery_PlaybackToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.TokenRequest
+		if args[2] != nil {
+			arg2 = args[2].(model.TokenRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_PlaybackToken_Call) Return(playbackToken model.PlaybackToken, err error) *MockDelivery_PlaybackToken_Call {
+	_c.Call.Return(playbackToken, err)
+	return _c
+}
+
+func (_c *MockDelivery_PlaybackToken_Call) RunAndReturn(run func(ctx context.Context, id string, request model.TokenRequest) (model.PlaybackToken, error)) *MockDelivery_PlaybackToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockDelivery
+func (_mock *MockDelivery) Search(ctx context.Context, query string, limit int) (model.SearchResult, error) {
+	ret := _mock.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 model.SearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (model.SearchResult, error)); ok {
+		return returnFunc(ctx, query, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) model.SearchResult); ok {
+		r0 = returnFunc(ctx, query, limit)
+	} else {
+		r0 = ret.Get(0).(model.SearchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelivery_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockDelivery_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockDelivery_Expecter) Search(ctx interface{}, query interface{}, limit interface{}) *MockDelivery_Search_Call {
+	return &MockDelivery_Search_Call{Call: _e.mock.On("Search", ctx, query, limit)}
+}
+
+func (_c *MockDelivery_Search_Call) Run(run func(ctx context.Context, query string, limit int)) *MockDelivery_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelivery_Search_Call) Return(searchResult model.SearchResult, err error) *MockDelivery_Search_Call {
+	_c.Call.Return(searchResult, err)
+	return _c
+}
+
+func (_c *MockDelivery_Search_Call) RunAndReturn(run func(ctx context.Context, query string, limit int) (model.SearchResult, error)) *MockDelivery_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIngestion creates a new instance of MockIngestion. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIngestion(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIngestion {
+	mock := &MockIngestion{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIngestion is an autogenerated mock type for the Ingestion type
+type MockIngestion struct {
+	mock.Mock
+}
+
+type MockIngestion_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIngestion) EXPECT() *MockIngestion_Expecter {
+	return &MockIngestion_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) Create(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	ret := _mock.Called(ctx, anyVideo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) (model.Video, error)); ok {
+		return returnFunc(ctx, anyVideo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) model.Video); ok {
+		r0 = returnFunc(ctx, anyVideo)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video) error); ok {
+		r1 = returnFunc(ctx, anyVideo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIngestion_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIngestion_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyVideo model.Video
+func (_e *MockIngestion_Expecter) Create(ctx interface{}, anyVideo interface{}) *MockIngestion_Create_Call {
+	return &MockIngestion_Create_Call{Call: _e.mock.On("Create", ctx, anyVideo)}
+}
+
+func (_c *MockIngestion_Create_Call) Run(run func(ctx context.Context, anyVideo model.Video)) *MockIngestion_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Video
+		if args[1] != nil {
+			arg1 = args[1].(model.Video)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_Create_Call) Return(video model.Video, err error) *MockIngestion_Create_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockIngestion_Create_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video) (model.Video, error)) *MockIngestion_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateClip provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) CreateClip(ctx context.Context, id string, request model.ClipRequest) (model.Video, error) {
+	ret := _mock.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClip")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.ClipRequest) (model.Video, error)); ok {
+		return returnFunc(ctx, id, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.ClipRequest) model.Video); ok {
+		r0 = returnFunc(ctx, id, request)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.ClipRequest) error); ok {
+		r1 = returnFunc(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIngestion_CreateClip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClip'
+type MockIngestion_CreateClip_Call struct {
+	*mock.Call
+}
+
+// CreateClip is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - request model.ClipRequest
+func (_e *MockIngestion_Expecter) CreateClip(ctx interface{}, id interface{}, request interface{}) *MockIngestion_CreateClip_Call {
+	return &MockIngestion_CreateClip_Call{Call: _e.mock.On("CreateClip", ctx, id, request)}
+}
+
+func (_c *MockIngestion_CreateClip_Call) Run(run func(ctx context.Context, id string, request model.ClipRequest)) *MockIngestion_CreateClip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.ClipRequest
+		if args[2] != nil {
+			arg2 = args[2].(model.ClipRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_CreateClip_Call) Return(video model.Video, err error) *MockIngestion_CreateClip_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockIngestion_CreateClip_Call) RunAndReturn(run func(ctx context.Context, id string, request model.ClipRequest) (model.Video, error)) *MockIngestion_CreateClip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) Delete(ctx context.Context, id string, permanent bool) error {
+	ret := _mock.Called(ctx, id, permanent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, id, permanent)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIngestion_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIngestion_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - permanent bool
+func (_e *MockIngestion_Expecter) Delete(ctx interface{}, id interface{}, permanent interface{}) *MockIngestion_Delete_Call {
+	return &MockIngestion_Delete_Call{Call: _e.mock.On("Delete", ctx, id, permanent)}
+}
+
+func (_c *MockIngestion_Delete_Call) Run(run func(ctx context.Context, id string, permanent bool)) *MockIngestion_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_Delete_Call) Return(err error) *MockIngestion_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIngestion_Delete_Call) RunAndReturn(run func(ctx context.Context, id string, permanent bool) error) *MockIngestion_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableDownload provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) EnableDownload(ctx context.Context, id string) (model.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableDownload")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIngestion_EnableDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableDownload'
+type MockIngestion_EnableDownload_Call struct {
+	*mock.Call
+}
+
+// EnableDownload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIngestion_Expecter) EnableDownload(ctx interface{}, id interface{}) *MockIngestion_EnableDownload_Call {
+	return &MockIngestion_EnableDownload_Call{Call: _e.mock.On("EnableDownload", ctx, id)}
+}
+
+func (_c *MockIngestion_EnableDownload_Call) Run(run func(ctx context.Context, id string)) *MockIngestion_EnableDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_EnableDownload_Call) Return(video model.Video, err error) *MockIngestion_EnableDownload_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockIngestion_EnableDownload_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockIngestion_EnableDownload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) Patch(ctx context.Context, id string, patch []byte) (model.Video, error) {
+	ret := _mock.Called(ctx, id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) (model.Video, error)); ok {
+		return returnFunc(ctx, id, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) model.Video); ok {
+		r0 = returnFunc(ctx, id, patch)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = returnFunc(ctx, id, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIngestion_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockIngestion_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - patch []byte
+func (_e *MockIngestion_Expecter) Patch(ctx interface{}, id interface{}, patch interface{}) *MockIngestion_Patch_Call {
+	return &MockIngestion_Patch_Call{Call: _e.mock.On("Patch", ctx, id, patch)}
+}
+
+func (_c *MockIngestion_Patch_Call) Run(run func(ctx context.Context, id string, patch []byte)) *MockIngestion_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_Patch_Call) Return(video model.Video, err error) *MockIngestion_Patch_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockIngestion_Patch_Call) RunAndReturn(run func(ctx context.Context, id string, patch []byte) (model.Video, error)) *MockIngestion_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) Restore(ctx context.Context, id string) (model.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIngestion_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockIngestion_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIngestion_Expecter) Restore(ctx interface{}, id interface{}) *MockIngestion_Restore_Call {
+	return &MockIngestion_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockIngestion_Restore_Call) Run(run func(ctx context.Context, id string)) *MockIngestion_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_Restore_Call) Return(video model.Video, err error) *MockIngestion_Restore_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockIngestion_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockIngestion_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryIngestion provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) RetryIngestion(ctx context.Context, id string) (model.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryIngestion")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIngestion_RetryIngestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryIngestion'
+type MockIngestion_RetryIngestion_Call struct {
+	*mock.Call
+}
+
+// RetryIngestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIngestion_Expecter) RetryIngestion(ctx interface{}, id interface{}) *MockIngestion_RetryIngestion_Call {
+	return &MockIngestion_RetryIngestion_Call{Call: _e.mock.On("RetryIngestion", ctx, id)}
+}
+
+func (_c *MockIngestion_RetryIngestion_Call) Run(run func(ctx context.Context, id string)) *MockIngestion_RetryIngestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_RetryIngestion_Call) Return(video model.Video, err error) *MockIngestion_RetryIngestion_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockIngestion_RetryIngestion_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockIngestion_RetryIngestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIngestion
+func (_mock *MockIngestion) Update(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	ret := _mock.Called(ctx, anyVideo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) (model.Video, error)); ok {
+		return returnFunc(ctx, anyVideo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) model.Video); ok {
+		r0 = returnFunc(ctx, anyVideo)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video) error); ok {
+		r1 = returnFunc(ctx, anyVideo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIngestion_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIngestion_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyVideo model.Video
+func (_e *MockIngestion_Expecter) Update(ctx interface{}, anyVideo interface{}) *MockIngestion_Update_Call {
+	return &MockIngestion_Update_Call{Call: _e.mock.On("Update", ctx, anyVideo)}
+}
+
+func (_c *MockIngestion_Update_Call) Run(run func(ctx context.Context, anyVideo model.Video)) *MockIngestion_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Video
+		if args[1] != nil {
+			arg1 = args[1].(model.Video)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIngestion_Update_Call) Return(video model.Video, err error) *MockIngestion_Update_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockIngestion_Update_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video) (model.Video, error)) *MockIngestion_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotifications creates a new instance of MockNotifications. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotifications(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotifications {
+	mock := &MockNotifications{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotifications is an autogenerated mock type for the Notifications type
+type MockNotifications struct {
+	mock.Mock
+}
+
+type MockNotifications_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotifications) EXPECT() *MockNotifications_Expecter {
+	return &MockNotifications_Expecter{mock: &_m.Mock}
+}
+
+// Receive provides a mock function for the type MockNotifications
+func (_mock *MockNotifications) Receive(ctx context.Context, payload []byte, signature string) error {
+	ret := _mock.Called(ctx, payload, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Receive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string) error); ok {
+		r0 = returnFunc(ctx, payload, signature)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotifications_Receive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Receive'
+type MockNotifications_Receive_Call struct {
+	*mock.Call
+}
+
+// Receive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payload []byte
+//   - signature string
+func (_e *MockNotifications_Expecter) Receive(ctx interface{}, payload interface{}, signature interface{}) *MockNotifications_Receive_Call {
+	return &MockNotifications_Receive_Call{Call: _e.mock.On("Receive", ctx, payload, signature)}
+}
+
+func (_c *MockNotifications_Receive_Call) Run(run func(ctx context.Context, payload []byte, signature string)) *MockNotifications_Receive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotifications_Receive_Call) Return(err error) *MockNotifications_Receive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotifications_Receive_Call) RunAndReturn(run func(ctx context.Context, payload []byte, signature string) error) *MockNotifications_Receive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTracks creates a new instance of MockTracks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTracks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTracks {
+	mock := &MockTracks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTracks is an autogenerated mock type for the Tracks type
+type MockTracks struct {
+	mock.Mock
+}
+
+type MockTracks_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTracks) EXPECT() *MockTracks_Expecter {
+	return &MockTracks_Expecter{mock: &_m.Mock}
+}
+
+// Caption provides a mock function for the type MockTracks
+func (_mock *MockTracks) Caption(ctx context.Context, id string) (model.Caption, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Caption")
+	}
+
+	var r0 model.Caption
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Caption, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Caption); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Caption)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTracks_Caption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Caption'
+type MockTracks_Caption_Call struct {
+	*mock.Call
+}
+
+// Caption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTracks_Expecter) Caption(ctx interface{}, id interface{}) *MockTracks_Caption_Call {
+	return &MockTracks_Caption_Call{Call: _e.mock.On("Caption", ctx, id)}
+}
+
+func (_c *MockTracks_Caption_Call) Run(run func(ctx context.Context, id string)) *MockTracks_Caption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTracks_Caption_Call) Return(caption model.Caption, err error) *MockTracks_Caption_Call {
+	_c.Call.Return(caption, err)
+	return _c
+}
+
+func (_c *MockTracks_Caption_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Caption, error)) *MockTracks_Caption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockTracks
+func (_mock *MockTracks) Create(ctx context.Context, id string, request model.TrackRequest) (model.Track, error) {
+	ret := _mock.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Track
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.TrackRequest) (model.Track, error)); ok {
+		return returnFunc(ctx, id, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.TrackRequest) model.Track); ok {
+		r0 = returnFunc(ctx, id, request)
+	} else {
+		r0 = ret.Get(0).(model.Track)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.TrackRequest) error); ok {
+		r1 = returnFunc(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTracks_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTracks_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - request model.TrackRequest
+func (_e *MockTracks_Expecter) Create(ctx interface{}, id interface{}, request interface{}) *MockTracks_Create_Call {
+	return &MockTracks_Create_Call{Call: _e.mock.On("Create", ctx, id, request)}
+}
+
+func (_c *MockTracks_Create_Call) Run(run func(ctx context.Context, id string, request model.TrackRequest)) *MockTracks_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.TrackRequest
+		if args[2] != nil {
+			arg2 = args[2].(model.TrackRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTracks_Create_Call) Return(track model.Track, err error) *MockTracks_Create_Call {
+	_c.Call.Return(track, err)
+	return _c
+}
+
+func (_c *MockTracks_Create_Call) RunAndReturn(run func(ctx context.Context, id string, request model.TrackRequest) (model.Track, error)) *MockTracks_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockTracks
+func (_mock *MockTracks) Delete(ctx context.Context, id string, trackID string) error {
+	ret := _mock.Called(ctx, id, trackID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, trackID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTracks_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTracks_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - trackID string
+func (_e *MockTracks_Expecter) Delete(ctx interface{}, id interface{}, trackID interface{}) *MockTracks_Delete_Call {
+	return &MockTracks_Delete_Call{Call: _e.mock.On("Delete", ctx, id, trackID)}
+}
+
+func (_c *MockTracks_Delete_Call) Run(run func(ctx context.Context, id string, trackID string)) *MockTracks_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTracks_Delete_Call) Return(err error) *MockTracks_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTracks_Delete_Call) RunAndReturn(run func(ctx context.Context, id string, trackID string) error) *MockTracks_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockTracks
+func (_mock *MockTracks) List(ctx context.Context, id string) ([]model.Track, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.Track
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]model.Track, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []model.Track); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Track)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTracks_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockTracks_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockTracks_Expecter) List(ctx interface{}, id interface{}) *MockTracks_List_Call {
+	return &MockTracks_List_Call{Call: _e.mock.On("List", ctx, id)}
+}
+
+func (_c *MockTracks_List_Call) Run(run func(ctx context.Context, id string)) *MockTracks_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTracks_List_Call) Return(tracks []model.Track, err error) *MockTracks_List_Call {
+	_c.Call.Return(tracks, err)
+	return _c
+}
+
+func (_c *MockTracks_List_Call) RunAndReturn(run func(ctx context.Context, id string) ([]model.Track, error)) *MockTracks_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUploader creates a new instance of MockUploader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUploader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUploader {
+	mock := &MockUploader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUploader is an autogenerated mock type for the Uploader type
+type MockUploader struct {
+	mock.Mock
+}
+
+type MockUploader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUploader) EXPECT() *MockUploader_Expecter {
+	return &MockUploader_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUploader
+func (_mock *MockUploader) Create(ctx context.Context, request model.UploadRequest) (model.Upload, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.UploadRequest) (model.Upload, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.UploadRequest) model.Upload); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(model.Upload)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.UploadRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUploader_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUploader_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request model.UploadRequest
+func (_e *MockUploader_Expecter) Create(ctx interface{}, request interface{}) *MockUploader_Create_Call {
+	return &MockUploader_Create_Call{Call: _e.mock.On("Create", ctx, request)}
+}
+
+func (_c *MockUploader_Create_Call) Run(run func(ctx context.Context, request model.UploadRequest)) *MockUploader_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.UploadRequest
+		if args[1] != nil {
+			arg1 = args[1].(model.UploadRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUploader_Create_Call) Return(upload model.Upload, err error) *MockUploader_Create_Call {
+	_c.Call.Return(upload, err)
+	return _c
+}
+
+func (_c *MockUploader_Create_Call) RunAndReturn(run func(ctx context.Context, request model.UploadRequest) (model.Upload, error)) *MockUploader_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockUploader
+func (_mock *MockUploader) GetByID(ctx context.Context, id string) (model.Upload, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Upload, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Upload); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Upload)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUploader_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUploader_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockUploader_Expecter) GetByID(ctx interface{}, id interface{}) *MockUploader_GetByID_Call {
+	return &MockUploader_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockUploader_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockUploader_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUploader_GetByID_Call) Return(upload model.Upload, err error) *MockUploader_GetByID_Call {
+	_c.Call.Return(upload, err)
+	return _c
+}
+
+func (_c *MockUploader_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Upload, error)) *MockUploader_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// CreatePlaylist controller
+func (c controller) CreatePlaylist(w http.ResponseWriter, r *http.Request) {
+	var playlist model.Playlist
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&playlist); err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+	defer r.Body.Close()
+
+	response, err := c.collections.Create(r.Context(), playlist)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusCreated,
+		response,
+	)
+}
+
+// GetPlaylist controller returns the playlist with its hydrated videos
+func (c controller) GetPlaylist(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !validID(w, id) {
+		return
+	}
+
+	response, err := c.collections.GetByID(r.Context(), id)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// ListPlaylists controller
+func (c controller) ListPlaylists(w http.ResponseWriter, r *http.Request) {
+	page, limit := pagination(r)
+	playlists, err := c.collections.List(r.Context(), page, limit)
+	if err != nil {
+		JSONResponse(
+			w, http.StatusInternalServerError,
+			Response{
+				Message: "Internal server error",
+				Status:  http.StatusInternalServerError,
+			},
+		)
+		return
+	}
+	JSONResponse(w, http.StatusOK, playlists)
+}
+
+// UpdatePlaylist controller replaces the title and description of a playlist
+func (c controller) UpdatePlaylist(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !validID(w, id) {
+		return
+	}
+
+	var playlist model.Playlist
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&playlist); err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+	defer r.Body.Close()
+
+	// The ID in the path wins over the body
+	playlist.ID = id
+
+	response, err := c.collections.Update(r.Context(), playlist)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// DeletePlaylist controller
+func (c controller) DeletePlaylist(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !validID(w, id) {
+		return
+	}
+
+	if err := c.collections.Delete(r.Context(), id); err != nil {
+		updateError(w, err)
+		return
+	}
+
+	NoContentResponse(w)
+}
+
+// AddPlaylistVideo controller places a video in a playlist. The optional
+// position query parameter is zero based, the video is appended without it.
+func (c controller) AddPlaylistVideo(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, videoID := vars["id"], vars["videoId"]
+	if !validID(w, id, videoID) {
+		return
+	}
+
+	position := -1
+	if p := r.URL.Query().Get("position"); p != "" {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 {
+			JSONResponse(
+				w, http.StatusBadRequest,
+				Response{
+					Message: "Bad request",
+					Status:  http.StatusBadRequest,
+				},
+			)
+			return
+		}
+		position = v
+	}
+
+	response, err := c.collections.AddVideo(r.Context(), id, videoID, position)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// RemovePlaylistVideo controller takes a video out of a playlist
+func (c controller) RemovePlaylistVideo(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, videoID := vars["id"], vars["videoId"]
+	if !validID(w, id, videoID) {
+		return
+	}
+
+	response, err := c.collections.RemoveVideo(r.Context(), id, videoID)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// validID writes a 422 response unless every ID has the UUID length
+func validID(w http.ResponseWriter, ids ...string) bool {
+	for _, id := range ids {
+		if len(id) != 36 {
+			JSONResponse(
+				w, http.StatusUnprocessableEntity,
+				Response{
+					Message: "Unprocessable Entity",
+					Status:  http.StatusUnprocessableEntity,
+				},
+			)
+			return false
+		}
+	}
+	return true
+}
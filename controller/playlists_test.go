@@ -0,0 +1,307 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	playlistUUID = "6f1c1ba0-2f3b-4e8e-9c1a-1b5f0e4d7a11"
+	videoUUID    = "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+)
+
+func TestPlaylistController_CreatePlaylist(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		input        *model.Playlist
+		response     model.Playlist
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success",
+			body:         `{"title":"Morning Glory"}`,
+			input:        &model.Playlist{Title: "Morning Glory"},
+			response:     model.Playlist{ID: playlistUUID, Title: "Morning Glory", VideoIDs: []string{}},
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"id":"6f1c1ba0-2f3b-4e8e-9c1a-1b5f0e4d7a11","title":"Morning Glory","video_ids":[]}`,
+		},
+		{
+			name:         "Bad body",
+			body:         `{"title":`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"message":"Bad request","status":400}`,
+		},
+		{
+			name:         "Missing title",
+			body:         `{}`,
+			input:        &model.Playlist{},
+			wantedError:  errorcodes.ErrVideoUnprocessable,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable entity","status":422}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections := NewMockCollections(t)
+			controller := &controller{collections: collections}
+
+			r, _ := http.NewRequest("POST", "/playlists", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			if tt.input != nil {
+				collections.On("Create", r.Context(), *tt.input).Return(tt.response, tt.wantedError)
+			}
+
+			controller.CreatePlaylist(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestPlaylistController_GetPlaylist(t *testing.T) {
+	playlist := model.Playlist{
+		ID:       playlistUUID,
+		Title:    "Morning Glory",
+		VideoIDs: []string{videoUUID},
+		Videos:   []model.Video{{ID: videoUUID, Poster: "poster.jpg"}},
+	}
+
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", playlistUUID, true, nil, http.StatusOK, `{"id":"6f1c1ba0-2f3b-4e8e-9c1a-1b5f0e4d7a11","title":"Morning Glory","video_ids":["4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"],"videos":[{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","poster":"poster.jpg"}]}`},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Not found", playlistUUID, true, errorcodes.ErrPlaylistNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", playlistUUID, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections := NewMockCollections(t)
+			controller := &controller{collections: collections}
+
+			r, _ := http.NewRequest("GET", "/playlists/abcd", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				collections.On("GetByID", r.Context(), tt.id).Return(playlist, tt.wantedError)
+			}
+
+			controller.GetPlaylist(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestPlaylistController_ListPlaylists(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		collections := NewMockCollections(t)
+		controller := &controller{collections: collections}
+
+		collections.On("List", mock.Anything, 2, 5).Return([]model.Playlist{{ID: playlistUUID, VideoIDs: []string{}}}, nil)
+
+		r, _ := http.NewRequest("GET", "/playlists?page=2&limit=5", nil)
+		w := httptest.NewRecorder()
+
+		controller.ListPlaylists(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `[{"id":"6f1c1ba0-2f3b-4e8e-9c1a-1b5f0e4d7a11","video_ids":[]}]`, w.Body.String())
+	})
+
+	t.Run("Internal error", func(t *testing.T) {
+		collections := NewMockCollections(t)
+		controller := &controller{collections: collections}
+
+		collections.On("List", mock.Anything, 1, 10).Return(nil, assert.AnError)
+
+		r, _ := http.NewRequest("GET", "/playlists", nil)
+		w := httptest.NewRecorder()
+
+		controller.ListPlaylists(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestPlaylistController_UpdatePlaylist(t *testing.T) {
+	playlist := model.Playlist{ID: playlistUUID, Title: "Be Here Now", VideoIDs: []string{}}
+
+	tests := []struct {
+		name         string
+		id           string
+		body         string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+	}{
+		{"Success", playlistUUID, `{"id":"ignored","title":"Be Here Now"}`, true, nil, http.StatusOK},
+		{"Bad ID", "123", `{"title":"Be Here Now"}`, false, nil, http.StatusUnprocessableEntity},
+		{"Bad body", playlistUUID, `[`, false, nil, http.StatusBadRequest},
+		{"Not found", playlistUUID, `{"title":"Be Here Now"}`, true, errorcodes.ErrPlaylistNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections := NewMockCollections(t)
+			controller := &controller{collections: collections}
+
+			r, _ := http.NewRequest("PUT", "/playlists/abcd", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				collections.On("Update", r.Context(), model.Playlist{ID: playlistUUID, Title: "Be Here Now"}).Return(playlist, tt.wantedError)
+			}
+
+			controller.UpdatePlaylist(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+		})
+	}
+}
+
+func TestPlaylistController_DeletePlaylist(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+	}{
+		{"Success", playlistUUID, true, nil, http.StatusNoContent},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity},
+		{"Not found", playlistUUID, true, errorcodes.ErrPlaylistNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections := NewMockCollections(t)
+			controller := &controller{collections: collections}
+
+			r, _ := http.NewRequest("DELETE", "/playlists/abcd", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				collections.On("Delete", r.Context(), tt.id).Return(tt.wantedError)
+			}
+
+			controller.DeletePlaylist(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+		})
+	}
+}
+
+func TestPlaylistController_AddPlaylistVideo(t *testing.T) {
+	playlist := model.Playlist{ID: playlistUUID, VideoIDs: []string{videoUUID}}
+
+	tests := []struct {
+		name         string
+		url          string
+		videoID      string
+		position     int
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+	}{
+		{"Append", "/playlists/abcd/videos/efgh", videoUUID, -1, true, nil, http.StatusOK},
+		{"At position", "/playlists/abcd/videos/efgh?position=2", videoUUID, 2, true, nil, http.StatusOK},
+		{"Bad position", "/playlists/abcd/videos/efgh?position=-1", videoUUID, 0, false, nil, http.StatusBadRequest},
+		{"Bad video ID", "/playlists/abcd/videos/efgh", "123", 0, false, nil, http.StatusUnprocessableEntity},
+		{"Unknown video", "/playlists/abcd/videos/efgh", videoUUID, -1, true, errorcodes.ErrVideoNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections := NewMockCollections(t)
+			controller := &controller{collections: collections}
+
+			r, _ := http.NewRequest("POST", tt.url, nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id":      playlistUUID,
+				"videoId": tt.videoID,
+			})
+
+			if tt.callUsecase {
+				collections.On("AddVideo", r.Context(), playlistUUID, tt.videoID, tt.position).Return(playlist, tt.wantedError)
+			}
+
+			controller.AddPlaylistVideo(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+		})
+	}
+}
+
+func TestPlaylistController_RemovePlaylistVideo(t *testing.T) {
+	tests := []struct {
+		name         string
+		videoID      string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+	}{
+		{"Success", videoUUID, true, nil, http.StatusOK},
+		{"Bad video ID", "123", false, nil, http.StatusUnprocessableEntity},
+		{"Not in playlist", videoUUID, true, errorcodes.ErrVideoNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections := NewMockCollections(t)
+			controller := &controller{collections: collections}
+
+			r, _ := http.NewRequest("DELETE", "/playlists/abcd/videos/efgh", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id":      playlistUUID,
+				"videoId": tt.videoID,
+			})
+
+			if tt.callUsecase {
+				collections.On("RemoveVideo", r.Context(), playlistUUID, tt.videoID).Return(model.Playlist{ID: playlistUUID, VideoIDs: []string{}}, tt.wantedError)
+			}
+
+			controller.RemovePlaylistVideo(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// maxTrackSize limits the track body read into memory, the usecase bounds the caption file
+const maxTrackSize = 2 << 20
+
+// captionTypes are the content types of a caption file sent as the request body
+var captionTypes = map[string]bool{
+	"text/vtt":             true,
+	"application/x-subrip": true,
+	"text/srt":             true,
+}
+
+// CreateTrack controller adds a caption, subtitle or audio track to a video.
+// The body is a JSON track request, or the WebVTT or SRT file itself with the
+// language_code, name and closed_captions query parameters.
+func (c controller) CreateTrack(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if !validID(w, id) {
+		return
+	}
+
+	request, err := trackRequest(r)
+	if err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+
+	response, err := c.tracks.Create(r.Context(), id, request)
+	if err != nil {
+		if err == errorcodes.ErrInvalidTrack {
+			JSONResponse(
+				w, http.StatusBadRequest,
+				Response{
+					Message: "Bad request",
+					Status:  http.StatusBadRequest,
+				},
+			)
+			return
+		}
+
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusCreated,
+		response,
+	)
+}
+
+// ListTracks controller returns the caption, subtitle and audio tracks of a video
+func (c controller) ListTracks(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if !validID(w, id) {
+		return
+	}
+
+	response, err := c.tracks.List(r.Context(), id)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// DeleteTrack controller removes a caption, subtitle or audio track from a video
+func (c controller) DeleteTrack(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, trackID := vars["id"], vars["trackId"]
+
+	if !validID(w, id) {
+		return
+	}
+
+	if err := c.tracks.Delete(r.Context(), id, trackID); err != nil {
+		updateError(w, err)
+		return
+	}
+
+	NoContentResponse(w)
+}
+
+// Caption controller serves an uploaded caption file to Mux.com
+func (c controller) Caption(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if !validID(w, id) {
+		return
+	}
+
+	caption, err := c.tracks.Caption(r.Context(), id)
+	if err != nil {
+		if err == errorcodes.ErrCaptionNotFound {
+			err = errorcodes.ErrTrackNotFound
+		}
+
+		updateError(w, err)
+		return
+	}
+
+	corsHeaders(w)
+	w.Header().Set("Content-Type", "text/vtt; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, caption.Content)
+}
+
+// trackRequest reads the track request from a JSON body or from a caption file body
+func trackRequest(r *http.Request) (model.TrackRequest, error) {
+	defer r.Body.Close()
+	body := io.LimitReader(r.Body, maxTrackSize)
+
+	var request model.TrackRequest
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if !captionTypes[mediaType] {
+		err := json.NewDecoder(body).Decode(&request)
+		return request, err
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return request, err
+	}
+
+	query := r.URL.Query()
+	request.Content = string(content)
+	request.LanguageCode = query.Get("language_code")
+	request.Name = query.Get("name")
+	if value := query.Get("closed_captions"); len(value) > 0 {
+		request.ClosedCaptions, err = strconv.ParseBool(value)
+	}
+
+	return request, err
+}
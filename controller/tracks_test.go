@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const captionUUID = "8d7f1c1e-3a0b-4b8e-9f59-1f6c3c1d2e4a"
+
+func TestTrackController_CreateTrack(t *testing.T) {
+	srt := "1\n00:00:01,000 --> 00:00:04,000\nWonderwall\n"
+	track := model.Track{ID: "track", Type: "text", Status: "preparing", LanguageCode: "en"}
+
+	tests := []struct {
+		name         string
+		id           string
+		path         string
+		contentType  string
+		body         string
+		request      model.TrackRequest
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"JSON request", videoUUID, "/videos/abcd/tracks", "application/json", `{"url":"https://example.com/en.vtt","language_code":"en","closed_captions":true}`, model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "en", ClosedCaptions: true}, true, nil, http.StatusCreated, `{"id":"track","type":"text","status":"preparing","language_code":"en"}`},
+		{"Audio track", videoUUID, "/videos/abcd/tracks", "application/json", `{"type":"audio","url":"https://example.com/es.m4a","language_code":"es"}`, model.TrackRequest{Type: "audio", URL: "https://example.com/es.m4a", LanguageCode: "es"}, true, nil, http.StatusCreated, `{"id":"track","type":"text","status":"preparing","language_code":"en"}`},
+		{"SRT file", videoUUID, "/videos/abcd/tracks?language_code=en&name=English&closed_captions=true", "application/x-subrip", srt, model.TrackRequest{Content: srt, LanguageCode: "en", Name: "English", ClosedCaptions: true}, true, nil, http.StatusCreated, `{"id":"track","type":"text","status":"preparing","language_code":"en"}`},
+		{"Bad closed captions flag", videoUUID, "/videos/abcd/tracks?language_code=en&closed_captions=maybe", "text/vtt", "WEBVTT", model.TrackRequest{}, false, nil, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Bad body", videoUUID, "/videos/abcd/tracks", "application/json", `{"url":`, model.TrackRequest{}, false, nil, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Bad ID", "123", "/videos/abcd/tracks", "application/json", `{}`, model.TrackRequest{}, false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Invalid track", videoUUID, "/videos/abcd/tracks", "application/json", `{}`, model.TrackRequest{}, true, errorcodes.ErrInvalidTrack, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Asset not ready", videoUUID, "/videos/abcd/tracks", "application/json", `{}`, model.TrackRequest{}, true, errorcodes.ErrAssetNotReady, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"Not found", videoUUID, "/videos/abcd/tracks", "application/json", `{}`, model.TrackRequest{}, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", videoUUID, "/videos/abcd/tracks", "application/json", `{}`, model.TrackRequest{}, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracks := NewMockTracks(t)
+			controller := &controller{
+				tracks: tracks,
+			}
+
+			r, _ := http.NewRequest("POST", tt.path, bytes.NewBufferString(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				tracks.On("Create", r.Context(), tt.id, tt.request).Return(track, tt.wantedError)
+			}
+
+			controller.CreateTrack(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestTrackController_ListTracks(t *testing.T) {
+	list := []model.Track{{ID: "en", Type: "text", Status: "ready", LanguageCode: "en", ClosedCaptions: true}}
+
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", videoUUID, true, nil, http.StatusOK, `[{"id":"en","type":"text","status":"ready","language_code":"en","closed_captions":true}]`},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Not found", videoUUID, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", videoUUID, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracks := NewMockTracks(t)
+			controller := &controller{
+				tracks: tracks,
+			}
+
+			r, _ := http.NewRequest("GET", "/videos/abcd/tracks", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				tracks.On("List", r.Context(), tt.id).Return(list, tt.wantedError)
+			}
+
+			controller.ListTracks(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestTrackController_DeleteTrack(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", videoUUID, true, nil, http.StatusNoContent, ""},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Track not found", videoUUID, true, errorcodes.ErrTrackNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", videoUUID, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracks := NewMockTracks(t)
+			controller := &controller{
+				tracks: tracks,
+			}
+
+			r, _ := http.NewRequest("DELETE", "/videos/abcd/tracks/en", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id":      tt.id,
+				"trackId": "en",
+			})
+
+			if tt.callUsecase {
+				tracks.On("Delete", r.Context(), tt.id, "en").Return(tt.wantedError)
+			}
+
+			controller.DeleteTrack(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestTrackController_Caption(t *testing.T) {
+	caption := model.Caption{ID: captionUUID, Content: "WEBVTT\n\n00:01.000 --> 00:04.000\nWonderwall\n"}
+
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedType string
+		expectedBody string
+	}{
+		{"Success", captionUUID, true, nil, http.StatusOK, "text/vtt; charset=UTF-8", caption.Content},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, "application/json; charset=UTF-8", `{"message":"Unprocessable Entity","status":422}`},
+		{"Not found", captionUUID, true, errorcodes.ErrCaptionNotFound, http.StatusNotFound, "application/json; charset=UTF-8", `{"message":"Not found","status":404}`},
+		{"Internal error", captionUUID, true, assert.AnError, http.StatusInternalServerError, "application/json; charset=UTF-8", `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracks := NewMockTracks(t)
+			controller := &controller{
+				tracks: tracks,
+			}
+
+			r, _ := http.NewRequest("GET", "/captions/abcd.vtt", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				tracks.On("Caption", r.Context(), tt.id).Return(caption, tt.wantedError)
+			}
+
+			controller.Caption(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"), "Content type should match expected")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
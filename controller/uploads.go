@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// CreateUpload controller returns the URL the browser uploads the source file to
+func (c controller) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	var request model.UploadRequest
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&request); err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+	defer r.Body.Close()
+
+	response, err := c.uploader.Create(r.Context(), request)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusCreated,
+		response,
+	)
+}
+
+// GetUpload controller returns the status of an upload
+func (c controller) GetUpload(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	response, err := c.uploader.GetByID(r.Context(), id)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const uploadID = "OA02dANZ67tOl1e6EVpn02OE"
+
+func TestUploadController_CreateUpload(t *testing.T) {
+	upload := model.Upload{
+		ID:      uploadID,
+		VideoID: videoUUID,
+		URL:     "https://storage.googleapis.com/video-storage-us-east1-uploads/OA02dANZ67tOl1e6EVpn02OE",
+		Status:  model.UploadWaiting,
+	}
+
+	tests := []struct {
+		name         string
+		body         string
+		input        *model.UploadRequest
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "Success",
+			body: `{"title":"Live Forever","policy":"signed","cors_origin":"https://example.com"}`,
+			input: &model.UploadRequest{
+				Video:      model.Video{Title: "Live Forever", Policy: "signed"},
+				CorsOrigin: "https://example.com",
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `{"id":"OA02dANZ67tOl1e6EVpn02OE","video_id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","url":"https://storage.googleapis.com/video-storage-us-east1-uploads/OA02dANZ67tOl1e6EVpn02OE","status":"waiting"}`,
+		},
+		{
+			name:         "Bad body",
+			body:         `{"title":`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"message":"Bad request","status":400}`,
+		},
+		{
+			name:         "Unprocessable video",
+			body:         `{"title":"Live Forever"}`,
+			input:        &model.UploadRequest{Video: model.Video{Title: "Live Forever"}},
+			wantedError:  errorcodes.ErrVideoUnprocessable,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable entity","status":422}`,
+		},
+		{
+			name:         "Mux.com error",
+			body:         `{"title":"Live Forever","policy":"public"}`,
+			input:        &model.UploadRequest{Video: model.Video{Title: "Live Forever", Policy: "public"}},
+			wantedError:  errorcodes.ErrIngestionFailed,
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"message":"Internal server error","status":500}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uploader := NewMockUploader(t)
+			controller := &controller{uploader: uploader}
+
+			r, _ := http.NewRequest("POST", "/uploads", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			if tt.input != nil {
+				response := upload
+				if tt.wantedError != nil {
+					response = model.Upload{}
+				}
+				uploader.On("Create", r.Context(), *tt.input).Return(response, tt.wantedError)
+			}
+
+			controller.CreateUpload(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestUploadController_GetUpload(t *testing.T) {
+	upload := model.Upload{
+		ID:      uploadID,
+		VideoID: videoUUID,
+		Status:  model.UploadAssetCreated,
+		AssetID: "dd0f697463174c0ca57800847f8559d7",
+	}
+
+	tests := []struct {
+		name         string
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", nil, http.StatusOK, `{"id":"OA02dANZ67tOl1e6EVpn02OE","video_id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","status":"asset_created","asset_id":"dd0f697463174c0ca57800847f8559d7"}`},
+		{"Not found", errorcodes.ErrUploadNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uploader := NewMockUploader(t)
+			controller := &controller{uploader: uploader}
+
+			r, _ := http.NewRequest("GET", "/uploads/abcd", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": uploadID,
+			})
+
+			response := upload
+			if tt.wantedError != nil {
+				response = model.Upload{}
+			}
+			uploader.On("GetByID", r.Context(), uploadID).Return(response, tt.wantedError)
+
+			controller.GetUpload(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
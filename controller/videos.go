@@ -2,8 +2,13 @@ package controller
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -77,7 +82,19 @@ func (c controller) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := c.delivery.GetByID(r.Context(), id)
+	ttl, err := tokenTTL(r)
+	if err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+
+	response, err := c.delivery.GetByID(r.Context(), id, ttl)
 
 	if err != nil {
 		// Look for Custom Error
@@ -110,8 +127,495 @@ func (c controller) GetByID(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
-// List controller
+// List controller returns a page of videos after the cursor. The page parameter
+// is kept for compatibility and responds with the legacy array.
 func (c controller) List(w http.ResponseWriter, r *http.Request) {
+	opts, err := listOptions(r)
+	if err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+
+	list, err := c.delivery.List(r.Context(), opts)
+	if err != nil {
+		if err == errorcodes.ErrInvalidCursor || err == errorcodes.ErrInvalidListOptions {
+			JSONResponse(
+				w, http.StatusBadRequest,
+				Response{
+					Message: "Bad request",
+					Status:  http.StatusBadRequest,
+				},
+			)
+			return
+		}
+
+		JSONResponse(
+			w, http.StatusInternalServerError,
+			Response{
+				Message: "Internal server error",
+				Status:  http.StatusInternalServerError,
+			},
+		)
+		return
+	}
+
+	if opts.Page > 0 {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", link(r, "", opts.Limit, "successor-version"))
+		JSONResponse(w, http.StatusOK, list.Items)
+		return
+	}
+
+	setLinks(w, r, list.NextCursor, opts.Limit)
+
+	JSONResponse(w, http.StatusOK, list)
+}
+
+// Search controller returns the videos matching the q parameter
+func (c controller) Search(w http.ResponseWriter, r *http.Request) {
+	_, limit := pagination(r)
+	if !r.URL.Query().Has("limit") {
+		limit = 0
+	}
+
+	result, err := c.delivery.Search(r.Context(), r.URL.Query().Get("q"), limit)
+	if err != nil {
+		if err == errorcodes.ErrInvalidQuery {
+			JSONResponse(
+				w, http.StatusBadRequest,
+				Response{
+					Message: "Bad request",
+					Status:  http.StatusBadRequest,
+				},
+			)
+			return
+		}
+
+		JSONResponse(
+			w, http.StatusInternalServerError,
+			Response{
+				Message: "Internal server error",
+				Status:  http.StatusInternalServerError,
+			},
+		)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, result)
+}
+
+// Update controller replaces the editable fields of a video
+func (c controller) Update(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Wrong type of ID should return 422 error?
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	var video model.Video
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&video); err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+	defer r.Body.Close()
+
+	video.ID = id
+
+	response, err := c.ingestion.Update(r.Context(), video)
+
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// Patch controller applies a JSON Merge Patch to a video
+func (c controller) Patch(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Wrong type of ID should return 422 error?
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	patch, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+
+	// A merge patch must be a JSON object
+	var object map[string]json.RawMessage
+	if err != nil || json.Unmarshal(patch, &object) != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+
+	response, err := c.ingestion.Patch(r.Context(), id, patch)
+
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// Delete controller moves a video to the trash, or removes it and its Mux asset with ?permanent=true
+func (c controller) Delete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Wrong type of ID should return 422 error?
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	permanent := r.URL.Query().Get("permanent") == "true"
+
+	err := c.ingestion.Delete(r.Context(), id, permanent)
+
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	NoContentResponse(w)
+}
+
+// ListTrash controller
+func (c controller) ListTrash(w http.ResponseWriter, r *http.Request) {
+	page, limit := pagination(r)
+	videos, err := c.delivery.ListTrash(r.Context(), page, limit)
+	if err != nil {
+		JSONResponse(
+			w, http.StatusInternalServerError,
+			Response{
+				Message: "Internal server error",
+				Status:  http.StatusInternalServerError,
+			},
+		)
+		return
+	}
+	JSONResponse(w, http.StatusOK, videos)
+}
+
+// RetryIngestion controller queues again a failed ingestion
+func (c controller) RetryIngestion(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	response, err := c.ingestion.RetryIngestion(r.Context(), id)
+
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusAccepted,
+		response,
+	)
+}
+
+// EnableDownload controller requests the static MP4 renditions of a video
+func (c controller) EnableDownload(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	response, err := c.ingestion.EnableDownload(r.Context(), id)
+
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusAccepted,
+		response,
+	)
+}
+
+// CreateClip controller cuts a clip from a video, the clip is a new video
+// whose asset is created in the background
+func (c controller) CreateClip(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if !validID(w, id) {
+		return
+	}
+
+	var request model.ClipRequest
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&request); err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+	defer r.Body.Close()
+
+	response, err := c.ingestion.CreateClip(r.Context(), id, request)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusCreated,
+		response,
+	)
+}
+
+// ListClips controller returns a page of the clips cut from a video, with the
+// cursor and limit query parameters and the Link headers of the video list
+func (c controller) ListClips(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if !validID(w, id) {
+		return
+	}
+
+	cursor, limit := cursorPagination(r)
+	list, err := c.delivery.ListClips(r.Context(), id, cursor, limit)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	setLinks(w, r, list.NextCursor, limit)
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		list,
+	)
+}
+
+// Images controller returns the URL of an image of a video with the width,
+// height, time and format query parameters
+func (c controller) Images(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	opts, err := imageOptions(r)
+	if err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+
+	response, err := c.delivery.Images(r.Context(), id, opts)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// Chapters controller returns the chapters of a video as a WebVTT file
+func (c controller) Chapters(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if !validID(w, id) {
+		return
+	}
+
+	response, err := c.delivery.ChaptersVTT(r.Context(), id)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	corsHeaders(w)
+	w.Header().Set("Content-Type", "text/vtt; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, response)
+}
+
+// PlaybackToken controller issues fresh signed tokens of a video for a viewer,
+// the body is optional
+func (c controller) PlaybackToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	var request model.TokenRequest
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&request); err != nil && err != io.EOF {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+	defer r.Body.Close()
+
+	response, err := c.delivery.PlaybackToken(r.Context(), id, request)
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// Restore controller takes a video out of the trash
+func (c controller) Restore(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	// Wrong type of ID should return 422 error?
+	if len(id) != 36 {
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable Entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+		return
+	}
+
+	response, err := c.ingestion.Restore(r.Context(), id)
+
+	if err != nil {
+		updateError(w, err)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		response,
+	)
+}
+
+// maxLimit caps the size of a cursor page
+const maxLimit = 100
+
+// pagination reads the page and limit query parameters
+func pagination(r *http.Request) (int, int) {
 	page := 1
 	limit := 10
 	if p := r.URL.Query().Get("page"); p != "" {
@@ -124,8 +628,187 @@ func (c controller) List(w http.ResponseWriter, r *http.Request) {
 			limit = v
 		}
 	}
-	videos, err := c.delivery.List(r.Context(), page, limit)
-	if err != nil {
+	return page, limit
+}
+
+// cursorPagination reads the cursor and limit query parameters, the limit is
+// capped like the one of the video list
+func cursorPagination(r *http.Request) (string, int) {
+	_, limit := pagination(r)
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return r.URL.Query().Get("cursor"), limit
+}
+
+// tokenTTL reads the token_ttl query parameter, the requested lifetime of the
+// signed tokens in seconds. Zero keeps the signing policy.
+func tokenTTL(r *http.Request) (time.Duration, error) {
+	value := r.URL.Query().Get("token_ttl")
+	if value == "" {
+		return 0, nil
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0, errorcodes.ErrInvalidTokenTTL
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// imageOptions reads the width, height, time and format query parameters
+func imageOptions(r *http.Request) (model.ImageOptions, error) {
+	query := r.URL.Query()
+	opts := model.ImageOptions{Format: query.Get("format")}
+
+	var err error
+	if v := query.Get("width"); v != "" {
+		if opts.Width, err = strconv.Atoi(v); err != nil {
+			return model.ImageOptions{}, err
+		}
+	}
+	if v := query.Get("height"); v != "" {
+		if opts.Height, err = strconv.Atoi(v); err != nil {
+			return model.ImageOptions{}, err
+		}
+	}
+	if v := query.Get("time"); v != "" {
+		t, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return model.ImageOptions{}, err
+		}
+		opts.Time = &t
+	}
+
+	return opts, nil
+}
+
+// listOptions reads the pagination, sort and filter query parameters
+func listOptions(r *http.Request) (model.ListOptions, error) {
+	query := r.URL.Query()
+	page, limit := pagination(r)
+
+	opts := model.ListOptions{
+		Cursor: query.Get("cursor"),
+		Limit:  limit,
+		Total:  query.Get("total") == "true",
+		Sort:   query.Get("sort"),
+		Policy: query.Get("policy"),
+		Status: query.Get("status"),
+	}
+
+	if query.Has("page") {
+		opts.Page = page
+	} else if opts.Limit > maxLimit {
+		opts.Limit = maxLimit
+	}
+
+	if v := query.Get("created_after"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return model.ListOptions{}, err
+		}
+		opts.CreatedAfter = t
+	}
+
+	if v := query.Get("created_before"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return model.ListOptions{}, err
+		}
+		opts.CreatedBefore = t
+	}
+
+	if tags := query["tag"]; len(tags) > 0 {
+		opts.Tags = tags
+	}
+
+	for key, values := range query {
+		if name, ok := strings.CutPrefix(key, "metadata."); ok {
+			if opts.Metadata == nil {
+				opts.Metadata = map[string]string{}
+			}
+			opts.Metadata[name] = values[0]
+		}
+	}
+
+	if v := query.Get("has_asset"); v != "" {
+		hasAsset, err := strconv.ParseBool(v)
+		if err != nil {
+			return model.ListOptions{}, err
+		}
+		opts.HasAsset = &hasAsset
+	}
+
+	return opts, nil
+}
+
+// link returns an RFC 8288 link to the cursor page of the request path
+func link(r *http.Request, cursor string, limit int, rel string) string {
+	query := r.URL.Query()
+	query.Del("page")
+	query.Del("cursor")
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	query.Set("limit", strconv.Itoa(limit))
+
+	target := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+
+	return fmt.Sprintf("<%s>; rel=\"%s\"", target.String(), rel)
+}
+
+// setLinks sets the Link header to the first page and, when there is one, to
+// the page after the cursor
+func setLinks(w http.ResponseWriter, r *http.Request, next string, limit int) {
+	links := []string{link(r, "", limit, "first")}
+	if next != "" {
+		links = append(links, link(r, next, limit, "next"))
+	}
+	w.Header().Set("Link", strings.Join(links, ", "))
+}
+
+// updateError writes the response for errors returned by Update, Patch, Delete,
+// Restore, the playlist changes, the delivery of images and the clip list
+func updateError(w http.ResponseWriter, err error) {
+	switch err {
+	case errorcodes.ErrVideoNotFound, errorcodes.ErrPlaylistNotFound, errorcodes.ErrUploadNotFound,
+		errorcodes.ErrTrackNotFound, errorcodes.ErrLiveStreamNotFound, errorcodes.ErrAssetNotFound:
+		JSONResponse(
+			w, http.StatusNotFound,
+			Response{
+				Message: "Not found",
+				Status:  http.StatusNotFound,
+			},
+		)
+	case errorcodes.ErrIngestionNotRetryable, errorcodes.ErrPlaybackNotSigned, errorcodes.ErrNoPlayback,
+		errorcodes.ErrAssetNotReady:
+		JSONResponse(
+			w, http.StatusConflict,
+			Response{
+				Message: "Conflict",
+				Status:  http.StatusConflict,
+			},
+		)
+	case errorcodes.ErrInvalidImageOptions, errorcodes.ErrInvalidCursor:
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+	case errorcodes.ErrVideoUnprocessable, errorcodes.ErrInvalidID, errorcodes.ErrInvalidTokenRequest:
+		JSONResponse(
+			w, http.StatusUnprocessableEntity,
+			Response{
+				Message: "Unprocessable entity",
+				Status:  http.StatusUnprocessableEntity,
+			},
+		)
+	default:
 		JSONResponse(
 			w, http.StatusInternalServerError,
 			Response{
@@ -133,7 +816,5 @@ func (c controller) List(w http.ResponseWriter, r *http.Request) {
 				Status:  http.StatusInternalServerError,
 			},
 		)
-		return
 	}
-	JSONResponse(w, http.StatusOK, videos)
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -47,6 +48,14 @@ func TestVideoController_Create(t *testing.T) {
 			expectedCode: http.StatusUnprocessableEntity,
 			expectedBody: `{"message":"Unprocessable entity","status":422}`,
 		},
+		{
+			name:         "Unknown policy",
+			body:         `{"title":"Some Might Say","source_url":"https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4","policy":"private"}`,
+			video:        model.Video{},
+			wantedError:  errorcodes.ErrVideoUnprocessable,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable entity","status":422}`,
+		},
 		{
 			name:         "Error",
 			body:         `{"description":"(What's the Story) Morning Glory?"}`,
@@ -106,6 +115,8 @@ func TestVideoController_GetByID(t *testing.T) {
 		expectedBody string
 		video        model.Video
 		wantedError  error
+		query        string
+		ttl          time.Duration
 	}{
 		{
 			"Success",
@@ -114,6 +125,28 @@ func TestVideoController_GetByID(t *testing.T) {
 			`{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say","description":"Oasis song from (What's the Story) Morning Glory? album."}`,
 			completeVideo,
 			nil,
+			"",
+			0,
+		},
+		{
+			"Token TTL",
+			"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885",
+			200,
+			`{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say","description":"Oasis song from (What's the Story) Morning Glory? album."}`,
+			completeVideo,
+			nil,
+			"?token_ttl=600",
+			10 * time.Minute,
+		},
+		{
+			"Bad token TTL",
+			"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885",
+			400,
+			`{"message":"Bad request","status":400}`,
+			model.Video{},
+			nil,
+			"?token_ttl=-5",
+			0,
 		},
 		{
 			"Bad ID",
@@ -122,6 +155,8 @@ func TestVideoController_GetByID(t *testing.T) {
 			`{"message":"Unprocessable Entity","status":422}`,
 			model.Video{},
 			nil,
+			"",
+			0,
 		},
 		{
 			"Not found",
@@ -130,6 +165,8 @@ func TestVideoController_GetByID(t *testing.T) {
 			`{"message":"Not found","status":404}`,
 			model.Video{},
 			errorcodes.ErrVideoNotFound,
+			"",
+			0,
 		},
 		{
 			"Error",
@@ -138,6 +175,8 @@ func TestVideoController_GetByID(t *testing.T) {
 			`{"message":"Internal server error","status":500}`,
 			model.Video{},
 			errors.New("failed"),
+			"",
+			0,
 		},
 	}
 	for _, tt := range tests {
@@ -147,7 +186,7 @@ func TestVideoController_GetByID(t *testing.T) {
 				delivery: delivery,
 			}
 
-			r, _ := http.NewRequest("GET", "/videos/abcd", nil)
+			r, _ := http.NewRequest("GET", "/videos/abcd"+tt.query, nil)
 			w := httptest.NewRecorder()
 
 			r = mux.SetURLVars(r, map[string]string{
@@ -156,9 +195,9 @@ func TestVideoController_GetByID(t *testing.T) {
 
 			ctx := r.Context()
 
-			// Only set up mock expectations for valid IDs (36 chars)
-			if len(tt.id) == 36 {
-				delivery.On("GetByID", ctx, tt.id).Return(tt.video, tt.wantedError)
+			// Only set up mock expectations for valid IDs (36 chars) and TTLs
+			if len(tt.id) == 36 && tt.expectedCode != 400 {
+				delivery.On("GetByID", ctx, tt.id, tt.ttl).Return(tt.video, tt.wantedError)
 			}
 
 			controller.GetByID(w, r)
@@ -192,7 +231,7 @@ func TestVideoController_List(t *testing.T) {
 	}{
 		{
 			name:         "Success",
-			url:          "/videos",
+			url:          "/videos?page=1",
 			page:         1,
 			limit:        10,
 			mockReturn:   videos,
@@ -212,7 +251,7 @@ func TestVideoController_List(t *testing.T) {
 		},
 		{
 			name:         "Internal error",
-			url:          "/videos",
+			url:          "/videos?page=1",
 			page:         1,
 			limit:        10,
 			mockReturn:   nil,
@@ -225,7 +264,8 @@ func TestVideoController_List(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			delivery := NewMockDelivery(t)
-			delivery.On("List", mock.Anything, tt.page, tt.limit).Return(tt.mockReturn, tt.mockError)
+			delivery.On("List", mock.Anything, model.ListOptions{Page: tt.page, Limit: tt.limit}).
+				Return(model.VideoList{Items: tt.mockReturn}, tt.mockError)
 			controller := &controller{delivery: delivery}
 
 			r, _ := http.NewRequest("GET", tt.url, nil)
@@ -235,6 +275,9 @@ func TestVideoController_List(t *testing.T) {
 
 			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
 			if tt.mockError == nil {
+				assert.Equal(t, "true", w.Header().Get("Deprecation"), "Page mode should be deprecated")
+				assert.Contains(t, w.Header().Get("Link"), `rel="successor-version"`)
+
 				var got []model.Video
 				err := json.Unmarshal(w.Body.Bytes(), &got)
 				assert.NoError(t, err, "Should unmarshal response body without errors")
@@ -249,3 +292,874 @@ func TestVideoController_List(t *testing.T) {
 		})
 	}
 }
+
+func TestVideoController_ListByCursor(t *testing.T) {
+	list := model.VideoList{
+		Items:      []model.Video{{ID: "id1", Title: "Video 1"}},
+		NextCursor: "next",
+	}
+	total := int64(1)
+	hasAsset := true
+
+	tests := []struct {
+		name         string
+		url          string
+		callDelivery bool
+		opts         model.ListOptions
+		mockReturn   model.VideoList
+		mockError    error
+		expectedCode int
+		expectedLink string
+	}{
+		{
+			name:         "First page",
+			url:          "/videos",
+			callDelivery: true,
+			opts:         model.ListOptions{Limit: 10},
+			mockReturn:   list,
+			expectedCode: http.StatusOK,
+			expectedLink: `</videos?limit=10>; rel="first", </videos?cursor=next&limit=10>; rel="next"`,
+		},
+		{
+			name:         "Last page with total",
+			url:          "/videos?cursor=abc&limit=500&total=true",
+			callDelivery: true,
+			opts:         model.ListOptions{Cursor: "abc", Limit: maxLimit, Total: true},
+			mockReturn:   model.VideoList{Items: []model.Video{}, Total: &total},
+			expectedCode: http.StatusOK,
+			expectedLink: `</videos?limit=100&total=true>; rel="first"`,
+		},
+		{
+			name:         "Sort and filters",
+			url:          "/videos?sort=-created_at&status=ready&policy=signed&has_asset=true&created_after=2025-01-01T00:00:00Z&created_before=2025-02-01T00:00:00Z",
+			callDelivery: true,
+			opts: model.ListOptions{
+				Limit:         10,
+				Sort:          "-created_at",
+				Status:        "ready",
+				Policy:        "signed",
+				HasAsset:      &hasAsset,
+				CreatedAfter:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				CreatedBefore: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+			mockReturn:   model.VideoList{Items: []model.Video{}},
+			expectedCode: http.StatusOK,
+			expectedLink: `</videos?created_after=2025-01-01T00%3A00%3A00Z&created_before=2025-02-01T00%3A00%3A00Z&has_asset=true&limit=10&policy=signed&sort=-created_at&status=ready>; rel="first"`,
+		},
+		{
+			name:         "Tags and metadata",
+			url:          "/videos?tag=britpop&tag=summer&metadata.campaign=launch",
+			callDelivery: true,
+			opts: model.ListOptions{
+				Limit:    10,
+				Tags:     []string{"britpop", "summer"},
+				Metadata: map[string]string{"campaign": "launch"},
+			},
+			mockReturn:   model.VideoList{Items: []model.Video{}},
+			expectedCode: http.StatusOK,
+			expectedLink: `</videos?limit=10&metadata.campaign=launch&tag=britpop&tag=summer>; rel="first"`,
+		},
+		{
+			name:         "Invalid date",
+			url:          "/videos?created_after=yesterday",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Invalid has_asset",
+			url:          "/videos?has_asset=maybe",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Invalid sort",
+			url:          "/videos?sort=views",
+			callDelivery: true,
+			opts:         model.ListOptions{Limit: 10, Sort: "views"},
+			mockError:    errorcodes.ErrInvalidListOptions,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Invalid cursor",
+			url:          "/videos?cursor=abc",
+			callDelivery: true,
+			opts:         model.ListOptions{Cursor: "abc", Limit: 10},
+			mockError:    errorcodes.ErrInvalidCursor,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Internal error",
+			url:          "/videos",
+			callDelivery: true,
+			opts:         model.ListOptions{Limit: 10},
+			mockError:    assert.AnError,
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewMockDelivery(t)
+			if tt.callDelivery {
+				delivery.On("List", mock.Anything, tt.opts).Return(tt.mockReturn, tt.mockError)
+			}
+			controller := &controller{delivery: delivery}
+
+			r, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+
+			controller.List(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedLink, w.Header().Get("Link"), "Link header should match")
+			if tt.expectedCode == http.StatusOK {
+				var got model.VideoList
+				err := json.Unmarshal(w.Body.Bytes(), &got)
+				assert.NoError(t, err, "Should unmarshal response body without errors")
+				assert.Equal(t, tt.mockReturn, got, "Response should match expected list")
+			}
+		})
+	}
+}
+
+func TestVideoController_Update(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	video := model.Video{
+		ID:          uuid,
+		Title:       "Some Might Say",
+		Description: "(What's the Story) Morning Glory?",
+	}
+
+	tests := []struct {
+		name         string
+		id           string
+		body         string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success",
+			id:           uuid,
+			body:         `{"title":"Some Might Say","description":"(What's the Story) Morning Glory?"}`,
+			callUsecase:  true,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say","description":"(What's the Story) Morning Glory?"}`,
+		},
+		{
+			name:         "Bad ID",
+			id:           "123",
+			body:         `{}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable Entity","status":422}`,
+		},
+		{
+			name:         "Bad request",
+			id:           uuid,
+			body:         `{"title":23,"description":?",}`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"message":"Bad request","status":400}`,
+		},
+		{
+			name:         "Unprocessable",
+			id:           uuid,
+			body:         `{"description":"(What's the Story) Morning Glory?"}`,
+			callUsecase:  true,
+			wantedError:  errorcodes.ErrVideoUnprocessable,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable entity","status":422}`,
+		},
+		{
+			name:         "Not found",
+			id:           uuid,
+			body:         `{"title":"Some Might Say","description":"(What's the Story) Morning Glory?"}`,
+			callUsecase:  true,
+			wantedError:  errorcodes.ErrVideoNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"message":"Not found","status":404}`,
+		},
+		{
+			name:         "Asset not found",
+			id:           uuid,
+			body:         `{"title":"Some Might Say","description":"(What's the Story) Morning Glory?","allow_download":true}`,
+			callUsecase:  true,
+			wantedError:  errorcodes.ErrAssetNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"message":"Not found","status":404}`,
+		},
+		{
+			name:         "Error",
+			id:           uuid,
+			body:         `{"title":"Some Might Say","description":"(What's the Story) Morning Glory?"}`,
+			callUsecase:  true,
+			wantedError:  errors.New("failed"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"message":"Internal server error","status":500}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestion := NewMockIngestion(t)
+			controller := &controller{
+				ingestion: ingestion,
+			}
+
+			r, _ := http.NewRequest("PUT", "/videos/abcd", bytes.NewBuffer([]byte(tt.body)))
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				ingestion.On("Update", r.Context(), mock.MatchedBy(func(v model.Video) bool {
+					return v.ID == tt.id
+				})).Return(video, tt.wantedError)
+			}
+
+			controller.Update(w, r)
+
+			assert.Equal(t, "application/json; charset=UTF-8", w.Header().Get("Content-Type"), "Should return JSON content type")
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_Patch(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	video := model.Video{
+		ID:          uuid,
+		Title:       "Some Might Say",
+		Description: "(What's the Story) Morning Glory?",
+	}
+
+	tests := []struct {
+		name         string
+		id           string
+		body         string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success",
+			id:           uuid,
+			body:         `{"title":"Some Might Say"}`,
+			callUsecase:  true,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say","description":"(What's the Story) Morning Glory?"}`,
+		},
+		{
+			name:         "Bad ID",
+			id:           "123",
+			body:         `{}`,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable Entity","status":422}`,
+		},
+		{
+			name:         "Not an object",
+			id:           uuid,
+			body:         `["title"]`,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"message":"Bad request","status":400}`,
+		},
+		{
+			name:         "Unprocessable",
+			id:           uuid,
+			body:         `{"title":null}`,
+			callUsecase:  true,
+			wantedError:  errorcodes.ErrVideoUnprocessable,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable entity","status":422}`,
+		},
+		{
+			name:         "Not found",
+			id:           uuid,
+			body:         `{"title":"Some Might Say"}`,
+			callUsecase:  true,
+			wantedError:  errorcodes.ErrVideoNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"message":"Not found","status":404}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestion := NewMockIngestion(t)
+			controller := &controller{
+				ingestion: ingestion,
+			}
+
+			r, _ := http.NewRequest("PATCH", "/videos/abcd", bytes.NewBuffer([]byte(tt.body)))
+			r.Header.Set("Content-Type", "application/merge-patch+json")
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				ingestion.On("Patch", r.Context(), tt.id, []byte(tt.body)).Return(video, tt.wantedError)
+			}
+
+			controller.Patch(w, r)
+
+			assert.Equal(t, "application/json; charset=UTF-8", w.Header().Get("Content-Type"), "Should return JSON content type")
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_Delete(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+
+	tests := []struct {
+		name         string
+		id           string
+		query        string
+		permanent    bool
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Move to trash",
+			id:           uuid,
+			callUsecase:  true,
+			expectedCode: http.StatusNoContent,
+			expectedBody: ``,
+		},
+		{
+			name:         "Permanent",
+			id:           uuid,
+			query:        "?permanent=true",
+			permanent:    true,
+			callUsecase:  true,
+			expectedCode: http.StatusNoContent,
+			expectedBody: ``,
+		},
+		{
+			name:         "Bad ID",
+			id:           "123",
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable Entity","status":422}`,
+		},
+		{
+			name:         "Not found",
+			id:           uuid,
+			callUsecase:  true,
+			wantedError:  errorcodes.ErrVideoNotFound,
+			expectedCode: http.StatusNotFound,
+			expectedBody: `{"message":"Not found","status":404}`,
+		},
+		{
+			name:         "Error",
+			id:           uuid,
+			callUsecase:  true,
+			wantedError:  errors.New("failed"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"message":"Internal server error","status":500}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestion := NewMockIngestion(t)
+			controller := &controller{
+				ingestion: ingestion,
+			}
+
+			r, _ := http.NewRequest("DELETE", "/videos/abcd"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				ingestion.On("Delete", r.Context(), tt.id, tt.permanent).Return(tt.wantedError)
+			}
+
+			controller.Delete(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_Search(t *testing.T) {
+	result := model.SearchResult{
+		Query: "glory",
+		Items: []model.SearchHit{{
+			Video:      model.Video{ID: "id1", Title: "Morning Glory"},
+			Score:      1.1,
+			Highlights: map[string]string{"title": "Morning <em>Glory</em>"},
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		url          string
+		query        string
+		limit        int
+		mockReturn   model.SearchResult
+		mockError    error
+		expectedCode int
+	}{
+		{"Success", "/videos/search?q=glory", "glory", 0, result, nil, http.StatusOK},
+		{"With limit", "/videos/search?q=glory&limit=5", "glory", 5, result, nil, http.StatusOK},
+		{"Invalid query", "/videos/search", "", 0, model.SearchResult{}, errorcodes.ErrInvalidQuery, http.StatusBadRequest},
+		{"Internal error", "/videos/search?q=glory", "glory", 0, model.SearchResult{}, assert.AnError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewMockDelivery(t)
+			delivery.On("Search", mock.Anything, tt.query, tt.limit).Return(tt.mockReturn, tt.mockError)
+			controller := &controller{delivery: delivery}
+
+			r, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+
+			controller.Search(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			if tt.mockError == nil {
+				var got model.SearchResult
+				err := json.Unmarshal(w.Body.Bytes(), &got)
+				assert.NoError(t, err, "Should unmarshal response body without errors")
+				assert.Equal(t, tt.mockReturn, got, "Response should match expected result")
+			}
+		})
+	}
+}
+
+func TestVideoController_ListTrash(t *testing.T) {
+	videos := []model.Video{
+		{ID: "id1", Title: "Video 1", DeletedAt: "2025-01-01 00:00:00 +0000 UTC"},
+	}
+
+	videosJSON, _ := json.Marshal(videos)
+
+	tests := []struct {
+		name         string
+		url          string
+		page         int
+		limit        int
+		mockReturn   []model.Video
+		mockError    error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", "/videos/trash", 1, 10, videos, nil, http.StatusOK, string(videosJSON)},
+		{"With pagination params", "/videos/trash?page=3&limit=2", 3, 2, videos, nil, http.StatusOK, string(videosJSON)},
+		{"Internal error", "/videos/trash", 1, 10, nil, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewMockDelivery(t)
+			delivery.On("ListTrash", mock.Anything, tt.page, tt.limit).Return(tt.mockReturn, tt.mockError)
+			controller := &controller{delivery: delivery}
+
+			r, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+
+			controller.ListTrash(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_Restore(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	video := model.Video{ID: uuid, Title: "Some Might Say"}
+
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", uuid, true, nil, http.StatusOK, `{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say"}`},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Not in trash", uuid, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestion := NewMockIngestion(t)
+			controller := &controller{
+				ingestion: ingestion,
+			}
+
+			r, _ := http.NewRequest("POST", "/videos/abcd/restore", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				ingestion.On("Restore", r.Context(), tt.id).Return(video, tt.wantedError)
+			}
+
+			controller.Restore(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_RetryIngestion(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	video := model.Video{ID: uuid, Title: "Some Might Say", Ingestion: &model.JobStatus{Status: model.JobPending}}
+
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", uuid, true, nil, http.StatusAccepted, `{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say","ingestion":{"status":"pending"}}`},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Not failed", uuid, true, errorcodes.ErrIngestionNotRetryable, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"Not found", uuid, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", uuid, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestion := NewMockIngestion(t)
+			controller := &controller{
+				ingestion: ingestion,
+			}
+
+			r, _ := http.NewRequest("POST", "/videos/abcd/retry-ingestion", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				ingestion.On("RetryIngestion", r.Context(), tt.id).Return(video, tt.wantedError)
+			}
+
+			controller.RetryIngestion(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_EnableDownload(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	video := model.Video{ID: uuid, Title: "Some Might Say", AllowDownload: true}
+
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", uuid, true, nil, http.StatusAccepted, `{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say","allow_download":true}`},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"No asset", uuid, true, errorcodes.ErrNoPlayback, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"Asset not ready", uuid, true, errorcodes.ErrAssetNotReady, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"Not found", uuid, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Asset not found", uuid, true, errorcodes.ErrAssetNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", uuid, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestion := NewMockIngestion(t)
+			controller := &controller{
+				ingestion: ingestion,
+			}
+
+			r, _ := http.NewRequest("POST", "/videos/abcd/renditions", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				ingestion.On("EnableDownload", r.Context(), tt.id).Return(video, tt.wantedError)
+			}
+
+			controller.EnableDownload(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_CreateClip(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	clip := model.Video{ID: "clip", Title: "Live Forever", MasterID: uuid, Clip: &model.Clip{StartTime: 30, EndTime: 60}}
+
+	tests := []struct {
+		name         string
+		id           string
+		body         string
+		request      model.ClipRequest
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", uuid, `{"start_time":30,"end_time":60}`, model.ClipRequest{StartTime: 30, EndTime: 60}, true, nil, http.StatusCreated, `{"id":"clip","title":"Live Forever","master_id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","clip":{"start_time":30,"end_time":60}}`},
+		{"Bad body", uuid, `{"start_time":`, model.ClipRequest{}, false, nil, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Bad ID", "123", `{}`, model.ClipRequest{}, false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Invalid times", uuid, `{"start_time":60,"end_time":30}`, model.ClipRequest{StartTime: 60, EndTime: 30}, true, errorcodes.ErrVideoUnprocessable, http.StatusUnprocessableEntity, `{"message":"Unprocessable entity","status":422}`},
+		{"Asset not ready", uuid, `{"start_time":30,"end_time":60}`, model.ClipRequest{StartTime: 30, EndTime: 60}, true, errorcodes.ErrAssetNotReady, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"Not found", uuid, `{"start_time":30,"end_time":60}`, model.ClipRequest{StartTime: 30, EndTime: 60}, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", uuid, `{"start_time":30,"end_time":60}`, model.ClipRequest{StartTime: 30, EndTime: 60}, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestion := NewMockIngestion(t)
+			controller := &controller{
+				ingestion: ingestion,
+			}
+
+			r, _ := http.NewRequest("POST", "/videos/abcd/clips", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				ingestion.On("CreateClip", r.Context(), tt.id, tt.request).Return(clip, tt.wantedError)
+			}
+
+			controller.CreateClip(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_ListClips(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	clips := model.VideoList{Items: []model.Video{{ID: "clip", Title: "Live Forever", MasterID: uuid}}}
+	next := model.VideoList{Items: clips.Items, NextCursor: "next"}
+	body := `{"items":[{"id":"clip","title":"Live Forever","master_id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"}]}`
+
+	tests := []struct {
+		name         string
+		id           string
+		query        string
+		cursor       string
+		limit        int
+		list         model.VideoList
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+		expectedLink string
+	}{
+		{"Success", uuid, "", "", 10, clips, true, nil, http.StatusOK, body, `</videos/abcd/clips?limit=10>; rel="first"`},
+		{"Next page", uuid, "?cursor=abc&limit=5", "abc", 5, next, true, nil, http.StatusOK, `{"items":[{"id":"clip","title":"Live Forever","master_id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"}],"next_cursor":"next"}`, `</videos/abcd/clips?limit=5>; rel="first", </videos/abcd/clips?cursor=next&limit=5>; rel="next"`},
+		{"Limit capped", uuid, "?limit=500", "", 100, clips, true, nil, http.StatusOK, body, `</videos/abcd/clips?limit=100>; rel="first"`},
+		{"Bad ID", "123", "", "", 0, clips, false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`, ""},
+		{"Invalid cursor", uuid, "?cursor=bad", "bad", 10, model.VideoList{}, true, errorcodes.ErrInvalidCursor, http.StatusBadRequest, `{"message":"Bad request","status":400}`, ""},
+		{"Not found", uuid, "", "", 10, model.VideoList{}, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`, ""},
+		{"Internal error", uuid, "", "", 10, model.VideoList{}, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewMockDelivery(t)
+			controller := &controller{
+				delivery: delivery,
+			}
+
+			r, _ := http.NewRequest("GET", "/videos/abcd/clips"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				delivery.On("ListClips", r.Context(), tt.id, tt.cursor, tt.limit).Return(tt.list, tt.wantedError)
+			}
+
+			controller.ListClips(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+			assert.Equal(t, tt.expectedLink, w.Header().Get("Link"), "Link header should match")
+		})
+	}
+}
+
+func TestVideoController_PlaybackToken(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	token := model.PlaybackToken{
+		PlaybackID: "playback",
+		Playback:   "v-token",
+		Thumbnail:  "t-token",
+		Storyboard: "s-token",
+		ExpiresAt:  "2025-01-01T00:15:00Z",
+	}
+
+	tests := []struct {
+		name         string
+		id           string
+		body         string
+		request      model.TokenRequest
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", uuid, `{"viewer_id":"viewer","ttl":600,"claims":{"plan":"pro"}}`, model.TokenRequest{ViewerID: "viewer", TTL: 600, Claims: map[string]interface{}{"plan": "pro"}}, true, nil, http.StatusOK, `{"playback_id":"playback","playback":"v-token","thumbnail":"t-token","storyboard":"s-token","expires_at":"2025-01-01T00:15:00Z"}`},
+		{"Empty body", uuid, "", model.TokenRequest{}, true, nil, http.StatusOK, `{"playback_id":"playback","playback":"v-token","thumbnail":"t-token","storyboard":"s-token","expires_at":"2025-01-01T00:15:00Z"}`},
+		{"Bad body", uuid, `{"ttl":`, model.TokenRequest{}, false, nil, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Bad ID", "123", "", model.TokenRequest{}, false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"Invalid request", uuid, `{"ttl":-1}`, model.TokenRequest{TTL: -1}, true, errorcodes.ErrInvalidTokenRequest, http.StatusUnprocessableEntity, `{"message":"Unprocessable entity","status":422}`},
+		{"Public video", uuid, "", model.TokenRequest{}, true, errorcodes.ErrPlaybackNotSigned, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"No asset", uuid, "", model.TokenRequest{}, true, errorcodes.ErrNoPlayback, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"Not found", uuid, "", model.TokenRequest{}, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+		{"Internal error", uuid, "", model.TokenRequest{}, true, assert.AnError, http.StatusInternalServerError, `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewMockDelivery(t)
+			controller := &controller{
+				delivery: delivery,
+			}
+
+			r, _ := http.NewRequest("POST", "/videos/abcd/playback-token", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				delivery.On("PlaybackToken", r.Context(), tt.id, tt.request).Return(token, tt.wantedError)
+			}
+
+			controller.PlaybackToken(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_Images(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	frame := 12.5
+	image := model.Image{URL: "https://image.mux.com/playback/animated.gif?width=320&start=12.5", Format: "gif", Width: 320, Time: 12.5}
+
+	tests := []struct {
+		name         string
+		id           string
+		query        string
+		opts         model.ImageOptions
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{"Success", uuid, "?width=320&time=12.5&format=gif", model.ImageOptions{Width: 320, Time: &frame, Format: "gif"}, true, nil, http.StatusOK, `{"url":"https://image.mux.com/playback/animated.gif?width=320\u0026start=12.5","format":"gif","width":320,"time":12.5}`},
+		{"Bad width", uuid, "?width=wide", model.ImageOptions{}, false, nil, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Bad time", uuid, "?time=soon", model.ImageOptions{}, false, nil, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Invalid options", uuid, "?format=bmp", model.ImageOptions{Format: "bmp"}, true, errorcodes.ErrInvalidImageOptions, http.StatusBadRequest, `{"message":"Bad request","status":400}`},
+		{"Bad ID", "123", "", model.ImageOptions{}, false, nil, http.StatusUnprocessableEntity, `{"message":"Unprocessable Entity","status":422}`},
+		{"No playback", uuid, "", model.ImageOptions{}, true, errorcodes.ErrNoPlayback, http.StatusConflict, `{"message":"Conflict","status":409}`},
+		{"Not found", uuid, "", model.ImageOptions{}, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, `{"message":"Not found","status":404}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewMockDelivery(t)
+			controller := &controller{
+				delivery: delivery,
+			}
+
+			r, _ := http.NewRequest("GET", "/videos/abcd/images"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				delivery.On("Images", r.Context(), tt.id, tt.opts).Return(image, tt.wantedError)
+			}
+
+			controller.Images(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
+
+func TestVideoController_Chapters(t *testing.T) {
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	chapters := "WEBVTT\n\n1\n00:00:00.000 --> 00:01:30.000\nIntro\n"
+
+	tests := []struct {
+		name         string
+		id           string
+		callUsecase  bool
+		wantedError  error
+		expectedCode int
+		expectedType string
+		expectedBody string
+	}{
+		{"Success", uuid, true, nil, http.StatusOK, "text/vtt; charset=UTF-8", chapters},
+		{"Bad ID", "123", false, nil, http.StatusUnprocessableEntity, "application/json; charset=UTF-8", `{"message":"Unprocessable Entity","status":422}`},
+		{"Asset not ready", uuid, true, errorcodes.ErrAssetNotReady, http.StatusConflict, "application/json; charset=UTF-8", `{"message":"Conflict","status":409}`},
+		{"Not found", uuid, true, errorcodes.ErrVideoNotFound, http.StatusNotFound, "application/json; charset=UTF-8", `{"message":"Not found","status":404}`},
+		{"Internal error", uuid, true, assert.AnError, http.StatusInternalServerError, "application/json; charset=UTF-8", `{"message":"Internal server error","status":500}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewMockDelivery(t)
+			controller := &controller{
+				delivery: delivery,
+			}
+
+			r, _ := http.NewRequest("GET", "/videos/abcd/chapters.vtt", nil)
+			w := httptest.NewRecorder()
+
+			r = mux.SetURLVars(r, map[string]string{
+				"id": tt.id,
+			})
+
+			if tt.callUsecase {
+				response := chapters
+				if tt.wantedError != nil {
+					response = ""
+				}
+				delivery.On("ChaptersVTT", r.Context(), tt.id).Return(response, tt.wantedError)
+			}
+
+			controller.Chapters(w, r)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"), "Content type should match expected")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+)
+
+// maxWebhookSize limits the webhook body read into memory
+const maxWebhookSize = 1 << 20
+
+// Webhook controller receives Mux.com events
+func (c controller) Webhook(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookSize))
+	if err != nil {
+		JSONResponse(
+			w, http.StatusBadRequest,
+			Response{
+				Message: "Bad request",
+				Status:  http.StatusBadRequest,
+			},
+		)
+		return
+	}
+
+	err = c.notifications.Receive(r.Context(), payload, r.Header.Get("Mux-Signature"))
+
+	if err != nil {
+		// Look for Custom Error
+		if err == errorcodes.ErrInvalidSignature {
+			JSONResponse(
+				w, http.StatusUnauthorized,
+				Response{
+					Message: "Unauthorized",
+					Status:  http.StatusUnauthorized,
+				},
+			)
+			return
+		}
+
+		if err == errorcodes.ErrVideoUnprocessable {
+			JSONResponse(
+				w, http.StatusUnprocessableEntity,
+				Response{
+					Message: "Unprocessable entity",
+					Status:  http.StatusUnprocessableEntity,
+				},
+			)
+			return
+		}
+
+		JSONResponse(
+			w, http.StatusInternalServerError,
+			Response{
+				Message: "Internal server error",
+				Status:  http.StatusInternalServerError,
+			},
+		)
+		return
+	}
+
+	JSONResponse(
+		w,
+		http.StatusOK,
+		Response{
+			Message: "OK",
+			Status:  http.StatusOK,
+		},
+	)
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+)
+
+func TestVideoController_Webhook(t *testing.T) {
+	body := `{"type":"video.asset.ready","data":{"id":"dd0f697463174c0ca57800847f8559d7"}}`
+	signature := "t=1565125718,v1=854ece4c22acef7c66b57d4e504153bc512595e8e9c772ece2a68150548c19a7"
+
+	tests := []struct {
+		name         string
+		wantedError  error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Success",
+			wantedError:  nil,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"message":"OK","status":200}`,
+		},
+		{
+			name:         "Invalid signature",
+			wantedError:  errorcodes.ErrInvalidSignature,
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: `{"message":"Unauthorized","status":401}`,
+		},
+		{
+			name:         "Unprocessable",
+			wantedError:  errorcodes.ErrVideoUnprocessable,
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable entity","status":422}`,
+		},
+		{
+			name:         "Error",
+			wantedError:  errors.New("failed"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"message":"Internal server error","status":500}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications := NewMockNotifications(t)
+			controller := &controller{
+				notifications: notifications,
+			}
+
+			r, _ := http.NewRequest("POST", "/webhooks/mux", bytes.NewBuffer([]byte(body)))
+			r.Header.Set("Mux-Signature", signature)
+			w := httptest.NewRecorder()
+
+			notifications.On("Receive", r.Context(), []byte(body), signature).Return(tt.wantedError)
+
+			controller.Webhook(w, r)
+
+			assert.Equal(t, "application/json; charset=UTF-8", w.Header().Get("Content-Type"), "Should return JSON content type")
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+		})
+	}
+}
@@ -16,3 +16,60 @@ var ErrVideoUnprocessable = errors.New("unprocessable Entity")
 
 // ErrInvalidID definition
 var ErrInvalidID = errors.New("invalid ID format")
+
+// ErrInvalidSignature definition
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrInvalidCursor definition
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrInvalidListOptions definition
+var ErrInvalidListOptions = errors.New("invalid list options")
+
+// ErrInvalidQuery definition
+var ErrInvalidQuery = errors.New("invalid search query")
+
+// ErrPlaylistNotFound definition
+var ErrPlaylistNotFound = errors.New("playlist not found")
+
+// ErrUploadNotFound definition
+var ErrUploadNotFound = errors.New("upload not found")
+
+// ErrJobNotFound definition
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrIngestionNotRetryable definition
+var ErrIngestionNotRetryable = errors.New("ingestion not retryable")
+
+// ErrInvalidTokenTTL definition
+var ErrInvalidTokenTTL = errors.New("invalid token TTL")
+
+// ErrPlaybackNotSigned definition
+var ErrPlaybackNotSigned = errors.New("playback is not signed")
+
+// ErrInvalidTokenRequest definition
+var ErrInvalidTokenRequest = errors.New("invalid token request")
+
+// ErrNoPlayback definition
+var ErrNoPlayback = errors.New("video has no playback")
+
+// ErrInvalidImageOptions definition
+var ErrInvalidImageOptions = errors.New("invalid image options")
+
+// ErrAssetNotReady definition
+var ErrAssetNotReady = errors.New("asset not ready")
+
+// ErrTrackNotFound definition
+var ErrTrackNotFound = errors.New("track not found")
+
+// ErrInvalidTrack definition
+var ErrInvalidTrack = errors.New("invalid track")
+
+// ErrCaptionNotFound definition
+var ErrCaptionNotFound = errors.New("caption not found")
+
+// ErrLiveStreamNotFound definition
+var ErrLiveStreamNotFound = errors.New("live stream not found")
+
+// ErrLiveStreamsDisabled definition
+var ErrLiveStreamsDisabled = errors.New("live streams are disabled")
@@ -1,6 +1,7 @@
 package idlemux
 
 import (
+	"context"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -10,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	"github.com/javiertlopez/idlemux/controller"
+	"github.com/javiertlopez/idlemux/model"
 	"github.com/javiertlopez/idlemux/mongodb"
 	"github.com/javiertlopez/idlemux/muxinc"
 	"github.com/javiertlopez/idlemux/router"
@@ -17,30 +19,155 @@ import (
 )
 
 const (
-	Database     = "delivery"       // Database keeps the database name
-	mongoTimeout = 15 * time.Second // mongotimeout
+	Database       = "delivery"          // Database keeps the database name
+	mongoTimeout   = 15 * time.Second    // mongotimeout
+	trashRetention = 30 * 24 * time.Hour // default time trashed videos are kept
+	purgeInterval  = time.Hour           // how often trashed videos are purged
+	uploadInterval = time.Minute         // how often waiting uploads are polled
+	ingestInterval = 5 * time.Second     // how often workers look for due ingestion jobs
+	ingestWorkers  = 4                   // number of ingestion workers
+	sweepInterval  = 6 * time.Hour       // how often orphaned Mux.com assets are removed
+	namespace      = "idlemux"           // default passthrough namespace of the assets
 )
 
+// mediaRepository is the Mux.com implementation of assets, direct uploads and live streams
+type mediaRepository interface {
+	usecase.Assets
+	usecase.DirectUploads
+	usecase.LiveStreams
+}
+
 // App holds the handler, and logger
 type App struct {
 	logger *logrus.Logger
 	router *mux.Router
 }
 
+// Maintenance holds the one-shot administrative tasks
+type Maintenance struct {
+	logger   *logrus.Logger
+	backfill interface {
+		Run(ctx context.Context) (model.BackfillReport, error)
+	}
+	reconcile interface {
+		Run(ctx context.Context, fix bool) (model.ReconcileReport, error)
+	}
+}
+
 // AppConfig struct with configuration variables
 type AppConfig struct {
-	Commit         string
-	Version        string
-	MongoURI       string
-	MuxTokenID     string
-	MuxTokenSecret string
-	MuxKeyID       string
-	MuxKeySecret   string
-	Test           bool
+	Commit           string
+	Version          string
+	MongoURI         string
+	MuxTokenID       string
+	MuxTokenSecret   string
+	MuxKeyID         string
+	MuxKeySecret     string
+	MuxWebhookSecret string
+	StreamKeySecret  string
+	PublicURL        string
+	Namespace        string
+	TrashRetention   time.Duration
+	SigningPolicy    muxinc.SigningPolicy
+	Test             bool
 }
 
 // New returns an App
 func New(config AppConfig, logger *logrus.Logger) App {
+	assets, videos := repositories(config, logger)
+
+	// Init delivery usecase
+	delivery := usecase.Delivery(assets, videos, logger)
+
+	// Init ingestion usecase
+	ingestion := usecase.Ingestion(assets, videos, videos, videos, logger)
+
+	// Init ingester usecase, the workers send queued source files to Mux.com
+	ingester := usecase.Ingester(assets, videos, videos, videos, config.namespace(), logger)
+	for range ingestWorkers {
+		go ingester.Run(context.Background(), ingestInterval)
+	}
+
+	// Init purger usecase, hard deletes trashed videos after the retention
+	retention := config.TrashRetention
+	if retention <= 0 {
+		retention = trashRetention
+	}
+	purger := usecase.Purger(assets, videos, videos, retention, logger)
+	go purger.Run(context.Background(), purgeInterval)
+
+	// Init sweeper usecase, removes the assets no video ended up linking. The
+	// replicas share a lease so a single one sweeps.
+	sweeper := usecase.Sweeper(assets, videos, videos, videos, config.namespace(), logger)
+	go sweeper.Run(context.Background(), sweepInterval)
+
+	// Init notifications usecase
+	notifications := usecase.Notifications(videos, videos, videos, videos, config.MuxWebhookSecret, config.namespace(), logger)
+
+	// Init collections usecase
+	collections := usecase.Collections(assets, videos, videos, logger)
+
+	// Init uploader usecase, polls the uploads whose webhook never arrived
+	uploader := usecase.Uploader(assets, videos, videos, config.namespace(), logger)
+	go uploader.Run(context.Background(), uploadInterval)
+
+	// Init tracks usecase, uploaded caption files are served from the public URL
+	tracks := usecase.Tracks(assets, videos, videos, config.PublicURL, logger)
+
+	// Init broadcaster usecase, the stream keys are sealed with the secret
+	broadcaster := usecase.Broadcaster(assets, videos, config.StreamKeySecret, logger)
+
+	// Init controller
+	controller := controller.New(config.Commit, config.Version, delivery, ingestion, notifications, collections, uploader, tracks, broadcaster)
+
+	// Setup router
+	router := router.New(controller, videos)
+
+	return App{
+		logger: logger,
+		router: router,
+	}
+}
+
+// NewMaintenance returns the administrative tasks, no background workers are started
+func NewMaintenance(config AppConfig, logger *logrus.Logger) Maintenance {
+	assets, videos := repositories(config, logger)
+
+	return Maintenance{
+		logger:    logger,
+		backfill:  usecase.Backfill(assets, videos, logger),
+		reconcile: usecase.Reconciler(assets, videos, videos, config.namespace(), logger),
+	}
+}
+
+// namespace returns the passthrough namespace of the assets of the deployment,
+// deployments sharing a Mux.com environment only sweep their own assets
+func (c AppConfig) namespace() string {
+	if c.Namespace == "" {
+		return namespace
+	}
+
+	return c.Namespace
+}
+
+// Router returns the *mux.Router
+func (a *App) Router() *mux.Router {
+	return a.router
+}
+
+// Backfill hydrates existing videos with the asset state stored in Mux.com
+func (m *Maintenance) Backfill(ctx context.Context) (model.BackfillReport, error) {
+	return m.backfill.Run(ctx)
+}
+
+// Reconcile compares the videos with the Mux.com assets, with fix the orphaned
+// assets are deleted and the broken videos marked
+func (m *Maintenance) Reconcile(ctx context.Context, fix bool) (model.ReconcileReport, error) {
+	return m.reconcile.Run(ctx, fix)
+}
+
+// repositories connects to MongoDB and Mux.com
+func repositories(config AppConfig, logger *logrus.Logger) (mediaRepository, *mongodb.DB) {
 	// Set client options
 	clientOptions := options.Client().ApplyURI(config.MongoURI)
 
@@ -53,7 +180,7 @@ func New(config AppConfig, logger *logrus.Logger) App {
 	db := client.Database(Database)
 
 	// Init mux repository
-	assets := muxinc.New(
+	assets, err := muxinc.New(
 		logger,
 		muxgo.NewAPIClient(
 			muxgo.NewConfiguration(
@@ -61,34 +188,32 @@ func New(config AppConfig, logger *logrus.Logger) App {
 			),
 		),
 		muxinc.Config{
-			KeyID:     config.MuxKeyID,
-			KeySecret: config.MuxKeySecret,
-			Test:      config.Test,
+			KeyID:         config.MuxKeyID,
+			KeySecret:     config.MuxKeySecret,
+			SigningPolicy: config.SigningPolicy,
+			Test:          config.Test,
 		},
 	)
+	if err != nil {
+		logger.WithError(err).Error(err.Error())
+		logger.Fatal(err)
+	}
 
 	// Init mongodb repository
 	videos := mongodb.New(logger, db)
 
-	// Init delivery usecase
-	delivery := usecase.Delivery(assets, videos, logger)
-
-	// Init ingestion usecase
-	ingestion := usecase.Ingestion(assets, videos, logger)
-
-	// Init controller
-	controller := controller.New(config.Commit, config.Version, delivery, ingestion)
-
-	// Setup router
-	router := router.New(controller)
+	// A recording is only created once with the unique indexes
+	ctx, cancel := context.WithTimeout(context.Background(), mongoTimeout)
+	defer cancel()
+	if err := videos.EnsureUniqueIndexes(ctx); err != nil {
+		logger.WithError(err).Error("unique indexes were not created")
+		logger.Fatal(err)
+	}
 
-	return App{
-		logger: logger,
-		router: router,
+	// Create the listing indexes, the service still works without them
+	if err := videos.EnsureIndexes(ctx); err != nil {
+		logger.WithError(err).Warn("listing indexes were not created")
 	}
-}
 
-// Router returns the *mux.Router
-func (a *App) Router() *mux.Router {
-	return a.router
+	return assets, videos
 }
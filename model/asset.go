@@ -2,18 +2,64 @@ package model
 
 // Asset Information from Mux
 type Asset struct {
-	ID                  string   `json:"id,omitempty"`
-	URL                 string   `json:"url,omitempty"`
-	CreatedAt           string   `json:"created_at,omitempty"`
-	Status              string   `json:"status,omitempty"`
-	Duration            float64  `json:"duration,omitempty"`
-	MaxStoredResolution string   `json:"max_stored_resolution,omitempty"`
-	MaxStoredFrameRate  float64  `json:"max_stored_frame_rate,omitempty"`
-	AspectRatio         string   `json:"aspect_ratio,omitempty"`
-	Passthrough         string   `json:"passthrough,omitempty"`
-	Poster              string   `json:"poster,omitempty"`
-	Thumbnail           string   `json:"thumbnail,omitempty"`
-	Sources             []Source `json:"sources,omitempty"`
+	ID                  string            `json:"id,omitempty"`
+	URL                 string            `json:"url,omitempty"`
+	CreatedAt           string            `json:"created_at,omitempty"`
+	Status              string            `json:"status,omitempty"`
+	Duration            float64           `json:"duration,omitempty"`
+	MaxStoredResolution string            `json:"max_stored_resolution,omitempty"`
+	MaxStoredFrameRate  float64           `json:"max_stored_frame_rate,omitempty"`
+	AspectRatio         string            `json:"aspect_ratio,omitempty"`
+	Passthrough         string            `json:"passthrough,omitempty"`
+	LiveStreamID        string            `json:"live_stream_id,omitempty"`
+	PlaybackIDs         []PlaybackID      `json:"playback_ids,omitempty"`
+	StaticRenditions    *StaticRenditions `json:"static_renditions,omitempty"`
+	Poster              string            `json:"poster,omitempty"`
+	Thumbnail           string            `json:"thumbnail,omitempty"`
+	Previews            *Previews         `json:"previews,omitempty"`
+	Tracks              []Track           `json:"tracks,omitempty"`
+	Sources             []Source          `json:"sources,omitempty"`
+}
+
+// AssetSettings are the settings of a new asset, the passthrough carries the
+// video ID and GeneratedCaptions the language of the captions Mux.com generates.
+// AudioInputs are ingested as alternate audio tracks of the asset, a Clip
+// cuts the asset from the section of the source asset.
+type AssetSettings struct {
+	Public            bool
+	MP4Support        bool
+	GeneratedCaptions string
+	AudioInputs       []AudioInput
+	Clip              *Clip
+	Passthrough       string
+}
+
+// PlaybackID from Mux
+type PlaybackID struct {
+	ID     string `json:"id"`
+	Policy string `json:"policy"`
+}
+
+// StaticRenditions holds the downloadable MP4 files of an asset
+type StaticRenditions struct {
+	Status string                `json:"status,omitempty"`
+	Files  []StaticRenditionFile `json:"files,omitempty"`
+}
+
+// StaticRenditionFile describes a single MP4/M4A file
+type StaticRenditionFile struct {
+	Name   string `json:"name,omitempty"`
+	Ext    string `json:"ext,omitempty"`
+	Width  int32  `json:"width,omitempty"`
+	Height int32  `json:"height,omitempty"`
+}
+
+// Previews holds the animated previews and the scrubbing storyboards of an asset
+type Previews struct {
+	GIF            string `json:"gif,omitempty"`
+	WebP           string `json:"webp,omitempty"`
+	StoryboardVTT  string `json:"storyboard_vtt,omitempty"`
+	StoryboardJSON string `json:"storyboard_json,omitempty"`
 }
 
 // Source manifests
@@ -0,0 +1,10 @@
+package model
+
+// Chapter marks a section of a video starting at StartTime, in seconds. The
+// thumbnail shows the frame at ThumbnailTime, or at the start when not set.
+type Chapter struct {
+	StartTime     float64  `json:"start_time"`
+	Title         string   `json:"title"`
+	ThumbnailTime *float64 `json:"thumbnail_time,omitempty"`
+	Thumbnail     string   `json:"thumbnail,omitempty"`
+}
@@ -0,0 +1,12 @@
+package model
+
+// Cleanup records a Mux asset that still has to be deleted
+type Cleanup struct {
+	ID        string `json:"id,omitempty"`
+	AssetID   string `json:"asset_id,omitempty"`
+	VideoID   string `json:"video_id,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	Attempts  int    `json:"attempts,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
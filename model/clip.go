@@ -0,0 +1,16 @@
+package model
+
+// Clip is the section of the parent asset a clip video is cut from, in seconds
+type Clip struct {
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+}
+
+// ClipRequest cuts a clip from a video, the title and description of the
+// parent video are used when not given
+type ClipRequest struct {
+	Title       string  `json:"title,omitempty"`
+	Description string  `json:"description,omitempty"`
+	StartTime   float64 `json:"start_time"`
+	EndTime     float64 `json:"end_time"`
+}
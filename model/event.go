@@ -0,0 +1,11 @@
+package model
+
+import "encoding/json"
+
+// Event received from a Mux webhook
+type Event struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt string          `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
@@ -0,0 +1,10 @@
+package model
+
+// IdempotencyKey holds a request sent with an Idempotency-Key header and the
+// response it got, a zero Status means the request is still running
+type IdempotencyKey struct {
+	Key         string `json:"key,omitempty"`
+	RequestHash string `json:"request_hash,omitempty"`
+	Status      int    `json:"status,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
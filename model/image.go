@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// HydrateOptions changes the URLs of a hydrated asset. A zero TokenTTL keeps
+// the signing policy, a nil PosterTime the default frame. The MP4 downloads
+// are only listed with Downloads.
+type HydrateOptions struct {
+	TokenTTL   time.Duration
+	PosterTime *float64
+	Downloads  bool
+}
+
+// ImageOptions describes an image of a video. Zero sizes keep the ones of
+// Mux.com, Time is in seconds.
+type ImageOptions struct {
+	Width  int
+	Height int
+	Time   *float64
+	Format string
+}
+
+// Image is the URL of an image of a video
+type Image struct {
+	URL    string  `json:"url"`
+	Format string  `json:"format"`
+	Width  int     `json:"width,omitempty"`
+	Height int     `json:"height,omitempty"`
+	Time   float64 `json:"time"`
+}
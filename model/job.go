@@ -0,0 +1,35 @@
+package model
+
+// Ingestion job statuses, failed jobs are dead letters waiting for a manual retry
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+)
+
+// Job is a queued ingestion of a video source file into Mux.com
+type Job struct {
+	ID                string       `json:"id,omitempty"`
+	VideoID           string       `json:"video_id,omitempty"`
+	SourceURL         string       `json:"source_url,omitempty"`
+	Public            bool         `json:"public,omitempty"`
+	MP4Support        bool         `json:"mp4_support,omitempty"`
+	GeneratedCaptions string       `json:"generated_captions,omitempty"`
+	AudioInputs       []AudioInput `json:"audio_inputs,omitempty"`
+	Clip              *Clip        `json:"clip,omitempty"`
+	Status            string       `json:"status,omitempty"`
+	Attempts          int          `json:"attempts,omitempty"`
+	Error             string       `json:"error,omitempty"`
+	RunAt             string       `json:"run_at,omitempty"`
+	CreatedAt         string       `json:"created_at,omitempty"`
+	UpdatedAt         string       `json:"updated_at,omitempty"`
+}
+
+// JobStatus is the state of the ingestion job shown on its video
+type JobStatus struct {
+	Status        string `json:"status,omitempty"`
+	Attempts      int    `json:"attempts,omitempty"`
+	Error         string `json:"error,omitempty"`
+	NextAttemptAt string `json:"next_attempt_at,omitempty"`
+}
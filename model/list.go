@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// ListOptions for video listings
+type ListOptions struct {
+	Cursor string
+	Limit  int
+	Total  bool
+
+	// Page selects the deprecated offset pagination when greater than zero
+	Page int
+
+	// Sort is one of created_at, updated_at, title or duration, prefixed
+	// with "-" for descending order
+	Sort string
+
+	Policy        string
+	Status        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	HasAsset      *bool
+
+	// Tags selects the videos having all of them
+	Tags []string
+
+	// Metadata selects the videos with the key and value, numbers and
+	// booleans are matched from their text form
+	Metadata map[string]string
+
+	// MasterID selects the clips cut from the video
+	MasterID string
+}
+
+// VideoList is a page of videos
+type VideoList struct {
+	Items      []Video `json:"items"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+	Total      *int64  `json:"total,omitempty"`
+}
@@ -0,0 +1,33 @@
+package model
+
+// Live stream statuses reported by Mux.com
+const (
+	LiveStreamIdle     = "idle"
+	LiveStreamActive   = "active"
+	LiveStreamDisabled = "disabled"
+)
+
+// LiveStream struct is a Mux.com live stream, the ID is the Mux.com live
+// stream ID. The recording of each broadcast becomes a video with its title,
+// description and policy.
+type LiveStream struct {
+	ID          string       `json:"id,omitempty"`
+	Title       string       `json:"title,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Policy      string       `json:"policy,omitempty"`
+	Status      string       `json:"status,omitempty"`
+	StreamKey   string       `json:"stream_key,omitempty"`
+	IngestURL   string       `json:"ingest_url,omitempty"`
+	PlaybackIDs []PlaybackID `json:"playback_ids,omitempty"`
+	Poster      string       `json:"poster,omitempty"`
+	Thumbnail   string       `json:"thumbnail,omitempty"`
+	Sources     []Source     `json:"sources,omitempty"`
+	CreatedAt   string       `json:"created_at,omitempty"`
+	UpdatedAt   string       `json:"updated_at,omitempty"`
+}
+
+// LiveStreamList is a page of live streams
+type LiveStreamList struct {
+	Items      []LiveStream `json:"items"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,12 @@
+package model
+
+// Playlist struct is an ordered collection of videos
+type Playlist struct {
+	ID          string   `json:"id,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	VideoIDs    []string `json:"video_ids"`
+	Videos      []Video  `json:"videos,omitempty"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+}
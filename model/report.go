@@ -0,0 +1,31 @@
+package model
+
+// BackfillReport summarizes a backfill run
+type BackfillReport struct {
+	Scanned int `json:"scanned"`
+	Updated int `json:"updated"`
+	Missing int `json:"missing"`
+	Failed  int `json:"failed"`
+}
+
+// ReconcileReport lists the differences found between the videos and the
+// Mux.com assets
+type ReconcileReport struct {
+	Assets           int        `json:"assets"`
+	Videos           int        `json:"videos"`
+	OrphanedAssets   []Mismatch `json:"orphaned_assets"`
+	ForeignAssets    []Mismatch `json:"foreign_assets"`
+	MissingAssets    []Mismatch `json:"missing_assets"`
+	ErroredAssets    []Mismatch `json:"errored_assets"`
+	PolicyMismatches []Mismatch `json:"policy_mismatches"`
+	Fixed            int        `json:"fixed"`
+	Failed           int        `json:"failed"`
+}
+
+// Mismatch is a video or an asset found by a reconciliation
+type Mismatch struct {
+	VideoID string `json:"video_id,omitempty"`
+	AssetID string `json:"asset_id,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+	Fixed   bool   `json:"fixed"`
+}
@@ -0,0 +1,14 @@
+package model
+
+// SearchHit is a video matching a search, with its relevance score
+type SearchHit struct {
+	Video      Video             `json:"video"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
+
+// SearchResult holds the hits of a search, most relevant first
+type SearchResult struct {
+	Query string      `json:"query"`
+	Items []SearchHit `json:"items"`
+}
@@ -0,0 +1,18 @@
+package model
+
+// TokenRequest asks for the signed tokens of a viewer. TTL is in seconds,
+// zero keeps the signing policy.
+type TokenRequest struct {
+	ViewerID string                 `json:"viewer_id,omitempty"`
+	TTL      int                    `json:"ttl,omitempty"`
+	Claims   map[string]interface{} `json:"claims,omitempty"`
+}
+
+// PlaybackToken holds the signed tokens issued for a playback ID
+type PlaybackToken struct {
+	PlaybackID string `json:"playback_id"`
+	Playback   string `json:"playback"`
+	Thumbnail  string `json:"thumbnail"`
+	Storyboard string `json:"storyboard"`
+	ExpiresAt  string `json:"expires_at"`
+}
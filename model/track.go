@@ -0,0 +1,51 @@
+package model
+
+// Track types of Mux.com
+const (
+	TrackAudio = "audio"
+	TrackText  = "text"
+)
+
+// Track is a video, audio or text track of an asset
+type Track struct {
+	ID             string `json:"id,omitempty"`
+	Type           string `json:"type,omitempty"`
+	TextType       string `json:"text_type,omitempty"`
+	TextSource     string `json:"text_source,omitempty"`
+	Status         string `json:"status,omitempty"`
+	Name           string `json:"name,omitempty"`
+	LanguageCode   string `json:"language_code,omitempty"`
+	ClosedCaptions bool   `json:"closed_captions,omitempty"`
+	Primary        bool   `json:"primary,omitempty"`
+	Passthrough    string `json:"passthrough,omitempty"`
+	URL            string `json:"url,omitempty"`
+}
+
+// TrackRequest adds a caption or subtitle track to a video, from the URL of a
+// file Mux.com downloads or from the WebVTT or SRT content itself. Audio
+// tracks are only added from a URL.
+type TrackRequest struct {
+	Type           string `json:"type,omitempty"`
+	URL            string `json:"url,omitempty"`
+	Content        string `json:"content,omitempty"`
+	LanguageCode   string `json:"language_code,omitempty"`
+	Name           string `json:"name,omitempty"`
+	ClosedCaptions bool   `json:"closed_captions,omitempty"`
+}
+
+// AudioInput is an audio file in another language, ingested with the video as
+// an alternate audio track
+type AudioInput struct {
+	URL          string `json:"url,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// Caption is an uploaded caption file converted to WebVTT, Mux.com downloads
+// it when the track is created
+type Caption struct {
+	ID        string `json:"id,omitempty"`
+	VideoID   string `json:"video_id,omitempty"`
+	Content   string `json:"content,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
@@ -0,0 +1,29 @@
+package model
+
+// Upload statuses reported by Mux.com
+const (
+	UploadWaiting      = "waiting"
+	UploadAssetCreated = "asset_created"
+	UploadErrored      = "errored"
+	UploadCancelled    = "cancelled"
+	UploadTimedOut     = "timed_out"
+)
+
+// Upload struct is a direct upload of a video source file
+type Upload struct {
+	ID         string `json:"id,omitempty"`
+	VideoID    string `json:"video_id,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Status     string `json:"status,omitempty"`
+	AssetID    string `json:"asset_id,omitempty"`
+	CorsOrigin string `json:"cors_origin,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// UploadRequest holds the video to create and the origin of the browser
+// sending the file
+type UploadRequest struct {
+	Video
+	CorsOrigin string `json:"cors_origin,omitempty"`
+}
@@ -2,16 +2,33 @@ package model
 
 // Video struct
 type Video struct {
-	ID          string   `json:"id,omitempty"`
-	Title       string   `json:"title,omitempty"`
-	Description string   `json:"description,omitempty"`
-	SourceURL   string   `json:"source_url,omitempty"`
-	Asset       *Asset   `json:"asset,omitempty"`
-	Duration    float64  `json:"duration,omitempty"`
-	Poster      string   `json:"poster,omitempty"`
-	Thumbnail   string   `json:"thumbnail,omitempty"`
-	Policy      string   `json:"policy,omitempty"`
-	Sources     []Source `json:"sources,omitempty"`
-	CreatedAt   string   `json:"created_at,omitempty"`
-	UpdatedAt   string   `json:"updated_at,omitempty"`
+	ID                string       `json:"id,omitempty"`
+	Title             string       `json:"title,omitempty"`
+	Description       string       `json:"description,omitempty"`
+	SourceURL         string       `json:"source_url,omitempty"`
+	Asset             *Asset       `json:"asset,omitempty"`
+	Ingestion         *JobStatus   `json:"ingestion,omitempty"`
+	Duration          float64      `json:"duration,omitempty"`
+	Poster            string       `json:"poster,omitempty"`
+	PosterTime        *float64     `json:"poster_time,omitempty"`
+	Thumbnail         string       `json:"thumbnail,omitempty"`
+	Previews          *Previews    `json:"previews,omitempty"`
+	Policy            string       `json:"policy,omitempty"`
+	MasterID          string       `json:"master_id,omitempty"`
+	Clip              *Clip        `json:"clip,omitempty"`
+	LiveStreamID      string       `json:"live_stream_id,omitempty"`
+	AllowDownload     bool         `json:"allow_download,omitempty"`
+	GeneratedCaptions string       `json:"generated_captions,omitempty"`
+	AudioInputs       []AudioInput `json:"audio_inputs,omitempty"`
+	Chapters          []Chapter    `json:"chapters,omitempty"`
+	Tags              []string     `json:"tags,omitempty"`
+	Metadata          Metadata     `json:"metadata,omitempty"`
+	Sources           []Source     `json:"sources,omitempty"`
+	Tracks            []Track      `json:"tracks,omitempty"`
+	CreatedAt         string       `json:"created_at,omitempty"`
+	UpdatedAt         string       `json:"updated_at,omitempty"`
+	DeletedAt         string       `json:"deleted_at,omitempty"`
 }
+
+// Metadata holds custom fields, values are strings, numbers or booleans
+type Metadata map[string]interface{}
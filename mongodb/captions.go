@@ -0,0 +1,89 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// CaptionCollection keeps the collection name
+const CaptionCollection = "captions"
+
+// caption model for mongodb
+type caption struct {
+	ID        string    `bson:"_id"`
+	VideoID   string    `bson:"video_id"`
+	Content   string    `bson:"content"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+// CreateCaption stores an uploaded caption file
+func (db *DB) CreateCaption(ctx context.Context, anyCaption model.Caption) (model.Caption, error) {
+	collection := db.mongo.Collection(CaptionCollection)
+
+	insert := &caption{
+		ID:        uuid.New().String(),
+		VideoID:   anyCaption.VideoID,
+		Content:   anyCaption.Content,
+		CreatedAt: time.Now(),
+	}
+
+	_, err := collection.InsertOne(ctx, insert)
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting caption into collection")
+
+		return model.Caption{}, err
+	}
+
+	return insert.toModel(), nil
+}
+
+// GetCaption retrieves a caption file with the ID
+func (db *DB) GetCaption(ctx context.Context, id string) (model.Caption, error) {
+	var response caption
+
+	collection := db.mongo.Collection(CaptionCollection)
+	err := collection.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Caption{}, errorcodes.ErrCaptionNotFound
+		}
+
+		db.logger.WithError(err).Error("error retrieving caption")
+
+		return model.Caption{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// DeleteCaption removes a caption file
+func (db *DB) DeleteCaption(ctx context.Context, id string) error {
+	collection := db.mongo.Collection(CaptionCollection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	_, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		db.logger.WithError(err).Error("error deleting caption")
+
+		return err
+	}
+
+	return nil
+}
+
+func (c caption) toModel() model.Caption {
+	return model.Caption{
+		ID:        c.ID,
+		VideoID:   c.VideoID,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt.String(),
+	}
+}
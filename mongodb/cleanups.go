@@ -0,0 +1,129 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// CleanupCollection keeps the collection name
+const CleanupCollection = "cleanups"
+
+// cleanup model for mongodb
+type cleanup struct {
+	ID        string    `bson:"_id"`
+	AssetID   string    `bson:"asset_id"`
+	VideoID   string    `bson:"video_id,omitempty"`
+	Reason    string    `bson:"reason,omitempty"`
+	Attempts  int       `bson:"attempts"`
+	CreatedAt time.Time `bson:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// CreateCleanup records a pending Mux asset deletion
+func (db *DB) CreateCleanup(ctx context.Context, anyCleanup model.Cleanup) (model.Cleanup, error) {
+	collection := db.mongo.Collection(CleanupCollection)
+	time := time.Now()
+
+	insert := &cleanup{
+		ID:        uuid.New().String(),
+		AssetID:   anyCleanup.AssetID,
+		VideoID:   anyCleanup.VideoID,
+		Reason:    anyCleanup.Reason,
+		Attempts:  anyCleanup.Attempts,
+		CreatedAt: time,
+		UpdatedAt: time,
+	}
+
+	_, err := collection.InsertOne(ctx, insert)
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting cleanup into collection")
+
+		return model.Cleanup{}, err
+	}
+
+	return insert.toModel(), nil
+}
+
+// ListCleanups returns the oldest pending cleanups
+func (db *DB) ListCleanups(ctx context.Context, limit int) ([]model.Cleanup, error) {
+	collection := db.mongo.Collection(CleanupCollection)
+	opts := options.Find().SetLimit(int64(limit)).SetSort(bson.D{{Key: "updatedAt", Value: 1}})
+	cur, err := collection.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		db.logger.WithError(err).Error("error listing cleanups")
+
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var cleanups []model.Cleanup
+	for cur.Next(ctx) {
+		var c cleanup
+		if err := cur.Decode(&c); err != nil {
+			return nil, err
+		}
+		cleanups = append(cleanups, c.toModel())
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return cleanups, nil
+}
+
+// RetryCleanup increments the attempts of a cleanup that failed again
+func (db *DB) RetryCleanup(ctx context.Context, id string, reason string) error {
+	collection := db.mongo.Collection(CleanupCollection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: 1}}},
+		{Key: "$set", Value: bson.D{
+			{Key: "reason", Value: reason},
+			{Key: "updatedAt", Value: time.Now()},
+		}},
+	}
+
+	_, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error updating cleanup")
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCleanup removes a completed cleanup
+func (db *DB) DeleteCleanup(ctx context.Context, id string) error {
+	collection := db.mongo.Collection(CleanupCollection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	_, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		db.logger.WithError(err).Error("error deleting cleanup")
+
+		return err
+	}
+
+	return nil
+}
+
+func (c cleanup) toModel() model.Cleanup {
+	return model.Cleanup{
+		ID:        c.ID,
+		AssetID:   c.AssetID,
+		VideoID:   c.VideoID,
+		Reason:    c.Reason,
+		Attempts:  c.Attempts,
+		CreatedAt: c.CreatedAt.String(),
+		UpdatedAt: c.UpdatedAt.String(),
+	}
+}
@@ -0,0 +1,85 @@
+package mongodb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// cursor is the position of the last document returned
+type cursor struct {
+	Sort  string          `json:"s"`
+	Value json.RawMessage `json:"v"`
+	ID    string          `json:"id"`
+}
+
+// encodeCursor returns an opaque token for the position of a document, with
+// its sort value and ID, in the sort
+func encodeCursor(key string, sortValue interface{}, id string) string {
+	value, _ := json.Marshal(sortValue)
+	raw, _ := json.Marshal(cursor{Sort: key, Value: value, ID: id})
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor parses a token returned by encodeCursor for the same sort key,
+// it returns the sort value and the document ID
+func decodeCursor(token, key string) (interface{}, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var c cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, "", err
+	}
+
+	if c.ID == "" || c.Sort != key {
+		return nil, "", errors.New("cursor does not match the sort")
+	}
+
+	switch key {
+	case "created_at", "updated_at":
+		var value time.Time
+		if err := json.Unmarshal(c.Value, &value); err != nil || value.IsZero() {
+			return nil, "", errors.New("invalid cursor time")
+		}
+		return value, c.ID, nil
+	case "title":
+		var value string
+		if err := json.Unmarshal(c.Value, &value); err != nil {
+			return nil, "", err
+		}
+		return value, c.ID, nil
+	case "duration":
+		var value *float64
+		if err := json.Unmarshal(c.Value, &value); err != nil {
+			return nil, "", err
+		}
+		if value == nil {
+			return nil, c.ID, nil
+		}
+		return *value, c.ID, nil
+	}
+
+	return nil, "", errors.New("unknown sort")
+}
+
+// sortValue returns the value of the sort field, nil when it is not stored
+func sortValue(key string, v video) interface{} {
+	switch key {
+	case "updated_at":
+		return v.UpdatedAt
+	case "title":
+		return v.Title
+	case "duration":
+		if v.Duration == 0 {
+			return nil
+		}
+		return v.Duration
+	}
+
+	return v.CreatedAt
+}
@@ -0,0 +1,136 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// IdempotencyCollection keeps the collection name
+const IdempotencyCollection = "idempotency_keys"
+
+// idempotencyTTL is the time a key and its response are kept
+const idempotencyTTL = 24 * time.Hour
+
+// idempotencyKey model for mongodb, expired documents are removed by a TTL index
+type idempotencyKey struct {
+	Key         string    `bson:"_id"`
+	RequestHash string    `bson:"request_hash"`
+	Status      int       `bson:"status,omitempty"`
+	Body        []byte    `bson:"body,omitempty"`
+	LockedUntil time.Time `bson:"lockedUntil"`
+	CreatedAt   time.Time `bson:"createdAt"`
+	ExpiresAt   time.Time `bson:"expiresAt"`
+}
+
+// ReserveKey stores a new key for the request, the unique _id makes a single
+// replica win. A key whose request is still running is taken over by the same
+// request once the lease expires, so a replica that died doesn't block it.
+// When the key is not reserved the stored key is returned.
+func (db *DB) ReserveKey(ctx context.Context, key, requestHash string, lease time.Duration) (model.IdempotencyKey, bool, error) {
+	collection := db.mongo.Collection(IdempotencyCollection)
+	now := time.Now()
+
+	insert := &idempotencyKey{
+		Key:         key,
+		RequestHash: requestHash,
+		LockedUntil: now.Add(lease),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(idempotencyTTL),
+	}
+
+	_, err := collection.InsertOne(ctx, insert)
+	if err == nil {
+		return insert.toModel(), true, nil
+	}
+
+	if !mongo.IsDuplicateKeyError(err) {
+		db.logger.WithError(err).Error("error inserting idempotency key into collection")
+
+		return model.IdempotencyKey{}, false, err
+	}
+
+	var response idempotencyKey
+
+	filter := bson.D{
+		{Key: "_id", Value: key},
+		{Key: "request_hash", Value: requestHash},
+		{Key: "status", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "lockedUntil", Value: bson.D{{Key: "$lt", Value: now}}},
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "lockedUntil", Value: now.Add(lease)}}}}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err = collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&response)
+	if err == nil {
+		return response.toModel(), true, nil
+	}
+
+	if err != mongo.ErrNoDocuments {
+		db.logger.WithError(err).Error("error taking over idempotency key")
+
+		return model.IdempotencyKey{}, false, err
+	}
+
+	err = collection.FindOne(ctx, bson.D{{Key: "_id", Value: key}}).Decode(&response)
+	if err != nil {
+		db.logger.WithError(err).Error("error getting idempotency key")
+
+		return model.IdempotencyKey{}, false, err
+	}
+
+	return response.toModel(), false, nil
+}
+
+// CompleteKey stores the response sent for the key
+func (db *DB) CompleteKey(ctx context.Context, key string, response model.IdempotencyKey) error {
+	collection := db.mongo.Collection(IdempotencyCollection)
+
+	filter := bson.D{{Key: "_id", Value: key}}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: response.Status},
+		{Key: "body", Value: response.Body},
+	}}}
+
+	_, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error completing idempotency key")
+
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseKey removes a key whose request failed, so it can be sent again
+func (db *DB) ReleaseKey(ctx context.Context, key string) error {
+	collection := db.mongo.Collection(IdempotencyCollection)
+
+	filter := bson.D{{Key: "_id", Value: key}}
+
+	_, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		db.logger.WithError(err).Error("error releasing idempotency key")
+
+		return err
+	}
+
+	return nil
+}
+
+func (k idempotencyKey) toModel() model.IdempotencyKey {
+	return model.IdempotencyKey{
+		Key:         k.Key,
+		RequestHash: k.RequestHash,
+		Status:      k.Status,
+		Body:        k.Body,
+	}
+}
@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// EnsureUniqueIndexes creates the indexes the stored data relies on, a single
+// video links each asset so a recording is created once. It fails while
+// videos share an asset, those must be removed first.
+func (db *DB) EnsureUniqueIndexes(ctx context.Context) error {
+	// Videos without an asset are left out
+	_, err := db.mongo.Collection(Collection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "asset_id", Value: 1}},
+		Options: options.Index().
+			SetName("asset_id_unique").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "asset_id", Value: bson.D{{Key: "$exists", Value: true}}}}),
+	})
+	if err != nil {
+		db.logger.WithError(err).Error("error creating unique indexes")
+
+		return err
+	}
+
+	return nil
+}
+
+// EnsureIndexes creates the indexes used by the video, clip and live stream
+// listings, search, upload polling, the job queue and the expiry of
+// idempotency keys, existing indexes are left untouched
+func (db *DB) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "updatedAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "title", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "duration", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "asset_status", Value: 1}, {Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "policy", Value: 1}, {Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "master_id", Value: 1}, {Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "tags", Value: 1}, {Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "metadata.$**", Value: 1}}},
+		{
+			Keys: bson.D{{Key: "title", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().
+				SetName("videos_text").
+				SetWeights(bson.D{{Key: "title", Value: 10}, {Key: "description", Value: 1}}),
+		},
+	}
+
+	_, err := db.mongo.Collection(Collection).Indexes().CreateMany(ctx, models)
+	if err != nil {
+		db.logger.WithError(err).Error("error creating indexes")
+
+		return err
+	}
+
+	// Pending uploads are polled oldest first
+	_, err = db.mongo.Collection(UploadCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "createdAt", Value: 1}},
+	})
+	if err != nil {
+		db.logger.WithError(err).Error("error creating indexes")
+
+		return err
+	}
+
+	// Workers claim the oldest due job, retries look jobs up by video
+	_, err = db.mongo.Collection(JobCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "runAt", Value: 1}}},
+		{Keys: bson.D{{Key: "video_id", Value: 1}}},
+	})
+	if err != nil {
+		db.logger.WithError(err).Error("error creating indexes")
+
+		return err
+	}
+
+	// Live streams are listed newest first
+	_, err = db.mongo.Collection(StreamCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}},
+	})
+	if err != nil {
+		db.logger.WithError(err).Error("error creating indexes")
+
+		return err
+	}
+
+	// Idempotency keys are removed by MongoDB once they expire
+	_, err = db.mongo.Collection(IdempotencyCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		db.logger.WithError(err).Error("error creating indexes")
+
+		return err
+	}
+
+	return nil
+}
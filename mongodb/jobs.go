@@ -0,0 +1,221 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// JobCollection keeps the collection name
+const JobCollection = "jobs"
+
+// job model for mongodb
+type job struct {
+	ID                string       `bson:"_id"`
+	VideoID           string       `bson:"video_id"`
+	SourceURL         string       `bson:"source_url"`
+	Public            bool         `bson:"public"`
+	MP4Support        bool         `bson:"mp4_support,omitempty"`
+	GeneratedCaptions string       `bson:"generated_captions,omitempty"`
+	AudioInputs       []audioInput `bson:"audio_inputs,omitempty"`
+	Clip              *clip        `bson:"clip,omitempty"`
+	Status            string       `bson:"status"`
+	Attempts          int          `bson:"attempts"`
+	Error             string       `bson:"error,omitempty"`
+	RunAt             time.Time    `bson:"runAt"`
+	CreatedAt         time.Time    `bson:"createdAt"`
+	UpdatedAt         time.Time    `bson:"updatedAt"`
+}
+
+// CreateJob enqueues an ingestion job that is ready to run
+func (db *DB) CreateJob(ctx context.Context, anyJob model.Job) (model.Job, error) {
+	collection := db.mongo.Collection(JobCollection)
+
+	insert := newJob(anyJob)
+
+	_, err := collection.InsertOne(ctx, insert)
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting job into collection")
+
+		return model.Job{}, err
+	}
+
+	return insert.toModel(), nil
+}
+
+// newJob builds the document of a job that is ready to run
+func newJob(anyJob model.Job) *job {
+	time := time.Now()
+
+	return &job{
+		ID:                uuid.New().String(),
+		VideoID:           anyJob.VideoID,
+		SourceURL:         anyJob.SourceURL,
+		Public:            anyJob.Public,
+		MP4Support:        anyJob.MP4Support,
+		GeneratedCaptions: anyJob.GeneratedCaptions,
+		AudioInputs:       fromAudioInputs(anyJob.AudioInputs),
+		Clip:              fromClip(anyJob.Clip),
+		Status:            model.JobPending,
+		RunAt:             time,
+		CreatedAt:         time,
+		UpdatedAt:         time,
+	}
+}
+
+// ClaimJob atomically takes the oldest job that is due and counts the attempt.
+// A running job is due again when its lease expires, so jobs of a worker that
+// died are picked up by another one.
+func (db *DB) ClaimJob(ctx context.Context, lease time.Duration) (model.Job, error) {
+	var response job
+
+	collection := db.mongo.Collection(JobCollection)
+	now := time.Now()
+
+	filter := bson.D{
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{model.JobPending, model.JobRunning}}}},
+		{Key: "runAt", Value: bson.D{{Key: "$lte", Value: now}}},
+	}
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: 1}}},
+		{Key: "$set", Value: bson.D{
+			{Key: "status", Value: model.JobRunning},
+			{Key: "runAt", Value: now.Add(lease)},
+			{Key: "updatedAt", Value: now},
+		}},
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "runAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Job{}, errorcodes.ErrJobNotFound
+		}
+
+		db.logger.WithError(err).Error("error claiming job")
+
+		return model.Job{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// RetryJob puts a job that failed back in the queue until runAt
+func (db *DB) RetryJob(ctx context.Context, id string, reason string, runAt time.Time) error {
+	return db.updateJob(ctx, id, bson.D{
+		{Key: "status", Value: model.JobPending},
+		{Key: "error", Value: reason},
+		{Key: "runAt", Value: runAt},
+	})
+}
+
+// FailJob dead-letters a job, it only runs again when it is requeued
+func (db *DB) FailJob(ctx context.Context, id string, reason string) error {
+	return db.updateJob(ctx, id, bson.D{
+		{Key: "status", Value: model.JobFailed},
+		{Key: "error", Value: reason},
+	})
+}
+
+// RequeueJob resets the attempts of the dead-lettered job of a video
+func (db *DB) RequeueJob(ctx context.Context, videoID string) (model.Job, error) {
+	var response job
+
+	collection := db.mongo.Collection(JobCollection)
+	now := time.Now()
+
+	filter := bson.D{
+		{Key: "video_id", Value: videoID},
+		{Key: "status", Value: model.JobFailed},
+	}
+
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "status", Value: model.JobPending},
+			{Key: "attempts", Value: 0},
+			{Key: "runAt", Value: now},
+			{Key: "updatedAt", Value: now},
+		}},
+		{Key: "$unset", Value: bson.D{{Key: "error", Value: ""}}},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Job{}, errorcodes.ErrJobNotFound
+		}
+
+		db.logger.WithError(err).Error("error requeuing job")
+
+		return model.Job{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// DeleteJob removes a completed job
+func (db *DB) DeleteJob(ctx context.Context, id string) error {
+	collection := db.mongo.Collection(JobCollection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	_, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		db.logger.WithError(err).Error("error deleting job")
+
+		return err
+	}
+
+	return nil
+}
+
+// updateJob sets the fields of a job and bumps updatedAt
+func (db *DB) updateJob(ctx context.Context, id string, set bson.D) error {
+	collection := db.mongo.Collection(JobCollection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	set = append(set, bson.E{Key: "updatedAt", Value: time.Now()})
+	update := bson.D{{Key: "$set", Value: set}}
+
+	_, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error updating job")
+
+		return err
+	}
+
+	return nil
+}
+
+func (j job) toModel() model.Job {
+	return model.Job{
+		ID:                j.ID,
+		VideoID:           j.VideoID,
+		SourceURL:         j.SourceURL,
+		Public:            j.Public,
+		MP4Support:        j.MP4Support,
+		GeneratedCaptions: j.GeneratedCaptions,
+		AudioInputs:       toAudioInputs(j.AudioInputs),
+		Clip:              toClip(j.Clip),
+		Status:            j.Status,
+		Attempts:          j.Attempts,
+		Error:             j.Error,
+		RunAt:             j.RunAt.String(),
+		CreatedAt:         j.CreatedAt.String(),
+		UpdatedAt:         j.UpdatedAt.String(),
+	}
+}
@@ -0,0 +1,51 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// LeaseCollection keeps the collection name
+const LeaseCollection = "leases"
+
+// ClaimLease takes the named lease for the holder until the lease duration
+// passes. The holder renews a lease it already has, and a lease that expired
+// is taken over, so a replica that died doesn't block the others. The unique
+// _id makes a single replica win, false is returned when another holder has it.
+func (db *DB) ClaimLease(ctx context.Context, name, holder string, lease time.Duration) (bool, error) {
+	collection := db.mongo.Collection(LeaseCollection)
+	now := time.Now()
+
+	filter := bson.D{
+		{Key: "_id", Value: name},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "holder", Value: holder}},
+			bson.D{{Key: "lockedUntil", Value: bson.D{{Key: "$lt", Value: now}}}},
+		}},
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "holder", Value: holder},
+		{Key: "lockedUntil", Value: now.Add(lease)},
+		{Key: "updatedAt", Value: now},
+	}}}
+
+	// A lease held by another replica fails the filter, the upsert then
+	// collides with its _id
+	_, err := collection.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+
+		db.logger.WithError(err).Error("error claiming lease")
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,189 @@
+package mongodb
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// sortFields maps the sort keys to the document fields
+var sortFields = map[string]string{
+	"created_at": "createdAt",
+	"updated_at": "updatedAt",
+	"title":      "title",
+	"duration":   "duration",
+}
+
+// List returns a page of the videos matching the options. Pages are read
+// after the cursor, or with skip and limit when a page number is given.
+func (db *DB) List(ctx context.Context, opts model.ListOptions) (model.VideoList, error) {
+	if opts.Limit < 1 {
+		opts.Limit = 10
+	}
+
+	key := strings.TrimPrefix(opts.Sort, "-")
+	if key == "" {
+		key = "created_at"
+	}
+	field, ok := sortFields[key]
+	if !ok {
+		return model.VideoList{}, errorcodes.ErrInvalidListOptions
+	}
+	descending := strings.HasPrefix(opts.Sort, "-")
+
+	order := 1
+	if descending {
+		order = -1
+	}
+
+	// _id breaks the ties, so the order is stable while new videos are inserted
+	filter := listFilter(opts)
+	findOptions := options.Find().SetSort(bson.D{{Key: field, Value: order}, {Key: "_id", Value: order}})
+
+	if opts.Page > 0 {
+		findOptions.SetSkip(int64((opts.Page - 1) * opts.Limit)).SetLimit(int64(opts.Limit))
+	} else {
+		if opts.Cursor != "" {
+			value, id, err := decodeCursor(opts.Cursor, key)
+			if err != nil {
+				return model.VideoList{}, errorcodes.ErrInvalidCursor
+			}
+			filter = append(filter, bson.E{Key: "$or", Value: after(field, value, id, descending)})
+		}
+
+		// One extra document tells whether there is a next page
+		findOptions.SetLimit(int64(opts.Limit + 1))
+	}
+
+	documents, err := db.findDocuments(ctx, filter, findOptions)
+	if err != nil {
+		return model.VideoList{}, err
+	}
+
+	list := model.VideoList{Items: []model.Video{}}
+	if opts.Page == 0 && len(documents) > opts.Limit {
+		documents = documents[:opts.Limit]
+		last := documents[len(documents)-1]
+		list.NextCursor = encodeCursor(key, sortValue(key, last), last.ID)
+	}
+
+	for _, document := range documents {
+		list.Items = append(list.Items, document.toModel())
+	}
+
+	if opts.Total {
+		total, err := db.mongo.Collection(Collection).CountDocuments(ctx, listFilter(opts))
+		if err != nil {
+			db.logger.WithError(err).Error("error counting videos")
+
+			return model.VideoList{}, err
+		}
+		list.Total = &total
+	}
+
+	return list, nil
+}
+
+// listFilter matches the videos selected by the options
+func listFilter(opts model.ListOptions) bson.D {
+	filter := bson.D{notTrashed}
+
+	if opts.Policy != "" {
+		filter = append(filter, bson.E{Key: "policy", Value: opts.Policy})
+	}
+
+	if opts.Status != "" {
+		filter = append(filter, bson.E{Key: "asset_status", Value: opts.Status})
+	}
+
+	created := bson.D{}
+	if !opts.CreatedAfter.IsZero() {
+		created = append(created, bson.E{Key: "$gte", Value: opts.CreatedAfter})
+	}
+	if !opts.CreatedBefore.IsZero() {
+		created = append(created, bson.E{Key: "$lt", Value: opts.CreatedBefore})
+	}
+	if len(created) > 0 {
+		filter = append(filter, bson.E{Key: "createdAt", Value: created})
+	}
+
+	if opts.MasterID != "" {
+		filter = append(filter, bson.E{Key: "master_id", Value: opts.MasterID})
+	}
+
+	if opts.HasAsset != nil {
+		filter = append(filter, bson.E{Key: "asset_id", Value: bson.D{{Key: "$exists", Value: *opts.HasAsset}}})
+	}
+
+	if len(opts.Tags) > 0 {
+		filter = append(filter, bson.E{Key: "tags", Value: bson.D{{Key: "$all", Value: opts.Tags}}})
+	}
+
+	// Sorted keys keep the filter, and the query plan, stable
+	keys := make([]string, 0, len(opts.Metadata))
+	for key := range opts.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		filter = append(filter, bson.E{
+			Key:   "metadata." + key,
+			Value: bson.D{{Key: "$in", Value: metadataValues(opts.Metadata[key])}},
+		})
+	}
+
+	return filter
+}
+
+// after matches the documents that follow the (value, id) position in the sort.
+// A nil value is a missing field, which sorts before any stored value.
+func after(field string, value interface{}, id string, descending bool) bson.A {
+	op := "$gt"
+	if descending {
+		op = "$lt"
+	}
+
+	if value == nil {
+		clauses := bson.A{
+			bson.D{
+				{Key: field, Value: bson.D{{Key: "$exists", Value: false}}},
+				{Key: "_id", Value: bson.D{{Key: op, Value: id}}},
+			},
+		}
+		if !descending {
+			clauses = append(clauses, bson.D{{Key: field, Value: bson.D{{Key: "$exists", Value: true}}}})
+		}
+		return clauses
+	}
+
+	clauses := bson.A{
+		bson.D{{Key: field, Value: bson.D{{Key: op, Value: value}}}},
+		bson.D{
+			{Key: field, Value: value},
+			{Key: "_id", Value: bson.D{{Key: op, Value: id}}},
+		},
+	}
+	if descending {
+		clauses = append(clauses, bson.D{{Key: field, Value: bson.D{{Key: "$exists", Value: false}}}})
+	}
+	return clauses
+}
+
+// metadataValues returns the stored values a query value can match
+func metadataValues(value string) bson.A {
+	values := bson.A{value}
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		values = append(values, number)
+	}
+	if value == "true" || value == "false" {
+		values = append(values, value == "true")
+	}
+	return values
+}
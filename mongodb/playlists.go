@@ -0,0 +1,248 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// PlaylistCollection keeps the collection name
+const PlaylistCollection = "playlists"
+
+// playlist model for mongodb
+type playlist struct {
+	ID          string    `bson:"_id"`
+	Title       string    `bson:"title"`
+	Description string    `bson:"description"`
+	VideoIDs    []string  `bson:"video_ids"`
+	CreatedAt   time.Time `bson:"createdAt"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+// CreatePlaylist inserts a playlist document
+func (db *DB) CreatePlaylist(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	collection := db.mongo.Collection(PlaylistCollection)
+	time := time.Now()
+
+	videoIDs := anyPlaylist.VideoIDs
+	if videoIDs == nil {
+		videoIDs = []string{}
+	}
+
+	insert := &playlist{
+		ID:          uuid.New().String(),
+		Title:       anyPlaylist.Title,
+		Description: anyPlaylist.Description,
+		VideoIDs:    videoIDs,
+		CreatedAt:   time,
+		UpdatedAt:   time,
+	}
+
+	_, err := collection.InsertOne(ctx, insert)
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting playlist into collection")
+
+		return model.Playlist{}, err
+	}
+
+	return insert.toModel(), nil
+}
+
+// GetPlaylist retrieves a playlist with the ID
+func (db *DB) GetPlaylist(ctx context.Context, id string) (model.Playlist, error) {
+	var response playlist
+
+	collection := db.mongo.Collection(PlaylistCollection)
+	err := collection.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Playlist{}, errorcodes.ErrPlaylistNotFound
+		}
+
+		db.logger.WithError(err).Error("error retrieving playlist")
+
+		return model.Playlist{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// ListPlaylists returns paginated playlists, oldest first
+func (db *DB) ListPlaylists(ctx context.Context, page, limit int) ([]model.Playlist, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	opts := options.Find().
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+
+	collection := db.mongo.Collection(PlaylistCollection)
+	cur, err := collection.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		db.logger.WithError(err).Error("error listing playlists")
+
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	playlists := []model.Playlist{}
+	for cur.Next(ctx) {
+		var p playlist
+		if err := cur.Decode(&p); err != nil {
+			return nil, err
+		}
+		playlists = append(playlists, p.toModel())
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return playlists, nil
+}
+
+// UpdatePlaylist replaces the title and description of a playlist
+func (db *DB) UpdatePlaylist(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	return db.updatePlaylist(ctx, anyPlaylist.ID, bson.D{
+		{Key: "title", Value: anyPlaylist.Title},
+		{Key: "description", Value: anyPlaylist.Description},
+	})
+}
+
+// InsertPlaylistVideo places a video at the position of the ordered video IDs
+// in a single update, a negative or out of range position appends it. A video
+// already in the playlist is moved.
+func (db *DB) InsertPlaylistVideo(ctx context.Context, id, videoID string, position int) (model.Playlist, error) {
+	size := bson.D{{Key: "$size", Value: "$video_ids"}}
+
+	at := bson.D{{Key: "$min", Value: bson.A{position, size}}}
+	if position < 0 {
+		at = size
+	}
+
+	// The video is filtered out first, then spliced back in at the position
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "video_ids", Value: bson.D{{Key: "$filter", Value: bson.D{
+				{Key: "input", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$video_ids", bson.A{}}}}},
+				{Key: "as", Value: "v"},
+				{Key: "cond", Value: bson.D{{Key: "$ne", Value: bson.A{"$$v", videoID}}}},
+			}}}},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "video_ids", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+				bson.D{{Key: "$cond", Value: bson.A{
+					bson.D{{Key: "$eq", Value: bson.A{at, 0}}},
+					bson.A{},
+					bson.D{{Key: "$slice", Value: bson.A{"$video_ids", at}}},
+				}}},
+				bson.A{videoID},
+				bson.D{{Key: "$slice", Value: bson.A{"$video_ids", at, bson.D{{Key: "$add", Value: bson.A{size, 1}}}}}},
+			}}}},
+			{Key: "updatedAt", Value: time.Now()},
+		}}},
+	}
+
+	response, err := db.modifyPlaylist(ctx, bson.D{{Key: "_id", Value: id}}, pipeline)
+	if err == mongo.ErrNoDocuments {
+		return model.Playlist{}, errorcodes.ErrPlaylistNotFound
+	}
+
+	return response, err
+}
+
+// RemovePlaylistVideo takes a video out of the ordered video IDs in a single
+// update
+func (db *DB) RemovePlaylistVideo(ctx context.Context, id, videoID string) (model.Playlist, error) {
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "video_ids", Value: videoID}}
+	update := bson.D{
+		{Key: "$pull", Value: bson.D{{Key: "video_ids", Value: videoID}}},
+		{Key: "$set", Value: bson.D{{Key: "updatedAt", Value: time.Now()}}},
+	}
+
+	response, err := db.modifyPlaylist(ctx, filter, update)
+	if err == mongo.ErrNoDocuments {
+		// Tell a missing playlist apart from a video that isn't in it
+		if _, err := db.GetPlaylist(ctx, id); err != nil {
+			return model.Playlist{}, err
+		}
+
+		return model.Playlist{}, errorcodes.ErrVideoNotFound
+	}
+
+	return response, err
+}
+
+// DeletePlaylist removes a playlist, the videos are kept
+func (db *DB) DeletePlaylist(ctx context.Context, id string) error {
+	collection := db.mongo.Collection(PlaylistCollection)
+	result, err := collection.DeleteOne(ctx, bson.D{{Key: "_id", Value: id}})
+	if err != nil {
+		db.logger.WithError(err).Error("error deleting playlist")
+
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errorcodes.ErrPlaylistNotFound
+	}
+
+	return nil
+}
+
+// updatePlaylist sets the fields and the update time of a playlist
+func (db *DB) updatePlaylist(ctx context.Context, id string, set bson.D) (model.Playlist, error) {
+	set = append(set, bson.E{Key: "updatedAt", Value: time.Now()})
+
+	response, err := db.modifyPlaylist(ctx, bson.D{{Key: "_id", Value: id}}, bson.D{{Key: "$set", Value: set}})
+	if err == mongo.ErrNoDocuments {
+		return model.Playlist{}, errorcodes.ErrPlaylistNotFound
+	}
+
+	return response, err
+}
+
+// modifyPlaylist applies the update to the playlist matching the filter and
+// returns it as updated, mongo.ErrNoDocuments is returned when none matches
+func (db *DB) modifyPlaylist(ctx context.Context, filter bson.D, update any) (model.Playlist, error) {
+	var response playlist
+
+	collection := db.mongo.Collection(PlaylistCollection)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&response)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			db.logger.WithError(err).Error("error updating playlist")
+		}
+
+		return model.Playlist{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+func (p playlist) toModel() model.Playlist {
+	videoIDs := p.VideoIDs
+	if videoIDs == nil {
+		videoIDs = []string{}
+	}
+
+	return model.Playlist{
+		ID:          p.ID,
+		Title:       p.Title,
+		Description: p.Description,
+		VideoIDs:    videoIDs,
+		CreatedAt:   p.CreatedAt.String(),
+		UpdatedAt:   p.UpdatedAt.String(),
+	}
+}
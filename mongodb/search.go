@@ -0,0 +1,51 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// scoredVideo is a video document with its text search score
+type scoredVideo struct {
+	video `bson:",inline"`
+	Score float64 `bson:"score"`
+}
+
+// Search returns the videos matching the text query, most relevant first
+func (db *DB) Search(ctx context.Context, query string, limit int) ([]model.SearchHit, error) {
+	score := bson.D{{Key: "score", Value: bson.D{{Key: "$meta", Value: "textScore"}}}}
+	filter := bson.D{
+		{Key: "$text", Value: bson.D{{Key: "$search", Value: query}}},
+		notTrashed,
+	}
+	opts := options.Find().
+		SetProjection(score).
+		SetSort(score).
+		SetLimit(int64(limit))
+
+	collection := db.mongo.Collection(Collection)
+	cur, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		db.logger.WithError(err).Error("error searching videos")
+
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	hits := []model.SearchHit{}
+	for cur.Next(ctx) {
+		var v scoredVideo
+		if err := cur.Decode(&v); err != nil {
+			return nil, err
+		}
+		hits = append(hits, model.SearchHit{Video: v.toModel(), Score: v.Score})
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
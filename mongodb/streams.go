@@ -0,0 +1,196 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// StreamCollection keeps the collection name
+const StreamCollection = "live_streams"
+
+// liveStream model for mongodb, the ID is the Mux.com live stream ID and the
+// stream key is stored sealed by the usecase
+type liveStream struct {
+	ID          string       `bson:"_id"`
+	Title       string       `bson:"title"`
+	Description string       `bson:"description"`
+	Policy      string       `bson:"policy"`
+	Status      string       `bson:"status"`
+	StreamKey   string       `bson:"stream_key"`
+	PlaybackIDs []playbackID `bson:"playback_ids,omitempty"`
+	CreatedAt   time.Time    `bson:"createdAt"`
+	UpdatedAt   time.Time    `bson:"updatedAt"`
+}
+
+// CreateStream records a live stream
+func (db *DB) CreateStream(ctx context.Context, anyStream model.LiveStream) (model.LiveStream, error) {
+	collection := db.mongo.Collection(StreamCollection)
+	time := time.Now()
+
+	insert := &liveStream{
+		ID:          anyStream.ID,
+		Title:       anyStream.Title,
+		Description: anyStream.Description,
+		Policy:      anyStream.Policy,
+		Status:      anyStream.Status,
+		StreamKey:   anyStream.StreamKey,
+		PlaybackIDs: fromPlaybackIDs(anyStream.PlaybackIDs),
+		CreatedAt:   time,
+		UpdatedAt:   time,
+	}
+
+	_, err := collection.InsertOne(ctx, insert)
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting live stream into collection")
+
+		return model.LiveStream{}, err
+	}
+
+	return insert.toModel(), nil
+}
+
+// GetStream retrieves a live stream with the Mux.com live stream ID
+func (db *DB) GetStream(ctx context.Context, id string) (model.LiveStream, error) {
+	var response liveStream
+
+	collection := db.mongo.Collection(StreamCollection)
+	err := collection.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.LiveStream{}, errorcodes.ErrLiveStreamNotFound
+		}
+
+		db.logger.WithError(err).Error("error retrieving live stream")
+
+		return model.LiveStream{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// ListStreams returns a page of the live streams, newest first, read after
+// the cursor like the video list
+func (db *DB) ListStreams(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error) {
+	if limit < 1 {
+		limit = 10
+	}
+
+	// _id breaks the ties, so the order is stable while new live streams are inserted
+	filter := bson.D{}
+	if cursor != "" {
+		value, id, err := decodeCursor(cursor, "created_at")
+		if err != nil {
+			return model.LiveStreamList{}, errorcodes.ErrInvalidCursor
+		}
+		filter = append(filter, bson.E{Key: "$or", Value: after("createdAt", value, id, true)})
+	}
+
+	// One extra document tells whether there is a next page
+	opts := options.Find().
+		SetLimit(int64(limit + 1)).
+		SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}})
+
+	collection := db.mongo.Collection(StreamCollection)
+	cur, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		db.logger.WithError(err).Error("error listing live streams")
+
+		return model.LiveStreamList{}, err
+	}
+	defer cur.Close(ctx)
+
+	var documents []liveStream
+	for cur.Next(ctx) {
+		var s liveStream
+		if err := cur.Decode(&s); err != nil {
+			return model.LiveStreamList{}, err
+		}
+		documents = append(documents, s)
+	}
+	if err := cur.Err(); err != nil {
+		return model.LiveStreamList{}, err
+	}
+
+	list := model.LiveStreamList{Items: []model.LiveStream{}}
+	if len(documents) > limit {
+		documents = documents[:limit]
+		last := documents[len(documents)-1]
+		list.NextCursor = encodeCursor("created_at", last.CreatedAt, last.ID)
+	}
+
+	for _, document := range documents {
+		list.Items = append(list.Items, document.toModel())
+	}
+
+	return list, nil
+}
+
+// UpdateStream sets the status of a live stream. A disabled live stream stays
+// disabled, webhooks of its last broadcast may arrive after it was disabled
+// and the stored live stream is returned unchanged.
+func (db *DB) UpdateStream(ctx context.Context, id, status string) (model.LiveStream, error) {
+	var response liveStream
+
+	collection := db.mongo.Collection(StreamCollection)
+	filter := bson.D{{Key: "_id", Value: id}}
+	if status != model.LiveStreamDisabled {
+		filter = append(filter, bson.E{Key: "status", Value: bson.D{{Key: "$ne", Value: model.LiveStreamDisabled}}})
+	}
+	set := bson.D{
+		{Key: "status", Value: status},
+		{Key: "updatedAt", Value: time.Now()},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, filter, bson.D{{Key: "$set", Value: set}}, opts).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Either the live stream is missing or it is disabled
+			return db.GetStream(ctx, id)
+		}
+
+		db.logger.WithError(err).Error("error updating live stream")
+
+		return model.LiveStream{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// DeleteStream removes a live stream, the videos of its recordings are kept
+func (db *DB) DeleteStream(ctx context.Context, id string) error {
+	collection := db.mongo.Collection(StreamCollection)
+	result, err := collection.DeleteOne(ctx, bson.D{{Key: "_id", Value: id}})
+	if err != nil {
+		db.logger.WithError(err).Error("error deleting live stream")
+
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errorcodes.ErrLiveStreamNotFound
+	}
+
+	return nil
+}
+
+func (s liveStream) toModel() model.LiveStream {
+	return model.LiveStream{
+		ID:          s.ID,
+		Title:       s.Title,
+		Description: s.Description,
+		Policy:      s.Policy,
+		Status:      s.Status,
+		StreamKey:   s.StreamKey,
+		PlaybackIDs: toPlaybackIDs(s.PlaybackIDs),
+		CreatedAt:   s.CreatedAt.String(),
+		UpdatedAt:   s.UpdatedAt.String(),
+	}
+}
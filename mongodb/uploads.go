@@ -0,0 +1,140 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// UploadCollection keeps the collection name
+const UploadCollection = "uploads"
+
+// upload model for mongodb, the ID is the Mux.com upload ID
+type upload struct {
+	ID         string    `bson:"_id"`
+	VideoID    string    `bson:"video_id"`
+	URL        string    `bson:"url,omitempty"`
+	Status     string    `bson:"status"`
+	AssetID    string    `bson:"asset_id,omitempty"`
+	CorsOrigin string    `bson:"cors_origin,omitempty"`
+	CreatedAt  time.Time `bson:"createdAt"`
+	UpdatedAt  time.Time `bson:"updatedAt"`
+}
+
+// CreateUpload records a direct upload
+func (db *DB) CreateUpload(ctx context.Context, anyUpload model.Upload) (model.Upload, error) {
+	collection := db.mongo.Collection(UploadCollection)
+	time := time.Now()
+
+	insert := &upload{
+		ID:         anyUpload.ID,
+		VideoID:    anyUpload.VideoID,
+		URL:        anyUpload.URL,
+		Status:     anyUpload.Status,
+		AssetID:    anyUpload.AssetID,
+		CorsOrigin: anyUpload.CorsOrigin,
+		CreatedAt:  time,
+		UpdatedAt:  time,
+	}
+
+	_, err := collection.InsertOne(ctx, insert)
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting upload into collection")
+
+		return model.Upload{}, err
+	}
+
+	return insert.toModel(), nil
+}
+
+// GetUpload retrieves an upload with the Mux.com upload ID
+func (db *DB) GetUpload(ctx context.Context, id string) (model.Upload, error) {
+	var response upload
+
+	collection := db.mongo.Collection(UploadCollection)
+	err := collection.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Upload{}, errorcodes.ErrUploadNotFound
+		}
+
+		db.logger.WithError(err).Error("error retrieving upload")
+
+		return model.Upload{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// ListUploads returns the oldest uploads with the status
+func (db *DB) ListUploads(ctx context.Context, status string, limit int) ([]model.Upload, error) {
+	collection := db.mongo.Collection(UploadCollection)
+	opts := options.Find().SetLimit(int64(limit)).SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cur, err := collection.Find(ctx, bson.D{{Key: "status", Value: status}}, opts)
+	if err != nil {
+		db.logger.WithError(err).Error("error listing uploads")
+
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var uploads []model.Upload
+	for cur.Next(ctx) {
+		var u upload
+		if err := cur.Decode(&u); err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, u.toModel())
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return uploads, nil
+}
+
+// UpdateUpload sets the status and the asset ID of an upload
+func (db *DB) UpdateUpload(ctx context.Context, id, status, assetID string) (model.Upload, error) {
+	var response upload
+
+	collection := db.mongo.Collection(UploadCollection)
+	set := bson.D{
+		{Key: "status", Value: status},
+		{Key: "updatedAt", Value: time.Now()},
+	}
+	if len(assetID) > 0 {
+		set = append(set, bson.E{Key: "asset_id", Value: assetID})
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, bson.D{{Key: "_id", Value: id}}, bson.D{{Key: "$set", Value: set}}, opts).Decode(&response)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Upload{}, errorcodes.ErrUploadNotFound
+		}
+
+		db.logger.WithError(err).Error("error updating upload")
+
+		return model.Upload{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+func (u upload) toModel() model.Upload {
+	return model.Upload{
+		ID:         u.ID,
+		VideoID:    u.VideoID,
+		URL:        u.URL,
+		Status:     u.Status,
+		AssetID:    u.AssetID,
+		CorsOrigin: u.CorsOrigin,
+		CreatedAt:  u.CreatedAt.String(),
+		UpdatedAt:  u.UpdatedAt.String(),
+	}
+}
@@ -18,53 +18,226 @@ const Collection = "videos"
 
 // video model for mongodb
 type video struct {
-	ID          string    `bson:"_id"`
-	Title       string    `bson:"title"`
-	Description string    `bson:"description"`
-	Duration    float64   `bson:"duration,omitempty"`
-	AssetID     string    `bson:"asset_id,omitempty"`
-	MasterID    string    `bson:"master_id,omitempty"`
-	CreatedAt   time.Time `bson:"createdAt"`
-	UpdatedAt   time.Time `bson:"updatedAt"`
+	ID                string            `bson:"_id"`
+	Title             string            `bson:"title"`
+	Description       string            `bson:"description"`
+	Policy            string            `bson:"policy,omitempty"`
+	SourceURL         string            `bson:"source_url,omitempty"`
+	Duration          float64           `bson:"duration,omitempty"`
+	AssetID           string            `bson:"asset_id,omitempty"`
+	AssetStatus       string            `bson:"asset_status,omitempty"`
+	PlaybackIDs       []playbackID      `bson:"playback_ids,omitempty"`
+	StaticRenditions  *staticRenditions `bson:"static_renditions,omitempty"`
+	Tracks            []track           `bson:"tracks,omitempty"`
+	MasterID          string            `bson:"master_id,omitempty"`
+	Clip              *clip             `bson:"clip,omitempty"`
+	LiveStreamID      string            `bson:"live_stream_id,omitempty"`
+	AllowDownload     bool              `bson:"allow_download,omitempty"`
+	GeneratedCaptions string            `bson:"generated_captions,omitempty"`
+	AudioInputs       []audioInput      `bson:"audio_inputs,omitempty"`
+	PosterTime        *float64          `bson:"poster_time,omitempty"`
+	Chapters          []chapter         `bson:"chapters,omitempty"`
+	Tags              []string          `bson:"tags,omitempty"`
+	Metadata          bson.M            `bson:"metadata,omitempty"`
+	Ingestion         *jobStatus        `bson:"ingestion,omitempty"`
+	CreatedAt         time.Time         `bson:"createdAt"`
+	UpdatedAt         time.Time         `bson:"updatedAt"`
+	DeletedAt         *time.Time        `bson:"deletedAt,omitempty"`
+}
+
+// jobStatus model for mongodb, the ingestion state of the video
+type jobStatus struct {
+	Status        string     `bson:"status"`
+	Attempts      int        `bson:"attempts"`
+	Error         string     `bson:"error,omitempty"`
+	NextAttemptAt *time.Time `bson:"next_attempt_at,omitempty"`
+}
+
+// notTrashed matches the videos that have not been soft deleted
+var notTrashed = bson.E{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: false}}}
+
+// trashed matches the soft deleted videos
+var trashed = bson.E{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: true}}}
+
+// playbackID model for mongodb
+type playbackID struct {
+	ID     string `bson:"id"`
+	Policy string `bson:"policy"`
+}
+
+// staticRenditions model for mongodb
+type staticRenditions struct {
+	Status string                `bson:"status,omitempty"`
+	Files  []staticRenditionFile `bson:"files,omitempty"`
+}
+
+// staticRenditionFile model for mongodb
+type staticRenditionFile struct {
+	Name   string `bson:"name,omitempty"`
+	Ext    string `bson:"ext,omitempty"`
+	Width  int32  `bson:"width,omitempty"`
+	Height int32  `bson:"height,omitempty"`
+}
+
+// track model for mongodb
+type track struct {
+	ID             string `bson:"id"`
+	Type           string `bson:"type"`
+	TextType       string `bson:"text_type,omitempty"`
+	TextSource     string `bson:"text_source,omitempty"`
+	Status         string `bson:"status,omitempty"`
+	Name           string `bson:"name,omitempty"`
+	LanguageCode   string `bson:"language_code,omitempty"`
+	ClosedCaptions bool   `bson:"closed_captions,omitempty"`
+	Primary        bool   `bson:"primary,omitempty"`
+	Passthrough    string `bson:"passthrough,omitempty"`
+}
+
+// chapter model for mongodb
+type chapter struct {
+	StartTime     float64  `bson:"start_time"`
+	Title         string   `bson:"title"`
+	ThumbnailTime *float64 `bson:"thumbnail_time,omitempty"`
+}
+
+// clip model for mongodb
+type clip struct {
+	StartTime float64 `bson:"start_time"`
+	EndTime   float64 `bson:"end_time"`
+}
+
+// audioInput model for mongodb
+type audioInput struct {
+	URL          string `bson:"url"`
+	LanguageCode string `bson:"language_code"`
+	Name         string `bson:"name,omitempty"`
 }
 
 // Create video creates a new ID, stores the video and returns the new object
 func (db *DB) Create(ctx context.Context, anyVideo model.Video) (model.Video, error) {
 	collection := db.mongo.Collection(Collection)
-	time := time.Now()
 
-	id := uuid.New().String()
+	insert := newVideo(anyVideo)
 
-	insert := &video{
-		ID:          id,
-		Title:       anyVideo.Title,
-		Description: anyVideo.Description,
-		CreatedAt:   time,
-		UpdatedAt:   time,
-	}
+	_, err := collection.InsertOne(ctx, insert)
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting video into collection")
 
-	if anyVideo.Asset != nil {
-		insert.AssetID = anyVideo.Asset.ID
+		return model.Video{}, err
 	}
 
+	return insert.toModel(), nil
+}
+
+// CreateForAsset stores a new video linked to the asset unless a video already
+// has it, the unique asset_id index makes a single replica win and the
+// existing video is returned to the others
+func (db *DB) CreateForAsset(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	collection := db.mongo.Collection(Collection)
+
+	insert := newVideo(anyVideo)
+
 	_, err := collection.InsertOne(ctx, insert)
-	if err != nil {
+	if err == nil {
+		return insert.toModel(), nil
+	}
+
+	if !mongo.IsDuplicateKeyError(err) || insert.AssetID == "" {
 		db.logger.WithError(err).Error("error inserting video into collection")
 
 		return model.Video{}, err
 	}
 
+	return db.GetByAssetID(ctx, insert.AssetID)
+}
+
+// CreateWithJob stores a new video and its ingestion job in one transaction,
+// a video is never left without its job nor a job without its video
+func (db *DB) CreateWithJob(ctx context.Context, anyVideo model.Video, anyJob model.Job) (model.Video, error) {
+	insert := newVideo(anyVideo)
+	queued := newJob(anyJob)
+	queued.VideoID = insert.ID
+
+	session, err := db.mongo.Client().StartSession()
+	if err != nil {
+		db.logger.WithError(err).Error("error starting session")
+
+		return model.Video{}, err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		if _, err := db.mongo.Collection(Collection).InsertOne(ctx, insert); err != nil {
+			return nil, err
+		}
+
+		return db.mongo.Collection(JobCollection).InsertOne(ctx, queued)
+	})
+	if err != nil {
+		db.logger.WithError(err).Error("error inserting video and job into collections")
+
+		return model.Video{}, err
+	}
+
 	return insert.toModel(), nil
 }
 
+// newVideo builds the document of a new video
+func newVideo(anyVideo model.Video) *video {
+	time := time.Now()
+
+	insert := &video{
+		ID:                uuid.New().String(),
+		Title:             anyVideo.Title,
+		Description:       anyVideo.Description,
+		Policy:            anyVideo.Policy,
+		SourceURL:         anyVideo.SourceURL,
+		Duration:          anyVideo.Duration,
+		MasterID:          anyVideo.MasterID,
+		Clip:              fromClip(anyVideo.Clip),
+		LiveStreamID:      anyVideo.LiveStreamID,
+		AllowDownload:     anyVideo.AllowDownload,
+		GeneratedCaptions: anyVideo.GeneratedCaptions,
+		AudioInputs:       fromAudioInputs(anyVideo.AudioInputs),
+		PosterTime:        anyVideo.PosterTime,
+		Chapters:          fromChapters(anyVideo.Chapters),
+		Tags:              anyVideo.Tags,
+		Metadata:          bson.M(anyVideo.Metadata),
+		Ingestion:         fromJobStatus(anyVideo.Ingestion),
+		CreatedAt:         time,
+		UpdatedAt:         time,
+	}
+
+	if anyVideo.Asset != nil {
+		insert.AssetID = anyVideo.Asset.ID
+		insert.AssetStatus = anyVideo.Asset.Status
+		insert.PlaybackIDs = fromPlaybackIDs(anyVideo.Asset.PlaybackIDs)
+		insert.StaticRenditions = fromStaticRenditions(anyVideo.Asset.StaticRenditions)
+		insert.Tracks = fromTracks(anyVideo.Asset.Tracks)
+		if anyVideo.Asset.Duration > 0 {
+			insert.Duration = anyVideo.Asset.Duration
+		}
+	}
+
+	return insert
+}
+
 // GetByID retrieves a video with the ID
 func (db *DB) GetByID(ctx context.Context, id string) (model.Video, error) {
+	return db.getOne(ctx, bson.D{{Key: "_id", Value: id}, notTrashed})
+}
+
+// GetByIDWithTrashed retrieves a video with the ID, trashed or not
+func (db *DB) GetByIDWithTrashed(ctx context.Context, id string) (model.Video, error) {
+	return db.getOne(ctx, bson.D{{Key: "_id", Value: id}})
+}
+
+// getOne retrieves the video matching the filter
+func (db *DB) getOne(ctx context.Context, filter bson.D) (model.Video, error) {
 	var response video
 
 	collection := db.mongo.Collection(Collection)
 
-	filter := bson.D{{Key: "_id", Value: id}}
-
 	err := collection.FindOne(ctx, filter).Decode(&response)
 
 	if err != nil {
@@ -80,9 +253,152 @@ func (db *DB) GetByID(ctx context.Context, id string) (model.Video, error) {
 	return response.toModel(), nil
 }
 
-// List returns paginated videos from the collection using page and limit parameters.
-func (db *DB) List(ctx context.Context, page, limit int) ([]model.Video, error) {
+// Update stores the editable fields of a video and bumps updatedAt
+func (db *DB) Update(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	var response video
+
 	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: anyVideo.ID}, notTrashed}
+
+	set := bson.D{
+		{Key: "title", Value: anyVideo.Title},
+		{Key: "description", Value: anyVideo.Description},
+		{Key: "updatedAt", Value: time.Now()},
+	}
+	unset := bson.D{}
+
+	// Tags, metadata, poster time, chapters and downloads are replaced, empty values remove the field
+	if anyVideo.AllowDownload {
+		set = append(set, bson.E{Key: "allow_download", Value: true})
+	} else {
+		unset = append(unset, bson.E{Key: "allow_download", Value: ""})
+	}
+	if anyVideo.PosterTime != nil {
+		set = append(set, bson.E{Key: "poster_time", Value: *anyVideo.PosterTime})
+	} else {
+		unset = append(unset, bson.E{Key: "poster_time", Value: ""})
+	}
+	if len(anyVideo.Chapters) > 0 {
+		set = append(set, bson.E{Key: "chapters", Value: fromChapters(anyVideo.Chapters)})
+	} else {
+		unset = append(unset, bson.E{Key: "chapters", Value: ""})
+	}
+	if len(anyVideo.Tags) > 0 {
+		set = append(set, bson.E{Key: "tags", Value: anyVideo.Tags})
+	} else {
+		unset = append(unset, bson.E{Key: "tags", Value: ""})
+	}
+	if len(anyVideo.Metadata) > 0 {
+		set = append(set, bson.E{Key: "metadata", Value: bson.M(anyVideo.Metadata)})
+	} else {
+		unset = append(unset, bson.E{Key: "metadata", Value: ""})
+	}
+
+	update := bson.D{{Key: "$set", Value: set}}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&response)
+
+	if err != nil {
+		db.logger.WithError(err).Error("error updating video")
+
+		if err == mongo.ErrNoDocuments {
+			return model.Video{}, errorcodes.ErrVideoNotFound
+		}
+
+		return model.Video{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// Delete removes the video document, trashed or not, and returns it
+func (db *DB) Delete(ctx context.Context, id string) (model.Video, error) {
+	var response video
+
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	err := collection.FindOneAndDelete(ctx, filter).Decode(&response)
+
+	if err != nil {
+		db.logger.WithError(err).Error("error deleting video")
+
+		if err == mongo.ErrNoDocuments {
+			return model.Video{}, errorcodes.ErrVideoNotFound
+		}
+
+		return model.Video{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// Trash soft deletes the video by setting deletedAt
+func (db *DB) Trash(ctx context.Context, id string) error {
+	collection := db.mongo.Collection(Collection)
+
+	now := time.Now()
+
+	filter := bson.D{{Key: "_id", Value: id}, notTrashed}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "deletedAt", Value: now},
+		{Key: "updatedAt", Value: now},
+	}}}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error trashing video")
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errorcodes.ErrVideoNotFound
+	}
+
+	return nil
+}
+
+// Restore removes the deletedAt marker from a trashed video
+func (db *DB) Restore(ctx context.Context, id string) (model.Video, error) {
+	var response video
+
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: id}, trashed}
+
+	update := bson.D{
+		{Key: "$unset", Value: bson.D{{Key: "deletedAt", Value: ""}}},
+		{Key: "$set", Value: bson.D{{Key: "updatedAt", Value: time.Now()}}},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&response)
+
+	if err != nil {
+		db.logger.WithError(err).Error("error restoring video")
+
+		if err == mongo.ErrNoDocuments {
+			return model.Video{}, errorcodes.ErrVideoNotFound
+		}
+
+		return model.Video{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// ListTrash returns paginated trashed videos, most recently deleted first
+func (db *DB) ListTrash(ctx context.Context, page, limit int) ([]model.Video, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -91,8 +407,267 @@ func (db *DB) List(ctx context.Context, page, limit int) ([]model.Video, error)
 	}
 	skip := int64((page - 1) * limit)
 	lim := int64(limit)
-	opts := options.Find().SetSkip(skip).SetLimit(lim).SetSort(bson.D{{Key: "createdAt", Value: 1}})
-	cur, err := collection.Find(ctx, bson.D{}, opts)
+	opts := options.Find().SetSkip(skip).SetLimit(lim).SetSort(bson.D{{Key: "deletedAt", Value: -1}})
+
+	return db.find(ctx, bson.D{trashed}, opts)
+}
+
+// ListIncomplete returns videos linked to an asset whose status or playback IDs
+// were never stored, ordered by ID and starting after the given ID
+func (db *DB) ListIncomplete(ctx context.Context, after string, limit int) ([]model.Video, error) {
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$gt", Value: after}}},
+		{Key: "asset_id", Value: bson.D{{Key: "$exists", Value: true}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "asset_status", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "asset_status", Value: "preparing"}},
+			bson.D{{Key: "playback_ids", Value: bson.D{{Key: "$exists", Value: false}}}},
+		}},
+	}
+	opts := options.Find().SetLimit(int64(limit)).SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	return db.find(ctx, filter, opts)
+}
+
+// ListLinked returns videos linked to an asset, trashed ones included,
+// ordered by ID and starting after the given ID
+func (db *DB) ListLinked(ctx context.Context, after string, limit int) ([]model.Video, error) {
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$gt", Value: after}}},
+		{Key: "asset_id", Value: bson.D{{Key: "$exists", Value: true}}},
+	}
+	opts := options.Find().SetLimit(int64(limit)).SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	return db.find(ctx, filter, opts)
+}
+
+// ListPurgeable returns trashed videos deleted before the given time
+func (db *DB) ListPurgeable(ctx context.Context, before time.Time, limit int) ([]model.Video, error) {
+	filter := bson.D{{Key: "deletedAt", Value: bson.D{{Key: "$lt", Value: before}}}}
+	opts := options.Find().SetLimit(int64(limit)).SetSort(bson.D{{Key: "deletedAt", Value: 1}})
+
+	return db.find(ctx, filter, opts)
+}
+
+// ListByIDs returns the videos with the IDs, trashed videos are skipped
+func (db *DB) ListByIDs(ctx context.Context, ids []string) ([]model.Video, error) {
+	filter := bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}, notTrashed}
+
+	return db.find(ctx, filter, options.Find())
+}
+
+// GetByAssetID retrieves the video linked to a Mux Asset ID
+func (db *DB) GetByAssetID(ctx context.Context, assetID string) (model.Video, error) {
+	var response video
+
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "asset_id", Value: assetID}}
+
+	err := collection.FindOne(ctx, filter).Decode(&response)
+
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Video{}, errorcodes.ErrVideoNotFound
+		}
+
+		db.logger.WithError(err).Error("error getting video by asset ID")
+
+		return model.Video{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// UpdateAsset stores the asset state (status, duration, playback IDs, renditions) on the video
+func (db *DB) UpdateAsset(ctx context.Context, id string, asset model.Asset) (model.Video, error) {
+	var response video
+
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	set := bson.D{
+		{Key: "asset_id", Value: asset.ID},
+		{Key: "asset_status", Value: asset.Status},
+		{Key: "playback_ids", Value: fromPlaybackIDs(asset.PlaybackIDs)},
+		{Key: "updatedAt", Value: time.Now()},
+	}
+
+	if asset.Duration > 0 {
+		set = append(set, bson.E{Key: "duration", Value: asset.Duration})
+	}
+
+	// The video policy follows the playback policy of its asset
+	if len(asset.PlaybackIDs) > 0 {
+		set = append(set, bson.E{Key: "policy", Value: asset.PlaybackIDs[0].Policy})
+	}
+
+	if asset.StaticRenditions != nil {
+		set = append(set, bson.E{Key: "static_renditions", Value: fromStaticRenditions(asset.StaticRenditions)})
+	}
+
+	// Assets reported without tracks keep the stored ones
+	if asset.Tracks != nil {
+		set = append(set, bson.E{Key: "tracks", Value: fromTracks(asset.Tracks)})
+	}
+
+	update := bson.D{{Key: "$set", Value: set}}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&response)
+
+	if err != nil {
+		db.logger.WithError(err).Error("error updating video asset")
+
+		if err == mongo.ErrNoDocuments {
+			return model.Video{}, errorcodes.ErrVideoNotFound
+		}
+
+		return model.Video{}, err
+	}
+
+	return response.toModel(), nil
+}
+
+// UpdateIngestion stores the state of the ingestion job of a video
+func (db *DB) UpdateIngestion(ctx context.Context, id string, status model.JobStatus) error {
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "ingestion", Value: fromJobStatus(&status)},
+		{Key: "updatedAt", Value: time.Now()},
+	}}}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error updating video ingestion")
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errorcodes.ErrVideoNotFound
+	}
+
+	return nil
+}
+
+// UpdateAllowDownload stores whether the MP4 renditions of a video can be downloaded
+func (db *DB) UpdateAllowDownload(ctx context.Context, id string, allow bool) error {
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "allow_download", Value: allow},
+		{Key: "updatedAt", Value: time.Now()},
+	}}}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error updating video downloads")
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errorcodes.ErrVideoNotFound
+	}
+
+	return nil
+}
+
+// SaveTrack stores a track of the asset of a video, replacing the one with the same ID
+func (db *DB) SaveTrack(ctx context.Context, id string, anyTrack model.Track) error {
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "tracks.id", Value: anyTrack.ID}}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "tracks.$", Value: fromTrack(anyTrack)},
+		{Key: "updatedAt", Value: time.Now()},
+	}}}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error updating video track")
+
+		return err
+	}
+
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// A new track is added
+	filter = bson.D{{Key: "_id", Value: id}}
+
+	update = bson.D{
+		{Key: "$push", Value: bson.D{{Key: "tracks", Value: fromTrack(anyTrack)}}},
+		{Key: "$set", Value: bson.D{{Key: "updatedAt", Value: time.Now()}}},
+	}
+
+	result, err = collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error adding video track")
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errorcodes.ErrVideoNotFound
+	}
+
+	return nil
+}
+
+// RemoveTrack removes a track from the asset of a video
+func (db *DB) RemoveTrack(ctx context.Context, id string, trackID string) error {
+	collection := db.mongo.Collection(Collection)
+
+	filter := bson.D{{Key: "_id", Value: id}}
+
+	update := bson.D{
+		{Key: "$pull", Value: bson.D{{Key: "tracks", Value: bson.D{{Key: "id", Value: trackID}}}}},
+		{Key: "$set", Value: bson.D{{Key: "updatedAt", Value: time.Now()}}},
+	}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		db.logger.WithError(err).Error("error removing video track")
+
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errorcodes.ErrVideoNotFound
+	}
+
+	return nil
+}
+
+// find decodes the videos matching the filter
+func (db *DB) find(ctx context.Context, filter bson.D, opts *options.FindOptionsBuilder) ([]model.Video, error) {
+	documents, err := db.findDocuments(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var videos []model.Video
+	for _, document := range documents {
+		videos = append(videos, document.toModel())
+	}
+	return videos, nil
+}
+
+// findDocuments returns the raw documents matching the filter
+func (db *DB) findDocuments(ctx context.Context, filter bson.D, opts *options.FindOptionsBuilder) ([]video, error) {
+	collection := db.mongo.Collection(Collection)
+	cur, err := collection.Find(ctx, filter, opts)
 	if err != nil {
 		db.logger.WithError(err).Error("error listing videos")
 
@@ -100,30 +675,235 @@ func (db *DB) List(ctx context.Context, page, limit int) ([]model.Video, error)
 	}
 	defer cur.Close(ctx)
 
-	var videos []model.Video
+	var documents []video
 	for cur.Next(ctx) {
 		var v video
 		if err := cur.Decode(&v); err != nil {
 			return nil, err
 		}
-		videos = append(videos, v.toModel())
+		documents = append(documents, v)
 	}
 	if err := cur.Err(); err != nil {
 		return nil, err
 	}
-	return videos, nil
+	return documents, nil
 }
 
 func (v video) toModel() model.Video {
+	var deletedAt string
+	if v.DeletedAt != nil {
+		deletedAt = v.DeletedAt.String()
+	}
+
+	// Videos without an asset keep a nil Asset
+	var asset *model.Asset
+	if len(v.AssetID) > 0 {
+		asset = &model.Asset{
+			ID:               v.AssetID,
+			Status:           v.AssetStatus,
+			Duration:         v.Duration,
+			PlaybackIDs:      toPlaybackIDs(v.PlaybackIDs),
+			StaticRenditions: toStaticRenditions(v.StaticRenditions),
+			Tracks:           toTracks(v.Tracks),
+		}
+	}
+
 	return model.Video{
-		ID:          v.ID,
-		Title:       v.Title,
-		Description: v.Description,
-		Asset: &model.Asset{
-			ID: v.AssetID,
-		},
-		Duration:  v.Duration,
-		CreatedAt: v.CreatedAt.String(),
-		UpdatedAt: v.UpdatedAt.String(),
+		ID:                v.ID,
+		Title:             v.Title,
+		Description:       v.Description,
+		SourceURL:         v.SourceURL,
+		Policy:            v.Policy,
+		MasterID:          v.MasterID,
+		Clip:              toClip(v.Clip),
+		LiveStreamID:      v.LiveStreamID,
+		AllowDownload:     v.AllowDownload,
+		GeneratedCaptions: v.GeneratedCaptions,
+		AudioInputs:       toAudioInputs(v.AudioInputs),
+		PosterTime:        v.PosterTime,
+		Chapters:          toChapters(v.Chapters),
+		Tags:              v.Tags,
+		Metadata:          model.Metadata(v.Metadata),
+		Asset:             asset,
+		Ingestion:         toJobStatus(v.Ingestion),
+		Duration:          v.Duration,
+		CreatedAt:         v.CreatedAt.String(),
+		UpdatedAt:         v.UpdatedAt.String(),
+		DeletedAt:         deletedAt,
+	}
+}
+
+func fromPlaybackIDs(ids []model.PlaybackID) []playbackID {
+	var response []playbackID
+	for _, id := range ids {
+		response = append(response, playbackID{ID: id.ID, Policy: id.Policy})
+	}
+	return response
+}
+
+func toPlaybackIDs(ids []playbackID) []model.PlaybackID {
+	var response []model.PlaybackID
+	for _, id := range ids {
+		response = append(response, model.PlaybackID{ID: id.ID, Policy: id.Policy})
+	}
+	return response
+}
+
+func fromStaticRenditions(r *model.StaticRenditions) *staticRenditions {
+	if r == nil {
+		return nil
+	}
+	response := &staticRenditions{Status: r.Status}
+	for _, f := range r.Files {
+		response.Files = append(response.Files, staticRenditionFile{
+			Name:   f.Name,
+			Ext:    f.Ext,
+			Width:  f.Width,
+			Height: f.Height,
+		})
+	}
+	return response
+}
+
+func toStaticRenditions(r *staticRenditions) *model.StaticRenditions {
+	if r == nil {
+		return nil
+	}
+	response := &model.StaticRenditions{Status: r.Status}
+	for _, f := range r.Files {
+		response.Files = append(response.Files, model.StaticRenditionFile{
+			Name:   f.Name,
+			Ext:    f.Ext,
+			Width:  f.Width,
+			Height: f.Height,
+		})
 	}
+	return response
+}
+
+func fromTracks(tracks []model.Track) []track {
+	if tracks == nil {
+		return nil
+	}
+	response := make([]track, 0, len(tracks))
+	for _, t := range tracks {
+		response = append(response, fromTrack(t))
+	}
+	return response
+}
+
+func fromTrack(t model.Track) track {
+	return track{
+		ID:             t.ID,
+		Type:           t.Type,
+		TextType:       t.TextType,
+		TextSource:     t.TextSource,
+		Status:         t.Status,
+		Name:           t.Name,
+		LanguageCode:   t.LanguageCode,
+		ClosedCaptions: t.ClosedCaptions,
+		Primary:        t.Primary,
+		Passthrough:    t.Passthrough,
+	}
+}
+
+func toTracks(tracks []track) []model.Track {
+	var response []model.Track
+	for _, t := range tracks {
+		response = append(response, model.Track{
+			ID:             t.ID,
+			Type:           t.Type,
+			TextType:       t.TextType,
+			TextSource:     t.TextSource,
+			Status:         t.Status,
+			Name:           t.Name,
+			LanguageCode:   t.LanguageCode,
+			ClosedCaptions: t.ClosedCaptions,
+			Primary:        t.Primary,
+			Passthrough:    t.Passthrough,
+		})
+	}
+	return response
+}
+
+func fromChapters(chapters []model.Chapter) []chapter {
+	var response []chapter
+	for _, c := range chapters {
+		response = append(response, chapter{StartTime: c.StartTime, Title: c.Title, ThumbnailTime: c.ThumbnailTime})
+	}
+	return response
+}
+
+func toChapters(chapters []chapter) []model.Chapter {
+	var response []model.Chapter
+	for _, c := range chapters {
+		response = append(response, model.Chapter{StartTime: c.StartTime, Title: c.Title, ThumbnailTime: c.ThumbnailTime})
+	}
+	return response
+}
+
+func fromClip(c *model.Clip) *clip {
+	if c == nil {
+		return nil
+	}
+	return &clip{StartTime: c.StartTime, EndTime: c.EndTime}
+}
+
+func toClip(c *clip) *model.Clip {
+	if c == nil {
+		return nil
+	}
+	return &model.Clip{StartTime: c.StartTime, EndTime: c.EndTime}
+}
+
+func fromAudioInputs(inputs []model.AudioInput) []audioInput {
+	var response []audioInput
+	for _, i := range inputs {
+		response = append(response, audioInput{URL: i.URL, LanguageCode: i.LanguageCode, Name: i.Name})
+	}
+	return response
+}
+
+func toAudioInputs(inputs []audioInput) []model.AudioInput {
+	var response []model.AudioInput
+	for _, i := range inputs {
+		response = append(response, model.AudioInput{URL: i.URL, LanguageCode: i.LanguageCode, Name: i.Name})
+	}
+	return response
+}
+
+func fromJobStatus(s *model.JobStatus) *jobStatus {
+	if s == nil {
+		return nil
+	}
+
+	response := &jobStatus{
+		Status:   s.Status,
+		Attempts: s.Attempts,
+		Error:    s.Error,
+	}
+
+	if next, err := time.Parse(time.RFC3339, s.NextAttemptAt); err == nil {
+		response.NextAttemptAt = &next
+	}
+
+	return response
+}
+
+func toJobStatus(s *jobStatus) *model.JobStatus {
+	if s == nil {
+		return nil
+	}
+
+	response := &model.JobStatus{
+		Status:   s.Status,
+		Attempts: s.Attempts,
+		Error:    s.Error,
+	}
+
+	if s.NextAttemptAt != nil {
+		response.NextAttemptAt = s.NextAttemptAt.UTC().Format(time.RFC3339)
+	}
+
+	return response
 }
@@ -2,13 +2,15 @@ package muxinc
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	muxgo "github.com/muxinc/mux-go/v5"
 
+	"github.com/javiertlopez/idlemux/errorcodes"
 	"github.com/javiertlopez/idlemux/model"
 )
 
@@ -18,50 +20,125 @@ const (
 	posterHeight    = 1080
 	thumbnailWidth  = 640
 	thumbnailHeight = 360
-	imageTime       = "7"
+	imageTime       = 7.0 // default poster frame, in seconds
 )
 
+// Static rendition settings of Mux.com
+const (
+	mp4Standard     = "standard"
+	mp4None         = "none"
+	renditionsReady = "ready"
+)
+
+// param is a transformation of a Mux.com URL, a query parameter of a public
+// URL and a claim of the token of a signed one
+type param struct {
+	name  string
+	value interface{}
+}
+
 // asset struct
 type asset struct {
 	data muxgo.Asset
 }
 
-// Ingest send a source file url to mux.com
+// Ingest send a source file url to mux.com, the passthrough of the settings
+// carries the video ID so an asset can be traced back to its video
 // Returns a string Asset ID
-func (a *assets) Create(ctx context.Context, source string, public bool) (model.Asset, error) {
-	var policy []muxgo.PlaybackPolicy
+func (a *assets) Create(ctx context.Context, source string, settings model.AssetSettings) (model.Asset, error) {
+	response, err := a.mux.AssetsApi.CreateAsset(a.assetRequest(source, settings))
 
-	if public {
-		policy = append(policy, muxgo.PUBLIC)
-	} else {
-		policy = append(policy, muxgo.SIGNED)
+	if err != nil {
+		a.logger.WithError(err).Error("error creating asset")
+
+		return model.Asset{}, err
 	}
 
-	asset, err := a.mux.AssetsApi.CreateAsset(muxgo.CreateAssetRequest{
-		Input: []muxgo.InputSettings{
-			{
-				Url: source,
-			},
-		},
-		PlaybackPolicy: policy,
+	body := asset{
+		data: response.Data,
+	}
+
+	return body.toModel(), nil
+}
+
+// assetRequest returns the request of a new asset, direct uploads have no
+// source URL. With MP4 support, Mux.com also prepares the static renditions
+// offered as downloads. Audio inputs follow the main input as alternate
+// audio tracks. A clip cuts the section of an existing asset, its source is
+// a mux://assets/{id} URL.
+func (a *assets) assetRequest(source string, settings model.AssetSettings) muxgo.CreateAssetRequest {
+	policy := muxgo.SIGNED
+	if settings.Public {
+		policy = muxgo.PUBLIC
+	}
+
+	input := muxgo.InputSettings{Url: source}
+	if settings.Clip != nil {
+		input.StartTime = settings.Clip.StartTime
+		input.EndTime = settings.Clip.EndTime
+	}
+	if len(settings.GeneratedCaptions) > 0 {
+		input.GeneratedSubtitles = []muxgo.AssetGeneratedSubtitleSettings{
+			{LanguageCode: settings.GeneratedCaptions},
+		}
+	}
+
+	// The main input of a direct upload has no URL, it is kept to come first
+	var inputs []muxgo.InputSettings
+	if len(input.Url) > 0 || len(input.GeneratedSubtitles) > 0 || len(settings.AudioInputs) > 0 {
+		inputs = []muxgo.InputSettings{input}
+	}
+
+	for _, audio := range settings.AudioInputs {
+		inputs = append(inputs, muxgo.InputSettings{
+			Url:          audio.URL,
+			Type:         model.TrackAudio,
+			LanguageCode: audio.LanguageCode,
+			Name:         audio.Name,
+		})
+	}
+
+	return muxgo.CreateAssetRequest{
+		Input:          inputs,
+		PlaybackPolicy: []muxgo.PlaybackPolicy{policy},
+		Mp4Support:     mp4SupportSetting(settings.MP4Support),
+		Passthrough:    settings.Passthrough,
 		Test:           a.test,
-	})
+	}
+}
 
+// List returns a page of the assets stored in Mux.com, newest first
+func (a *assets) List(ctx context.Context, page, limit int) ([]model.Asset, error) {
+	response, err := a.mux.AssetsApi.ListAssets(muxgo.WithParams(&muxgo.ListAssetsParams{
+		Limit: int32(limit),
+		Page:  int32(page),
+	}))
 	if err != nil {
-		a.logger.WithError(err).Error("error creating asset")
+		a.logger.WithError(err).Error("error listing assets")
 
-		return model.Asset{}, err
+		return nil, err
 	}
 
-	return model.Asset{
-		ID: asset.Data.Id,
-	}, nil
+	var assets []model.Asset
+	for _, data := range response.Data {
+		body := asset{
+			data: data,
+		}
+		assets = append(assets, body.toModel())
+	}
+
+	return assets, nil
 }
 
 // GetByID retrieves an asset from Mux.com by Asset ID
 func (a *assets) GetByID(ctx context.Context, id string) (model.Asset, error) {
 	response, err := a.mux.AssetsApi.GetAsset(id)
 	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return model.Asset{}, errorcodes.ErrAssetNotFound
+		}
+
 		a.logger.WithError(err).Error("error retrieving asset by ID")
 
 		return model.Asset{}, err
@@ -73,11 +150,8 @@ func (a *assets) GetByID(ctx context.Context, id string) (model.Asset, error) {
 
 	asset := body.toModel()
 
-	if len(body.data.PlaybackIds) > 0 {
-		playbackID := body.data.PlaybackIds[0].Id
-		policy := body.data.PlaybackIds[0].Policy
-
-		if err := a.hydrateAssetURLs(playbackID, policy, asset.Duration, &asset); err != nil {
+	if len(asset.PlaybackIDs) > 0 {
+		if err := a.hydrateAssetURLs(asset.PlaybackIDs[0], model.HydrateOptions{}, &asset); err != nil {
 			a.logger.WithError(err).Error("error generating asset URLs")
 
 			return model.Asset{}, err
@@ -87,23 +161,190 @@ func (a *assets) GetByID(ctx context.Context, id string) (model.Asset, error) {
 	return asset, nil
 }
 
-// hydrateAssetURLs adds source, poster, and thumbnail URLs to the asset
-func (a *assets) hydrateAssetURLs(playbackID string, policy muxgo.PlaybackPolicy, duration float64, asset *model.Asset) error {
-	var source, poster, thumbnail string
-	var err error
+// Delete removes an asset from Mux.com
+func (a *assets) Delete(ctx context.Context, id string) error {
+	err := a.mux.AssetsApi.DeleteAsset(id)
+	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return errorcodes.ErrAssetNotFound
+		}
+
+		a.logger.WithError(err).Error("error deleting asset")
+
+		return err
+	}
+
+	return nil
+}
+
+// EnableRenditions asks Mux.com to prepare the static MP4 renditions of an
+// existing asset, the renditions.ready webhook reports when they are done
+func (a *assets) EnableRenditions(ctx context.Context, id string) (model.Asset, error) {
+	response, err := a.mux.AssetsApi.UpdateAssetMp4Support(id, muxgo.UpdateAssetMp4SupportRequest{
+		Mp4Support: mp4Standard,
+	})
+	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return model.Asset{}, errorcodes.ErrAssetNotFound
+		}
+
+		a.logger.WithError(err).Error("error enabling static renditions")
+
+		return model.Asset{}, err
+	}
+
+	body := asset{
+		data: response.Data,
+	}
+
+	return body.toModel(), nil
+}
+
+// Hydrate adds the source, poster, thumbnail and preview URLs to a stored
+// asset without calling Mux.com. The options request the lifetime of the
+// signed tokens, bounded by the signing policy, and the poster frame.
+func (a *assets) Hydrate(ctx context.Context, asset model.Asset, opts model.HydrateOptions) (model.Asset, error) {
+	if len(asset.PlaybackIDs) == 0 {
+		return asset, nil
+	}
+
+	if err := a.hydrateAssetURLs(asset.PlaybackIDs[0], opts, &asset); err != nil {
+		a.logger.WithError(err).Error("error generating asset URLs")
+
+		return model.Asset{}, err
+	}
+
+	return asset, nil
+}
 
-	switch policy {
+// Image returns the URL of an image of the asset, a GIF starts at the given time
+func (a *assets) Image(ctx context.Context, asset model.Asset, opts model.ImageOptions) (model.Image, error) {
+	if len(asset.PlaybackIDs) == 0 {
+		return model.Image{}, errorcodes.ErrNoPlayback
+	}
+
+	playbackID := asset.PlaybackIDs[0]
+	signed := muxgo.PlaybackPolicy(playbackID.Policy) == muxgo.SIGNED
+
+	frame := imageTime
+	if opts.Time != nil {
+		frame = *opts.Time
+	}
+
+	var params []param
+	if opts.Width > 0 {
+		params = append(params, param{"width", opts.Width})
+	}
+	if opts.Height > 0 {
+		params = append(params, param{"height", opts.Height})
+	}
+
+	pattern := "https://image.mux.com/%s/thumbnail." + opts.Format
+	audience := "t"
+	if opts.Format == "gif" {
+		pattern = "https://image.mux.com/%s/animated.gif"
+		audience = "g"
+		params = append(params, param{"start", frame})
+	} else {
+		params = append(params, param{"time", frame})
+	}
+
+	url, err := a.mediaURL(pattern, playbackID.ID, audience, signed, a.policy.ttl(asset.Duration, 0), params)
+	if err != nil {
+		a.logger.WithError(err).Error("error generating image URL")
+
+		return model.Image{}, err
+	}
+
+	return model.Image{
+		URL:    url,
+		Format: opts.Format,
+		Width:  opts.Width,
+		Height: opts.Height,
+		Time:   frame,
+	}, nil
+}
+
+// Chapters adds the thumbnail URLs to the chapters of a video, signed like the
+// thumbnail of the asset. A chapter without thumbnail time shows its first frame.
+func (a *assets) Chapters(ctx context.Context, asset model.Asset, chapters []model.Chapter, opts model.HydrateOptions) ([]model.Chapter, error) {
+	if len(asset.PlaybackIDs) == 0 {
+		return chapters, nil
+	}
+
+	playbackID := asset.PlaybackIDs[0]
+	signed := muxgo.PlaybackPolicy(playbackID.Policy) == muxgo.SIGNED
+	ttl := a.policy.ttl(asset.Duration, opts.TokenTTL)
+
+	response := make([]model.Chapter, 0, len(chapters))
+	for _, chapter := range chapters {
+		frame := chapter.StartTime
+		if chapter.ThumbnailTime != nil {
+			frame = *chapter.ThumbnailTime
+		}
+
+		url, err := a.mediaURL("https://image.mux.com/%s/thumbnail.png", playbackID.ID, "t", signed, ttl, thumbnailParams(thumbnailWidth, thumbnailHeight, frame))
+		if err != nil {
+			a.logger.WithError(err).Error("error generating chapter thumbnail URL")
+
+			return nil, err
+		}
+
+		chapter.Thumbnail = url
+		response = append(response, chapter)
+	}
+
+	return response, nil
+}
+
+// hydrateAssetURLs adds the source, poster, thumbnail and preview URLs to the asset
+func (a *assets) hydrateAssetURLs(playbackID model.PlaybackID, opts model.HydrateOptions, asset *model.Asset) error {
+	var signed bool
+	switch muxgo.PlaybackPolicy(playbackID.Policy) {
 	case muxgo.PUBLIC:
-		source, poster, thumbnail = a.generatePublicURLs(playbackID)
+		signed = false
 	case muxgo.SIGNED:
-		source, poster, thumbnail, err = a.generateSignedURLs(playbackID, duration)
+		signed = true
+	default:
+		return nil
+	}
+
+	ttl := a.policy.ttl(asset.Duration, opts.TokenTTL)
+
+	frame := imageTime
+	if opts.PosterTime != nil {
+		frame = *opts.PosterTime
+	}
+
+	var source string
+	previews := &model.Previews{}
+
+	urls := []struct {
+		name     string
+		pattern  string
+		audience string
+		params   []param
+		url      *string
+	}{
+		{"video playback", "https://stream.mux.com/%s.m3u8", "v", nil, &source},
+		{"poster", "https://image.mux.com/%s/thumbnail.png", "t", thumbnailParams(posterWidth, posterHeight, frame), &asset.Poster},
+		{"thumbnail", "https://image.mux.com/%s/thumbnail.png", "t", thumbnailParams(thumbnailWidth, thumbnailHeight, frame), &asset.Thumbnail},
+		{"GIF preview", "https://image.mux.com/%s/animated.gif", "g", nil, &previews.GIF},
+		{"WebP preview", "https://image.mux.com/%s/animated.webp", "g", nil, &previews.WebP},
+		{"storyboard", "https://image.mux.com/%s/storyboard.vtt", "s", nil, &previews.StoryboardVTT},
+		{"storyboard", "https://image.mux.com/%s/storyboard.json", "s", nil, &previews.StoryboardJSON},
+	}
+	for _, u := range urls {
+		url, err := a.mediaURL(u.pattern, playbackID.ID, u.audience, signed, ttl, u.params)
 		if err != nil {
-			return err
+			return fmt.Errorf("error signing URL for %s: %w", u.name, err)
 		}
+		*u.url = url
 	}
 
-	asset.Poster = poster
-	asset.Thumbnail = thumbnail
+	asset.Previews = previews
 	asset.Sources = []model.Source{
 		{
 			Source: source,
@@ -111,77 +352,130 @@ func (a *assets) hydrateAssetURLs(playbackID string, policy muxgo.PlaybackPolicy
 		},
 	}
 
+	// Text tracks are also served as WebVTT files, for players without HLS
+	for i, track := range asset.Tracks {
+		if track.Type != model.TrackText || track.Status != "ready" {
+			continue
+		}
+
+		url, err := a.mediaURL("https://stream.mux.com/%s/text/"+track.ID+".vtt", playbackID.ID, "v", signed, ttl, nil)
+		if err != nil {
+			return fmt.Errorf("error signing URL for track %s: %w", track.ID, err)
+		}
+		asset.Tracks[i].URL = url
+	}
+
+	// The MP4 files are downloads, they share the token of the HLS playback
+	if opts.Downloads && asset.StaticRenditions != nil && asset.StaticRenditions.Status == renditionsReady {
+		for _, file := range asset.StaticRenditions.Files {
+			url, err := a.mediaURL("https://stream.mux.com/%s/"+file.Name, playbackID.ID, "v", signed, ttl, nil)
+			if err != nil {
+				return fmt.Errorf("error signing URL for rendition %s: %w", file.Name, err)
+			}
+
+			asset.Sources = append(asset.Sources, model.Source{
+				Source: url,
+				Type:   renditionType(file.Ext),
+			})
+		}
+	}
+
 	return nil
 }
 
-// generatePublicURLs creates public URLs for the asset
-func (a *assets) generatePublicURLs(playbackID string) (source, poster, thumbnail string) {
-	source = fmt.Sprintf(
-		"https://stream.mux.com/%s.m3u8",
-		playbackID,
-	)
+// mp4SupportSetting returns the static rendition setting of a new asset
+func mp4SupportSetting(enabled bool) string {
+	if enabled {
+		return mp4Standard
+	}
 
-	poster = fmt.Sprintf(
-		"https://image.mux.com/%s/thumbnail.png?width=%d&height=%d&smart_crop=true&time=%s",
-		playbackID,
-		posterWidth,
-		posterHeight,
-		imageTime,
-	)
+	return mp4None
+}
 
-	thumbnail = fmt.Sprintf(
-		"https://image.mux.com/%s/thumbnail.png?width=%d&height=%d&smart_crop=true&time=%s",
-		playbackID,
-		thumbnailWidth,
-		thumbnailHeight,
-		imageTime,
-	)
+// renditionType returns the MIME type of a static rendition, audio-only
+// renditions are M4A files
+func renditionType(ext string) string {
+	if ext == "m4a" {
+		return "audio/mp4"
+	}
 
-	return source, poster, thumbnail
+	return "video/mp4"
 }
 
-// generateSignedURLs creates signed URLs for the asset
-func (a *assets) generateSignedURLs(playbackID string, duration float64) (source, poster, thumbnail string, err error) {
-	// Generate video token
-	videoToken, err := a.signURL(playbackID, "v", duration, 0, 0)
-	if err != nil {
-		return "", "", "", fmt.Errorf("error signing URL for video playback: %w", err)
+// thumbnailParams returns the transformations of a poster or a thumbnail
+func thumbnailParams(width, height int, frame float64) []param {
+	return []param{
+		{"width", width},
+		{"height", height},
+		{"smart_crop", true},
+		{"time", frame},
 	}
+}
 
-	// Generate poster token
-	posterToken, err := a.signURL(playbackID, "t", duration, posterWidth, posterHeight)
-	if err != nil {
-		return "", "", "", fmt.Errorf("error signing URL for poster: %w", err)
+// mediaURL returns the URL of a file of the playback ID. The params are sent
+// in the query of a public URL and as claims of the token of a signed one.
+func (a *assets) mediaURL(pattern, playbackID, audience string, signed bool, ttl time.Duration, params []param) (string, error) {
+	base := fmt.Sprintf(pattern, playbackID)
+
+	if !signed {
+		if len(params) == 0 {
+			return base, nil
+		}
+
+		return base + "?" + encodeParams(params), nil
 	}
 
-	// Generate thumbnail token
-	thumbnailToken, err := a.signURL(playbackID, "t", duration, thumbnailWidth, thumbnailHeight)
+	token, err := a.signURL(playbackID, audience, ttl, params)
 	if err != nil {
-		return "", "", "", fmt.Errorf("error signing URL for thumbnail: %w", err)
+		return "", err
 	}
 
-	source = fmt.Sprintf(
-		"https://stream.mux.com/%s.m3u8?token=%s",
-		playbackID,
-		videoToken,
-	)
-
-	poster = fmt.Sprintf(
-		"https://image.mux.com/%s/thumbnail.png?token=%s",
-		playbackID,
-		posterToken,
-	)
+	return base + "?token=" + token, nil
+}
 
-	thumbnail = fmt.Sprintf(
-		"https://image.mux.com/%s/thumbnail.png?token=%s",
-		playbackID,
-		thumbnailToken,
-	)
+// encodeParams returns the params as a query string, in order
+func encodeParams(params []param) string {
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		parts = append(parts, fmt.Sprintf("%s=%v", p.name, p.value))
+	}
 
-	return source, poster, thumbnail, nil
+	return strings.Join(parts, "&")
 }
 
 func (a *asset) toModel() model.Asset {
+	var playbackIDs []model.PlaybackID
+	for _, playbackID := range a.data.PlaybackIds {
+		playbackIDs = append(playbackIDs, model.PlaybackID{
+			ID:     playbackID.Id,
+			Policy: string(playbackID.Policy),
+		})
+	}
+
+	var staticRenditions *model.StaticRenditions
+	if len(a.data.StaticRenditions.Status) > 0 {
+		staticRenditions = &model.StaticRenditions{
+			Status: a.data.StaticRenditions.Status,
+		}
+		for _, file := range a.data.StaticRenditions.Files {
+			staticRenditions.Files = append(staticRenditions.Files, model.StaticRenditionFile{
+				Name:   file.Name,
+				Ext:    file.Ext,
+				Width:  file.Width,
+				Height: file.Height,
+			})
+		}
+	}
+
+	// Assets reported without tracks keep the stored ones
+	var tracks []model.Track
+	if len(a.data.Tracks) > 0 {
+		tracks = make([]model.Track, 0, len(a.data.Tracks))
+		for _, track := range a.data.Tracks {
+			tracks = append(tracks, toTrack(track))
+		}
+	}
+
 	return model.Asset{
 		ID:                  a.data.Id,
 		CreatedAt:           a.data.CreatedAt,
@@ -191,40 +485,62 @@ func (a *asset) toModel() model.Asset {
 		MaxStoredFrameRate:  a.data.MaxStoredFrameRate,
 		AspectRatio:         a.data.AspectRatio,
 		Passthrough:         a.data.Passthrough,
+		LiveStreamID:        a.data.LiveStreamId,
+		PlaybackIDs:         playbackIDs,
+		StaticRenditions:    staticRenditions,
+		Tracks:              tracks,
 	}
 }
 
+// signURL returns the token of a playback ID, the params are added as claims
 func (a *assets) signURL(
 	playbackID string,
 	audience string,
-	duration float64,
-	width int,
-	height int,
+	ttl time.Duration,
+	params []param,
 ) (string, error) {
-	decodedKey, err := base64.StdEncoding.DecodeString(a.keySecret)
-	if err != nil {
-		return "", err
+	// A cached token is reused while it still has half of its lifetime
+	key := tokenKey{playbackID: playbackID, audience: audience, ttl: ttl, params: encodeParams(params)}
+	if token, ok := a.tokens.get(key, ttl/2); ok {
+		return token, nil
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	claims := a.claims(playbackID, audience, expiresAt)
+	for _, p := range params {
+		claims[p.name] = p.value
 	}
 
-	signKey, err := jwt.ParseRSAPrivateKeyFromPEM(decodedKey)
+	tokenString, err := a.sign(claims)
 	if err != nil {
 		return "", err
 	}
 
-	round := int(duration * 1.6)
+	a.tokens.set(key, tokenString, expiresAt)
+
+	return tokenString, nil
+}
 
+// claims returns the claims every token carries
+func (a *assets) claims(playbackID, audience string, expiresAt time.Time) jwt.MapClaims {
 	claims := jwt.MapClaims{
 		"sub": playbackID,
 		"aud": audience,
-		"exp": time.Now().Add(time.Second * time.Duration(round)).Unix(),
+		"exp": expiresAt.Unix(),
 		"kid": a.keyID,
 	}
 
-	if audience == "t" {
-		claims["time"] = 7
-		claims["width"] = width
-		claims["height"] = height
-		claims["smart_crop"] = true
+	if len(a.policy.PlaybackRestrictionID) > 0 {
+		claims["playback_restriction_id"] = a.policy.PlaybackRestrictionID
+	}
+
+	return claims
+}
+
+// sign returns the token of the claims signed with the signing key
+func (a *assets) sign(claims jwt.MapClaims) (string, error) {
+	if a.signKey == nil {
+		return "", errSigningDisabled
 	}
 
 	token := jwt.NewWithClaims(
@@ -232,10 +548,5 @@ func (a *assets) signURL(
 		claims,
 	)
 
-	tokenString, err := token.SignedString(signKey)
-	if err != nil {
-		return "", err
-	}
-
-	return tokenString, nil
+	return token.SignedString(a.signKey)
 }
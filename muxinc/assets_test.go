@@ -0,0 +1,62 @@
+package muxinc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+func TestAssets_HydrateDownloads(t *testing.T) {
+	playbackID := model.PlaybackID{ID: "playback", Policy: "public"}
+	renditions := &model.StaticRenditions{
+		Status: renditionsReady,
+		Files:  []model.StaticRenditionFile{{Name: "high.mp4", Ext: "mp4"}, {Name: "audio.m4a", Ext: "m4a"}},
+	}
+
+	tests := []struct {
+		name       string
+		opts       model.HydrateOptions
+		renditions *model.StaticRenditions
+		want       []model.Source
+	}{
+		{
+			name:       "Downloads allowed",
+			opts:       model.HydrateOptions{Downloads: true},
+			renditions: renditions,
+			want: []model.Source{
+				{Source: "https://stream.mux.com/playback.m3u8", Type: "application/x-mpegURL"},
+				{Source: "https://stream.mux.com/playback/high.mp4", Type: "video/mp4"},
+				{Source: "https://stream.mux.com/playback/audio.m4a", Type: "audio/mp4"},
+			},
+		},
+		{
+			name:       "Downloads not allowed",
+			renditions: renditions,
+			want: []model.Source{
+				{Source: "https://stream.mux.com/playback.m3u8", Type: "application/x-mpegURL"},
+			},
+		},
+		{
+			name:       "Renditions not ready",
+			opts:       model.HydrateOptions{Downloads: true},
+			renditions: &model.StaticRenditions{Status: "preparing"},
+			want: []model.Source{
+				{Source: "https://stream.mux.com/playback.m3u8", Type: "application/x-mpegURL"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &assets{policy: SigningPolicy{}.withDefaults(), tokens: newTokenCache()}
+			asset := model.Asset{PlaybackIDs: []model.PlaybackID{playbackID}, StaticRenditions: tt.renditions}
+
+			err := a.hydrateAssetURLs(playbackID, tt.opts, &asset)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, asset.Sources)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package muxinc
+
+import (
+	"context"
+	"errors"
+
+	muxgo "github.com/muxinc/mux-go/v5"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// ingestURL is the RTMPS endpoint broadcasting software sends a live stream to
+const ingestURL = "rtmps://global-live.mux.com:443/app"
+
+// CreateLiveStream asks Mux.com for a live stream, the settings apply to the
+// asset recorded from each broadcast and to the playback of the stream.
+func (a *assets) CreateLiveStream(ctx context.Context, settings model.AssetSettings) (model.LiveStream, error) {
+	recording := a.assetRequest("", settings)
+
+	response, err := a.mux.LiveStreamsApi.CreateLiveStream(muxgo.CreateLiveStreamRequest{
+		PlaybackPolicy:   recording.PlaybackPolicy,
+		NewAssetSettings: recording,
+		Test:             a.test,
+	})
+	if err != nil {
+		a.logger.WithError(err).Error("error creating live stream")
+
+		return model.LiveStream{}, err
+	}
+
+	return toLiveStream(response.Data), nil
+}
+
+// DisableLiveStream stops a live stream from accepting broadcasts, an active
+// broadcast is ended and its recording completed
+func (a *assets) DisableLiveStream(ctx context.Context, id string) error {
+	_, err := a.mux.LiveStreamsApi.DisableLiveStream(id)
+	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return errorcodes.ErrLiveStreamNotFound
+		}
+
+		a.logger.WithError(err).Error("error disabling live stream")
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteLiveStream removes a live stream from Mux.com, its recorded assets are kept
+func (a *assets) DeleteLiveStream(ctx context.Context, id string) error {
+	err := a.mux.LiveStreamsApi.DeleteLiveStream(id)
+	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return errorcodes.ErrLiveStreamNotFound
+		}
+
+		a.logger.WithError(err).Error("error deleting live stream")
+
+		return err
+	}
+
+	return nil
+}
+
+// HydrateLiveStream adds the ingest, source, poster and thumbnail URLs to a
+// stored live stream without calling Mux.com. A broadcast has no known
+// duration, so signed tokens get the longest lifetime of the signing policy.
+// Mux.com generates no GIF, WebP or storyboard for live playback, so only the
+// HLS source and the images are signed.
+func (a *assets) HydrateLiveStream(ctx context.Context, stream model.LiveStream) (model.LiveStream, error) {
+	stream.IngestURL = ingestURL
+
+	if len(stream.PlaybackIDs) == 0 {
+		return stream, nil
+	}
+
+	playbackID := stream.PlaybackIDs[0]
+	signed := muxgo.PlaybackPolicy(playbackID.Policy) == muxgo.SIGNED
+	ttl := a.policy.ttl(0, a.policy.MaxTTL)
+
+	var source string
+	urls := []struct {
+		pattern  string
+		audience string
+		params   []param
+		url      *string
+	}{
+		{"https://stream.mux.com/%s.m3u8", "v", nil, &source},
+		{"https://image.mux.com/%s/thumbnail.png", "t", thumbnailParams(posterWidth, posterHeight, imageTime), &stream.Poster},
+		{"https://image.mux.com/%s/thumbnail.png", "t", thumbnailParams(thumbnailWidth, thumbnailHeight, imageTime), &stream.Thumbnail},
+	}
+	for _, u := range urls {
+		url, err := a.mediaURL(u.pattern, playbackID.ID, u.audience, signed, ttl, u.params)
+		if err != nil {
+			a.logger.WithError(err).Error("error generating live stream URLs")
+
+			return model.LiveStream{}, err
+		}
+		*u.url = url
+	}
+
+	stream.Sources = []model.Source{
+		{
+			Source: source,
+			Type:   "application/x-mpegURL",
+		},
+	}
+
+	return stream, nil
+}
+
+func toLiveStream(data muxgo.LiveStream) model.LiveStream {
+	var playbackIDs []model.PlaybackID
+	for _, playbackID := range data.PlaybackIds {
+		playbackIDs = append(playbackIDs, model.PlaybackID{
+			ID:     playbackID.Id,
+			Policy: string(playbackID.Policy),
+		})
+	}
+
+	return model.LiveStream{
+		ID:          data.Id,
+		Status:      string(data.Status),
+		StreamKey:   data.StreamKey,
+		PlaybackIDs: playbackIDs,
+	}
+}
@@ -0,0 +1,50 @@
+package muxinc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+func TestAssets_HydrateLiveStream(t *testing.T) {
+	secret, _ := signingSecret(t)
+	signKey, _ := parseSigningKey(secret)
+
+	t.Run("Public playback", func(t *testing.T) {
+		a := &assets{policy: SigningPolicy{}.withDefaults(), tokens: newTokenCache()}
+		stream := model.LiveStream{ID: "ls1", PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "public"}}}
+
+		got, err := a.HydrateLiveStream(t.Context(), stream)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ingestURL, got.IngestURL)
+		assert.Equal(t, []model.Source{{Source: "https://stream.mux.com/playback.m3u8", Type: "application/x-mpegURL"}}, got.Sources)
+		assert.Contains(t, got.Poster, "https://image.mux.com/playback/thumbnail.png")
+		assert.Contains(t, got.Thumbnail, "https://image.mux.com/playback/thumbnail.png")
+	})
+
+	t.Run("Signed playback has no preview tokens", func(t *testing.T) {
+		a := &assets{keyID: "key", signKey: signKey, policy: SigningPolicy{}.withDefaults(), tokens: newTokenCache()}
+		stream := model.LiveStream{ID: "ls1", PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "signed"}}}
+
+		_, err := a.HydrateLiveStream(t.Context(), stream)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, a.tokens.tokens)
+		for key := range a.tokens.tokens {
+			assert.Contains(t, []string{"v", "t"}, key.audience, "Only playback and image tokens are signed")
+			assert.Equal(t, a.policy.MaxTTL, key.ttl)
+		}
+	})
+
+	t.Run("Without playback IDs", func(t *testing.T) {
+		a := &assets{policy: SigningPolicy{}.withDefaults(), tokens: newTokenCache()}
+
+		got, err := a.HydrateLiveStream(t.Context(), model.LiveStream{ID: "ls1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.LiveStream{ID: "ls1", IngestURL: ingestURL}, got)
+	})
+}
@@ -1,37 +1,86 @@
 package muxinc
 
 import (
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
 	muxgo "github.com/muxinc/mux-go/v5"
 	"github.com/sirupsen/logrus"
 )
 
+// errSigningDisabled is returned when a signed URL is requested without a signing key
+var errSigningDisabled = errors.New("mux signing key secret is empty, signed playback is disabled")
+
 // Assets struct
 type assets struct {
-	logger    *logrus.Logger
-	mux       *muxgo.APIClient
-	keyID     string
-	keySecret string
-	test      bool
+	logger  *logrus.Logger
+	mux     *muxgo.APIClient
+	keyID   string
+	signKey *rsa.PrivateKey
+	policy  SigningPolicy
+	tokens  *tokenCache
+	test    bool
 }
 
 // Config struct
 type Config struct {
-	KeyID     string
-	KeySecret string
-	Test      bool
+	KeyID         string
+	KeySecret     string
+	SigningPolicy SigningPolicy
+	Test          bool
 }
 
-// New returns an asset implementation (mux.com)
+// New returns an asset implementation (mux.com). The signing key is parsed
+// once, an invalid key or signing policy is an error. Without a key only
+// public videos can be played, signed URLs fail when they are requested.
 func New(
 	l *logrus.Logger,
 	m *muxgo.APIClient,
 	cfg Config,
-) *assets {
+) (*assets, error) {
+	signKey, err := parseSigningKey(cfg.KeySecret)
+	if err != nil {
+		return nil, err
+	}
+	if signKey == nil {
+		l.Warn("mux signing key secret is empty, signed playback is disabled")
+	}
+
+	policy := cfg.SigningPolicy.withDefaults()
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
 	return &assets{
-		logger:    l,
-		mux:       m,
-		keyID:     cfg.KeyID,
-		keySecret: cfg.KeySecret,
-		test:      cfg.Test,
+		logger:  l,
+		mux:     m,
+		keyID:   cfg.KeyID,
+		signKey: signKey,
+		policy:  policy,
+		tokens:  newTokenCache(),
+		test:    cfg.Test,
+	}, nil
+}
+
+// parseSigningKey decodes the base64 PEM private key of a Mux.com signing key,
+// an empty secret returns no key
+func parseSigningKey(secret string) (*rsa.PrivateKey, error) {
+	if len(secret) == 0 {
+		return nil, nil
+	}
+
+	decodedKey, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("mux signing key secret is not base64: %w", err)
 	}
+
+	signKey, err := jwt.ParseRSAPrivateKeyFromPEM(decodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("mux signing key secret is not an RSA private key: %w", err)
+	}
+
+	return signKey, nil
 }
@@ -0,0 +1,106 @@
+package muxinc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// signingSecret returns a signing key secret the way Mux.com hands it out
+func signingSecret(t *testing.T) (string, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return base64.StdEncoding.EncodeToString(block), key
+}
+
+func TestParseSigningKey(t *testing.T) {
+	secret, key := signingSecret(t)
+
+	tests := []struct {
+		name    string
+		secret  string
+		want    *rsa.PrivateKey
+		wantErr bool
+	}{
+		{"Valid key", secret, key, false},
+		{"Empty secret disables signing", "", nil, false},
+		{"Not base64", "not base64!", nil, true},
+		{"Not a private key", base64.StdEncoding.EncodeToString([]byte("Wonderwall")), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSigningKey(tt.secret)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+			} else {
+				assert.True(t, tt.want.Equal(got), "Should parse the signing key")
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	t.Run("Without signing key", func(t *testing.T) {
+		got, err := New(logger, nil, Config{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, got.signKey)
+	})
+
+	t.Run("Invalid signing key", func(t *testing.T) {
+		_, err := New(logger, nil, Config{KeySecret: "not base64!"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAssets_Sign(t *testing.T) {
+	secret, key := signingSecret(t)
+	signKey, _ := parseSigningKey(secret)
+
+	t.Run("Signed with the key", func(t *testing.T) {
+		a := &assets{keyID: "key", signKey: signKey, policy: SigningPolicy{}.withDefaults(), tokens: newTokenCache()}
+
+		token, err := a.signURL("playback", "v", time.Hour, nil)
+
+		assert.NoError(t, err)
+
+		parsed, err := jwt.Parse(token, func(*jwt.Token) (any, error) { return &key.PublicKey, nil })
+		assert.NoError(t, err)
+		assert.True(t, parsed.Valid)
+	})
+
+	t.Run("Signing disabled", func(t *testing.T) {
+		a := &assets{policy: SigningPolicy{}.withDefaults(), tokens: newTokenCache()}
+
+		_, err := a.signURL("playback", "v", time.Hour, nil)
+
+		assert.Equal(t, errSigningDisabled, err)
+	})
+}
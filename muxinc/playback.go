@@ -0,0 +1,78 @@
+package muxinc
+
+import (
+	"context"
+	"time"
+
+	muxgo "github.com/muxinc/mux-go/v5"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// reservedClaims are set by the signer, a viewer token can't replace them
+var reservedClaims = map[string]bool{
+	"sub":                     true,
+	"aud":                     true,
+	"exp":                     true,
+	"kid":                     true,
+	"iat":                     true,
+	"nbf":                     true,
+	"playback_restriction_id": true,
+	"viewer_id":               true,
+	"time":                    true,
+	"width":                   true,
+	"height":                  true,
+	"smart_crop":              true,
+}
+
+// Token signs fresh playback, thumbnail and storyboard tokens for a viewer.
+// They are bound to the request, so they are not cached.
+func (a *assets) Token(ctx context.Context, asset model.Asset, request model.TokenRequest) (model.PlaybackToken, error) {
+	if len(asset.PlaybackIDs) == 0 || muxgo.PlaybackPolicy(asset.PlaybackIDs[0].Policy) != muxgo.SIGNED {
+		return model.PlaybackToken{}, errorcodes.ErrPlaybackNotSigned
+	}
+
+	for name := range request.Claims {
+		if reservedClaims[name] {
+			return model.PlaybackToken{}, errorcodes.ErrInvalidTokenRequest
+		}
+	}
+
+	playbackID := asset.PlaybackIDs[0].ID
+	ttl := a.policy.ttl(asset.Duration, time.Duration(request.TTL)*time.Second)
+	expiresAt := time.Now().Add(ttl)
+
+	response := model.PlaybackToken{
+		PlaybackID: playbackID,
+		ExpiresAt:  expiresAt.UTC().Format(time.RFC3339),
+	}
+
+	tokens := []struct {
+		audience string
+		token    *string
+	}{
+		{"v", &response.Playback},
+		{"t", &response.Thumbnail},
+		{"s", &response.Storyboard},
+	}
+	for _, t := range tokens {
+		claims := a.claims(playbackID, t.audience, expiresAt)
+		for name, value := range request.Claims {
+			claims[name] = value
+		}
+		if len(request.ViewerID) > 0 {
+			claims["viewer_id"] = request.ViewerID
+		}
+
+		token, err := a.sign(claims)
+		if err != nil {
+			a.logger.WithError(err).Error("error signing viewer token")
+
+			return model.PlaybackToken{}, err
+		}
+		*t.token = token
+	}
+
+	return response, nil
+}
@@ -0,0 +1,69 @@
+package muxinc
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultMinTTL = 15 * time.Minute // shortest token lifetime, covers assets whose duration is unknown and paused viewers
+	defaultMaxTTL = 24 * time.Hour   // longest token lifetime
+	ttlFactor     = 1.6              // lifetime of a token relative to the duration of its asset
+)
+
+// SigningPolicy bounds the lifetime of the signed tokens. A token lives 1.6
+// times the duration of its asset, or FixedTTL when set, within MinTTL and
+// MaxTTL. A TTL requested by a caller replaces the computed one, except in
+// fixed mode, and is bounded the same way.
+type SigningPolicy struct {
+	MinTTL                time.Duration
+	MaxTTL                time.Duration
+	FixedTTL              time.Duration
+	PlaybackRestrictionID string
+}
+
+// withDefaults fills the bounds left empty
+func (p SigningPolicy) withDefaults() SigningPolicy {
+	if p.MinTTL == 0 {
+		p.MinTTL = defaultMinTTL
+	}
+	if p.MaxTTL == 0 {
+		p.MaxTTL = defaultMaxTTL
+	}
+
+	return p
+}
+
+// validate rejects bounds that no token can meet
+func (p SigningPolicy) validate() error {
+	if p.MinTTL < 0 || p.MaxTTL < 0 || p.FixedTTL < 0 {
+		return fmt.Errorf("signing policy TTLs must not be negative")
+	}
+	if p.MinTTL > p.MaxTTL {
+		return fmt.Errorf("signing policy minimum TTL %s is above the maximum %s", p.MinTTL, p.MaxTTL)
+	}
+	if p.FixedTTL > 0 && (p.FixedTTL < p.MinTTL || p.FixedTTL > p.MaxTTL) {
+		return fmt.Errorf("signing policy fixed TTL %s is outside %s and %s", p.FixedTTL, p.MinTTL, p.MaxTTL)
+	}
+
+	return nil
+}
+
+// ttl returns the lifetime of a token for an asset lasting duration seconds.
+// A zero requested TTL keeps the one of the policy.
+func (p SigningPolicy) ttl(duration float64, requested time.Duration) time.Duration {
+	var ttl time.Duration
+	switch {
+	case p.FixedTTL > 0:
+		ttl = p.FixedTTL
+	case requested > 0:
+		ttl = requested
+	default:
+		ttl = time.Duration(duration * ttlFactor * float64(time.Second))
+	}
+
+	// Mux.com expects the expiry in whole seconds
+	ttl = ttl.Truncate(time.Second)
+
+	return min(max(ttl, p.MinTTL), p.MaxTTL)
+}
@@ -0,0 +1,71 @@
+package muxinc
+
+import (
+	"sync"
+	"time"
+)
+
+// maxTokens is the number of signed tokens kept before the expired ones are dropped
+const maxTokens = 10000
+
+// tokenKey identifies the claims of a signed token
+type tokenKey struct {
+	playbackID string
+	audience   string
+	ttl        time.Duration
+	params     string
+}
+
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// tokenCache keeps the signed tokens in memory, signing is the costly part of
+// building the URLs of a signed video
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[tokenKey]cachedToken
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{
+		tokens: make(map[tokenKey]cachedToken),
+	}
+}
+
+// get returns the token stored for the key if it is still valid for the
+// given duration
+func (c *tokenCache) get(key tokenKey, validity time.Duration) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.tokens[key]
+	if !ok || time.Until(cached.expiresAt) <= validity {
+		return "", false
+	}
+
+	return cached.token, true
+}
+
+// set stores a token until it expires
+func (c *tokenCache) set(key tokenKey, token string, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.tokens) >= maxTokens {
+		now := time.Now()
+		for k, cached := range c.tokens {
+			if !cached.expiresAt.After(now) {
+				delete(c.tokens, k)
+			}
+		}
+
+		// Every token is still valid, start over rather than grow without bound
+		if len(c.tokens) >= maxTokens {
+			clear(c.tokens)
+		}
+	}
+
+	c.tokens[key] = cachedToken{token: token, expiresAt: expiresAt}
+}
@@ -0,0 +1,91 @@
+package muxinc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenCache_Get(t *testing.T) {
+	key := tokenKey{playbackID: "playback", audience: "v", ttl: time.Hour}
+
+	tests := []struct {
+		name      string
+		expiresIn time.Duration
+		validity  time.Duration
+		want      bool
+	}{
+		{"Reused while valid", time.Hour, 30 * time.Minute, true},
+		{"Signed again near expiry", 20 * time.Minute, 30 * time.Minute, false},
+		{"Signed again once expired", -time.Minute, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newTokenCache()
+			cache.set(key, "token", time.Now().Add(tt.expiresIn))
+
+			token, ok := cache.get(key, tt.validity)
+
+			assert.Equal(t, tt.want, ok)
+			if tt.want {
+				assert.Equal(t, "token", token)
+			} else {
+				assert.Empty(t, token)
+			}
+		})
+	}
+
+	t.Run("Other claims are not reused", func(t *testing.T) {
+		cache := newTokenCache()
+		cache.set(key, "token", time.Now().Add(time.Hour))
+
+		_, ok := cache.get(tokenKey{playbackID: "playback", audience: "t", ttl: time.Hour}, 0)
+
+		assert.False(t, ok)
+	})
+}
+
+func TestTokenCache_Set(t *testing.T) {
+	fill := func(cache *tokenCache, expiresAt time.Time) {
+		for i := range maxTokens {
+			cache.set(tokenKey{playbackID: fmt.Sprint(i)}, "token", expiresAt)
+		}
+	}
+	key := tokenKey{playbackID: "new"}
+
+	t.Run("Expired tokens are dropped when full", func(t *testing.T) {
+		cache := newTokenCache()
+		fill(cache, time.Now().Add(-time.Minute))
+		valid := tokenKey{playbackID: "valid"}
+		delete(cache.tokens, tokenKey{playbackID: "0"})
+		cache.tokens[valid] = cachedToken{token: "token", expiresAt: time.Now().Add(time.Hour)}
+
+		cache.set(key, "token", time.Now().Add(time.Hour))
+
+		assert.Len(t, cache.tokens, 2)
+		assert.Contains(t, cache.tokens, valid)
+		assert.Contains(t, cache.tokens, key)
+	})
+
+	t.Run("Cleared when every token is valid", func(t *testing.T) {
+		cache := newTokenCache()
+		fill(cache, time.Now().Add(time.Hour))
+
+		cache.set(key, "token", time.Now().Add(time.Hour))
+
+		assert.Len(t, cache.tokens, 1)
+		assert.Contains(t, cache.tokens, key)
+	})
+
+	t.Run("Below the limit nothing is dropped", func(t *testing.T) {
+		cache := newTokenCache()
+		cache.set(tokenKey{playbackID: "expired"}, "token", time.Now().Add(-time.Minute))
+
+		cache.set(key, "token", time.Now().Add(time.Hour))
+
+		assert.Len(t, cache.tokens, 2)
+	})
+}
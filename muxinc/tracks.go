@@ -0,0 +1,77 @@
+package muxinc
+
+import (
+	"context"
+	"errors"
+
+	muxgo "github.com/muxinc/mux-go/v5"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// CreateTrack adds a subtitles or an audio track to an asset, Mux.com
+// downloads the WebVTT or SRT file, or the audio file, from the URL. The
+// track is ready once the video.asset.track.ready webhook arrives.
+func (a *assets) CreateTrack(ctx context.Context, assetID, url string, track model.Track) (model.Track, error) {
+	request := muxgo.CreateTrackRequest{
+		Url:          url,
+		Type:         model.TrackText,
+		TextType:     "subtitles",
+		LanguageCode: track.LanguageCode,
+		Name:         track.Name,
+		Passthrough:  track.Passthrough,
+	}
+	if track.Type == model.TrackAudio {
+		request.Type = model.TrackAudio
+		request.TextType = ""
+	} else {
+		request.ClosedCaptions = track.ClosedCaptions
+	}
+
+	response, err := a.mux.AssetsApi.CreateAssetTrack(assetID, request)
+	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return model.Track{}, errorcodes.ErrAssetNotFound
+		}
+
+		a.logger.WithError(err).Error("error creating track")
+
+		return model.Track{}, err
+	}
+
+	return toTrack(response.Data), nil
+}
+
+// DeleteTrack removes a track from an asset
+func (a *assets) DeleteTrack(ctx context.Context, assetID, trackID string) error {
+	err := a.mux.AssetsApi.DeleteAssetTrack(assetID, trackID)
+	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return errorcodes.ErrTrackNotFound
+		}
+
+		a.logger.WithError(err).Error("error deleting track")
+
+		return err
+	}
+
+	return nil
+}
+
+func toTrack(track muxgo.Track) model.Track {
+	return model.Track{
+		ID:             track.Id,
+		Type:           track.Type,
+		TextType:       track.TextType,
+		TextSource:     track.TextSource,
+		Status:         track.Status,
+		Name:           track.Name,
+		LanguageCode:   track.LanguageCode,
+		ClosedCaptions: track.ClosedCaptions,
+		Primary:        track.Primary,
+		Passthrough:    track.Passthrough,
+	}
+}
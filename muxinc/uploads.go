@@ -0,0 +1,56 @@
+package muxinc
+
+import (
+	"context"
+	"errors"
+
+	muxgo "github.com/muxinc/mux-go/v5"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// CreateDirectUpload asks Mux.com for a signed URL the browser sends the source file to.
+// The settings apply to the asset created from the upload.
+func (a *assets) CreateDirectUpload(ctx context.Context, settings model.AssetSettings, corsOrigin string) (model.Upload, error) {
+	response, err := a.mux.DirectUploadsApi.CreateDirectUpload(muxgo.CreateUploadRequest{
+		CorsOrigin:       corsOrigin,
+		NewAssetSettings: a.assetRequest("", settings),
+		Test:             a.test,
+	})
+	if err != nil {
+		a.logger.WithError(err).Error("error creating direct upload")
+
+		return model.Upload{}, err
+	}
+
+	return toUpload(response.Data), nil
+}
+
+// GetDirectUpload retrieves a direct upload from Mux.com
+func (a *assets) GetDirectUpload(ctx context.Context, id string) (model.Upload, error) {
+	response, err := a.mux.DirectUploadsApi.GetDirectUpload(id)
+	if err != nil {
+		var notFound muxgo.NotFoundError
+		if errors.As(err, &notFound) {
+			return model.Upload{}, errorcodes.ErrUploadNotFound
+		}
+
+		a.logger.WithError(err).Error("error retrieving direct upload")
+
+		return model.Upload{}, err
+	}
+
+	return toUpload(response.Data), nil
+}
+
+func toUpload(data muxgo.Upload) model.Upload {
+	return model.Upload{
+		ID:         data.Id,
+		VideoID:    data.NewAssetSettings.Passthrough,
+		URL:        data.Url,
+		Status:     data.Status,
+		AssetID:    data.AssetId,
+		CorsOrigin: data.CorsOrigin,
+	}
+}
@@ -0,0 +1,137 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/javiertlopez/idlemux/controller"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	idempotencyHeader = "Idempotency-Key"
+	maxIdempotencyKey = 255         // longest key accepted
+	idempotencyLease  = time.Minute // time a request owns its key before another replica can take it over
+)
+
+// IdempotencyKeys stores the responses sent for an Idempotency-Key
+type IdempotencyKeys interface {
+	ReserveKey(ctx context.Context, key, requestHash string, lease time.Duration) (model.IdempotencyKey, bool, error)
+	CompleteKey(ctx context.Context, key string, response model.IdempotencyKey) error
+	ReleaseKey(ctx context.Context, key string) error
+}
+
+// recorder keeps a copy of the response written by the handler
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// idempotent replays the stored response of a request sent again with the same
+// Idempotency-Key. A key sent with a different request is rejected with 422,
+// and one whose request is still running with 409. Requests without the
+// header are not affected.
+func idempotent(keys IdempotencyKeys) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(idempotencyHeader)
+			if len(key) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > maxIdempotencyKey {
+				errorResponse(w, http.StatusBadRequest, "Bad request")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				errorResponse(w, http.StatusBadRequest, "Bad request")
+				return
+			}
+			r.Body.Close()
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := requestHash(r, body)
+
+			stored, reserved, err := keys.ReserveKey(r.Context(), key, hash, idempotencyLease)
+			if err != nil {
+				errorResponse(w, http.StatusInternalServerError, "Internal server error")
+				return
+			}
+
+			if !reserved {
+				switch {
+				case stored.RequestHash != hash:
+					errorResponse(w, http.StatusUnprocessableEntity, "Unprocessable entity")
+				case stored.Status == 0:
+					errorResponse(w, http.StatusConflict, "Conflict")
+				default:
+					w.Header().Set("Idempotent-Replayed", "true")
+					controller.JSONResponse(w, stored.Status, json.RawMessage(stored.Body))
+				}
+				return
+			}
+
+			rec := &recorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+
+			// The response is already sent, a client that went away must not
+			// leave the key locked. Store errors are logged by the store.
+			ctx := context.WithoutCancel(r.Context())
+
+			// Server errors are not kept, the request can be sent again
+			if rec.status == 0 || rec.status >= http.StatusInternalServerError {
+				keys.ReleaseKey(ctx, key)
+				return
+			}
+
+			keys.CompleteKey(ctx, key, model.IdempotencyKey{
+				Key:         key,
+				RequestHash: hash,
+				Status:      rec.status,
+				Body:        rec.body.Bytes(),
+			})
+		})
+	}
+}
+
+// requestHash identifies a request by its method, path and body
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// errorResponse writes the JSON error body used by the controller
+func errorResponse(w http.ResponseWriter, code int, message string) {
+	controller.JSONResponse(w, code, controller.Response{
+		Message: message,
+		Status:  code,
+	})
+}
@@ -0,0 +1,171 @@
+package router
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+func TestIdempotent(t *testing.T) {
+	const (
+		key  = "9f2c1e4b-retry"
+		body = `{"title":"Some Might Say"}`
+	)
+	created := `{"id":"4e5bf8f2-9c50-4576-b9d4-1d1fd0705885","title":"Some Might Say"}`
+	hash := requestHash(httptest.NewRequest("POST", "/videos", nil), []byte(body))
+
+	tests := []struct {
+		name         string
+		key          string
+		body         string
+		handlerCode  int
+		mocks        func(keys *MockIdempotencyKeys)
+		wantCalls    int
+		expectedCode int
+		expectedBody string
+		replayed     bool
+	}{
+		{
+			name:         "Without key",
+			body:         body,
+			handlerCode:  http.StatusCreated,
+			wantCalls:    1,
+			expectedCode: http.StatusCreated,
+			expectedBody: created,
+		},
+		{
+			name:        "First request stores the response",
+			key:         key,
+			body:        body,
+			handlerCode: http.StatusCreated,
+			mocks: func(keys *MockIdempotencyKeys) {
+				keys.On("ReserveKey", mock.Anything, key, hash, idempotencyLease).Return(model.IdempotencyKey{Key: key, RequestHash: hash}, true, nil)
+				keys.On("CompleteKey", mock.Anything, key, model.IdempotencyKey{
+					Key:         key,
+					RequestHash: hash,
+					Status:      http.StatusCreated,
+					Body:        []byte(created),
+				}).Return(nil)
+			},
+			wantCalls:    1,
+			expectedCode: http.StatusCreated,
+			expectedBody: created,
+		},
+		{
+			name: "Replay returns the stored response",
+			key:  key,
+			body: body,
+			mocks: func(keys *MockIdempotencyKeys) {
+				keys.On("ReserveKey", mock.Anything, key, hash, idempotencyLease).Return(model.IdempotencyKey{
+					Key:         key,
+					RequestHash: hash,
+					Status:      http.StatusCreated,
+					Body:        []byte(created),
+				}, false, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: created,
+			replayed:     true,
+		},
+		{
+			name: "Different body",
+			key:  key,
+			body: `{"title":"Wonderwall"}`,
+			mocks: func(keys *MockIdempotencyKeys) {
+				keys.On("ReserveKey", mock.Anything, key, mock.Anything, idempotencyLease).Return(model.IdempotencyKey{
+					Key:         key,
+					RequestHash: hash,
+					Status:      http.StatusCreated,
+					Body:        []byte(created),
+				}, false, nil)
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedBody: `{"message":"Unprocessable entity","status":422}`,
+		},
+		{
+			name: "Request still running",
+			key:  key,
+			body: body,
+			mocks: func(keys *MockIdempotencyKeys) {
+				keys.On("ReserveKey", mock.Anything, key, hash, idempotencyLease).Return(model.IdempotencyKey{Key: key, RequestHash: hash}, false, nil)
+			},
+			expectedCode: http.StatusConflict,
+			expectedBody: `{"message":"Conflict","status":409}`,
+		},
+		{
+			name:        "Server error releases the key",
+			key:         key,
+			body:        body,
+			handlerCode: http.StatusInternalServerError,
+			mocks: func(keys *MockIdempotencyKeys) {
+				keys.On("ReserveKey", mock.Anything, key, hash, idempotencyLease).Return(model.IdempotencyKey{Key: key, RequestHash: hash}, true, nil)
+				keys.On("ReleaseKey", mock.Anything, key).Return(nil)
+			},
+			wantCalls:    1,
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: created,
+		},
+		{
+			name: "Store error",
+			key:  key,
+			body: body,
+			mocks: func(keys *MockIdempotencyKeys) {
+				keys.On("ReserveKey", mock.Anything, key, hash, idempotencyLease).Return(model.IdempotencyKey{}, false, errors.New("db error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"message":"Internal server error","status":500}`,
+		},
+		{
+			name:         "Key too long",
+			key:          strings.Repeat("k", maxIdempotencyKey+1),
+			body:         body,
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"message":"Bad request","status":400}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := NewMockIdempotencyKeys(t)
+			if tt.mocks != nil {
+				tt.mocks(keys)
+			}
+
+			calls := 0
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				// The handler still reads the whole body
+				received, _ := io.ReadAll(r.Body)
+				assert.Equal(t, tt.body, string(received))
+
+				w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+				w.WriteHeader(tt.handlerCode)
+				w.Write([]byte(created))
+			})
+
+			r := httptest.NewRequest("POST", "/videos", bytes.NewBufferString(tt.body))
+			if len(tt.key) > 0 {
+				r.Header.Set(idempotencyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+
+			idempotent(keys)(handler).ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantCalls, calls, "Handler calls don't match")
+			assert.Equal(t, tt.expectedCode, w.Code, "Should return expected status code")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match expected")
+			if tt.replayed {
+				assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+			}
+		})
+	}
+}
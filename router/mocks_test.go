@@ -5,8 +5,11 @@
 package router
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	"github.com/javiertlopez/idlemux/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,25 +40,1359 @@ func (_m *MockController) EXPECT() *MockController_Expecter {
 	return &MockController_Expecter{mock: &_m.Mock}
 }
 
+// AddPlaylistVideo provides a mock function for the type MockController
+func (_mock *MockController) AddPlaylistVideo(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_AddPlaylistVideo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPlaylistVideo'
+type MockController_AddPlaylistVideo_Call struct {
+	*mock.Call
+}
+
+// AddPlaylistVideo is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) AddPlaylistVideo(w interface{}, r interface{}) *MockController_AddPlaylistVideo_Call {
+	return &MockController_AddPlaylistVideo_Call{Call: _e.mock.On("AddPlaylistVideo", w, r)}
+}
+
+func (_c *MockController_AddPlaylistVideo_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_AddPlaylistVideo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_AddPlaylistVideo_Call) Return() *MockController_AddPlaylistVideo_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_AddPlaylistVideo_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_AddPlaylistVideo_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Caption provides a mock function for the type MockController
+func (_mock *MockController) Caption(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Caption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Caption'
+type MockController_Caption_Call struct {
+	*mock.Call
+}
+
+// Caption is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Caption(w interface{}, r interface{}) *MockController_Caption_Call {
+	return &MockController_Caption_Call{Call: _e.mock.On("Caption", w, r)}
+}
+
+func (_c *MockController_Caption_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Caption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Caption_Call) Return() *MockController_Caption_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Caption_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Caption_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Chapters provides a mock function for the type MockController
+func (_mock *MockController) Chapters(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Chapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Chapters'
+type MockController_Chapters_Call struct {
+	*mock.Call
+}
+
+// Chapters is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Chapters(w interface{}, r interface{}) *MockController_Chapters_Call {
+	return &MockController_Chapters_Call{Call: _e.mock.On("Chapters", w, r)}
+}
+
+func (_c *MockController_Chapters_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Chapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Chapters_Call) Return() *MockController_Chapters_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Chapters_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Chapters_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockController
 func (_mock *MockController) Create(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
 	return
 }
 
-// MockController_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockController_Create_Call struct {
+// MockController_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockController_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Create(w interface{}, r interface{}) *MockController_Create_Call {
+	return &MockController_Create_Call{Call: _e.mock.On("Create", w, r)}
+}
+
+func (_c *MockController_Create_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Create_Call) Return() *MockController_Create_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Create_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Create_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateClip provides a mock function for the type MockController
+func (_mock *MockController) CreateClip(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_CreateClip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClip'
+type MockController_CreateClip_Call struct {
+	*mock.Call
+}
+
+// CreateClip is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) CreateClip(w interface{}, r interface{}) *MockController_CreateClip_Call {
+	return &MockController_CreateClip_Call{Call: _e.mock.On("CreateClip", w, r)}
+}
+
+func (_c *MockController_CreateClip_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateClip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_CreateClip_Call) Return() *MockController_CreateClip_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_CreateClip_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateClip_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateLiveStream provides a mock function for the type MockController
+func (_mock *MockController) CreateLiveStream(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_CreateLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLiveStream'
+type MockController_CreateLiveStream_Call struct {
+	*mock.Call
+}
+
+// CreateLiveStream is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) CreateLiveStream(w interface{}, r interface{}) *MockController_CreateLiveStream_Call {
+	return &MockController_CreateLiveStream_Call{Call: _e.mock.On("CreateLiveStream", w, r)}
+}
+
+func (_c *MockController_CreateLiveStream_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_CreateLiveStream_Call) Return() *MockController_CreateLiveStream_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_CreateLiveStream_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateLiveStream_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreatePlaylist provides a mock function for the type MockController
+func (_mock *MockController) CreatePlaylist(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_CreatePlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePlaylist'
+type MockController_CreatePlaylist_Call struct {
+	*mock.Call
+}
+
+// CreatePlaylist is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) CreatePlaylist(w interface{}, r interface{}) *MockController_CreatePlaylist_Call {
+	return &MockController_CreatePlaylist_Call{Call: _e.mock.On("CreatePlaylist", w, r)}
+}
+
+func (_c *MockController_CreatePlaylist_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreatePlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_CreatePlaylist_Call) Return() *MockController_CreatePlaylist_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_CreatePlaylist_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreatePlaylist_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateTrack provides a mock function for the type MockController
+func (_mock *MockController) CreateTrack(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_CreateTrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrack'
+type MockController_CreateTrack_Call struct {
+	*mock.Call
+}
+
+// CreateTrack is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) CreateTrack(w interface{}, r interface{}) *MockController_CreateTrack_Call {
+	return &MockController_CreateTrack_Call{Call: _e.mock.On("CreateTrack", w, r)}
+}
+
+func (_c *MockController_CreateTrack_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateTrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_CreateTrack_Call) Return() *MockController_CreateTrack_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_CreateTrack_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateTrack_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateUpload provides a mock function for the type MockController
+func (_mock *MockController) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_CreateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUpload'
+type MockController_CreateUpload_Call struct {
+	*mock.Call
+}
+
+// CreateUpload is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) CreateUpload(w interface{}, r interface{}) *MockController_CreateUpload_Call {
+	return &MockController_CreateUpload_Call{Call: _e.mock.On("CreateUpload", w, r)}
+}
+
+func (_c *MockController_CreateUpload_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_CreateUpload_Call) Return() *MockController_CreateUpload_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_CreateUpload_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_CreateUpload_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockController
+func (_mock *MockController) Delete(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockController_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Delete(w interface{}, r interface{}) *MockController_Delete_Call {
+	return &MockController_Delete_Call{Call: _e.mock.On("Delete", w, r)}
+}
+
+func (_c *MockController_Delete_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Delete_Call) Return() *MockController_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Delete_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteLiveStream provides a mock function for the type MockController
+func (_mock *MockController) DeleteLiveStream(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_DeleteLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLiveStream'
+type MockController_DeleteLiveStream_Call struct {
+	*mock.Call
+}
+
+// DeleteLiveStream is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) DeleteLiveStream(w interface{}, r interface{}) *MockController_DeleteLiveStream_Call {
+	return &MockController_DeleteLiveStream_Call{Call: _e.mock.On("DeleteLiveStream", w, r)}
+}
+
+func (_c *MockController_DeleteLiveStream_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_DeleteLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_DeleteLiveStream_Call) Return() *MockController_DeleteLiveStream_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_DeleteLiveStream_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_DeleteLiveStream_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeletePlaylist provides a mock function for the type MockController
+func (_mock *MockController) DeletePlaylist(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_DeletePlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePlaylist'
+type MockController_DeletePlaylist_Call struct {
+	*mock.Call
+}
+
+// DeletePlaylist is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) DeletePlaylist(w interface{}, r interface{}) *MockController_DeletePlaylist_Call {
+	return &MockController_DeletePlaylist_Call{Call: _e.mock.On("DeletePlaylist", w, r)}
+}
+
+func (_c *MockController_DeletePlaylist_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_DeletePlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_DeletePlaylist_Call) Return() *MockController_DeletePlaylist_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_DeletePlaylist_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_DeletePlaylist_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteTrack provides a mock function for the type MockController
+func (_mock *MockController) DeleteTrack(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_DeleteTrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrack'
+type MockController_DeleteTrack_Call struct {
+	*mock.Call
+}
+
+// DeleteTrack is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) DeleteTrack(w interface{}, r interface{}) *MockController_DeleteTrack_Call {
+	return &MockController_DeleteTrack_Call{Call: _e.mock.On("DeleteTrack", w, r)}
+}
+
+func (_c *MockController_DeleteTrack_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_DeleteTrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_DeleteTrack_Call) Return() *MockController_DeleteTrack_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_DeleteTrack_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_DeleteTrack_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DisableLiveStream provides a mock function for the type MockController
+func (_mock *MockController) DisableLiveStream(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_DisableLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableLiveStream'
+type MockController_DisableLiveStream_Call struct {
+	*mock.Call
+}
+
+// DisableLiveStream is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) DisableLiveStream(w interface{}, r interface{}) *MockController_DisableLiveStream_Call {
+	return &MockController_DisableLiveStream_Call{Call: _e.mock.On("DisableLiveStream", w, r)}
+}
+
+func (_c *MockController_DisableLiveStream_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_DisableLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_DisableLiveStream_Call) Return() *MockController_DisableLiveStream_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_DisableLiveStream_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_DisableLiveStream_Call {
+	_c.Run(run)
+	return _c
+}
+
+// EnableDownload provides a mock function for the type MockController
+func (_mock *MockController) EnableDownload(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_EnableDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableDownload'
+type MockController_EnableDownload_Call struct {
+	*mock.Call
+}
+
+// EnableDownload is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) EnableDownload(w interface{}, r interface{}) *MockController_EnableDownload_Call {
+	return &MockController_EnableDownload_Call{Call: _e.mock.On("EnableDownload", w, r)}
+}
+
+func (_c *MockController_EnableDownload_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_EnableDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_EnableDownload_Call) Return() *MockController_EnableDownload_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_EnableDownload_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_EnableDownload_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockController
+func (_mock *MockController) GetByID(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockController_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) GetByID(w interface{}, r interface{}) *MockController_GetByID_Call {
+	return &MockController_GetByID_Call{Call: _e.mock.On("GetByID", w, r)}
+}
+
+func (_c *MockController_GetByID_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_GetByID_Call) Return() *MockController_GetByID_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_GetByID_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetByID_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetLiveStream provides a mock function for the type MockController
+func (_mock *MockController) GetLiveStream(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_GetLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLiveStream'
+type MockController_GetLiveStream_Call struct {
+	*mock.Call
+}
+
+// GetLiveStream is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) GetLiveStream(w interface{}, r interface{}) *MockController_GetLiveStream_Call {
+	return &MockController_GetLiveStream_Call{Call: _e.mock.On("GetLiveStream", w, r)}
+}
+
+func (_c *MockController_GetLiveStream_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_GetLiveStream_Call) Return() *MockController_GetLiveStream_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_GetLiveStream_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetLiveStream_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetPlaylist provides a mock function for the type MockController
+func (_mock *MockController) GetPlaylist(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_GetPlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlaylist'
+type MockController_GetPlaylist_Call struct {
+	*mock.Call
+}
+
+// GetPlaylist is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) GetPlaylist(w interface{}, r interface{}) *MockController_GetPlaylist_Call {
+	return &MockController_GetPlaylist_Call{Call: _e.mock.On("GetPlaylist", w, r)}
+}
+
+func (_c *MockController_GetPlaylist_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetPlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_GetPlaylist_Call) Return() *MockController_GetPlaylist_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_GetPlaylist_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetPlaylist_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetUpload provides a mock function for the type MockController
+func (_mock *MockController) GetUpload(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_GetUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpload'
+type MockController_GetUpload_Call struct {
+	*mock.Call
+}
+
+// GetUpload is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) GetUpload(w interface{}, r interface{}) *MockController_GetUpload_Call {
+	return &MockController_GetUpload_Call{Call: _e.mock.On("GetUpload", w, r)}
+}
+
+func (_c *MockController_GetUpload_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_GetUpload_Call) Return() *MockController_GetUpload_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_GetUpload_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetUpload_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Healthz provides a mock function for the type MockController
+func (_mock *MockController) Healthz(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Healthz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Healthz'
+type MockController_Healthz_Call struct {
+	*mock.Call
+}
+
+// Healthz is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Healthz(w interface{}, r interface{}) *MockController_Healthz_Call {
+	return &MockController_Healthz_Call{Call: _e.mock.On("Healthz", w, r)}
+}
+
+func (_c *MockController_Healthz_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Healthz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Healthz_Call) Return() *MockController_Healthz_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Healthz_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Healthz_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Images provides a mock function for the type MockController
+func (_mock *MockController) Images(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Images_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Images'
+type MockController_Images_Call struct {
+	*mock.Call
+}
+
+// Images is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Images(w interface{}, r interface{}) *MockController_Images_Call {
+	return &MockController_Images_Call{Call: _e.mock.On("Images", w, r)}
+}
+
+func (_c *MockController_Images_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Images_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Images_Call) Return() *MockController_Images_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Images_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Images_Call {
+	_c.Run(run)
+	return _c
+}
+
+// List provides a mock function for the type MockController
+func (_mock *MockController) List(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockController_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) List(w interface{}, r interface{}) *MockController_List_Call {
+	return &MockController_List_Call{Call: _e.mock.On("List", w, r)}
+}
+
+func (_c *MockController_List_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_List_Call) Return() *MockController_List_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_List_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_List_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListClips provides a mock function for the type MockController
+func (_mock *MockController) ListClips(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_ListClips_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListClips'
+type MockController_ListClips_Call struct {
+	*mock.Call
+}
+
+// ListClips is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) ListClips(w interface{}, r interface{}) *MockController_ListClips_Call {
+	return &MockController_ListClips_Call{Call: _e.mock.On("ListClips", w, r)}
+}
+
+func (_c *MockController_ListClips_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListClips_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_ListClips_Call) Return() *MockController_ListClips_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_ListClips_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListClips_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListLiveStreams provides a mock function for the type MockController
+func (_mock *MockController) ListLiveStreams(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_ListLiveStreams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLiveStreams'
+type MockController_ListLiveStreams_Call struct {
+	*mock.Call
+}
+
+// ListLiveStreams is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) ListLiveStreams(w interface{}, r interface{}) *MockController_ListLiveStreams_Call {
+	return &MockController_ListLiveStreams_Call{Call: _e.mock.On("ListLiveStreams", w, r)}
+}
+
+func (_c *MockController_ListLiveStreams_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListLiveStreams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_ListLiveStreams_Call) Return() *MockController_ListLiveStreams_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_ListLiveStreams_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListLiveStreams_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListPlaylists provides a mock function for the type MockController
+func (_mock *MockController) ListPlaylists(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_ListPlaylists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPlaylists'
+type MockController_ListPlaylists_Call struct {
+	*mock.Call
+}
+
+// ListPlaylists is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) ListPlaylists(w interface{}, r interface{}) *MockController_ListPlaylists_Call {
+	return &MockController_ListPlaylists_Call{Call: _e.mock.On("ListPlaylists", w, r)}
+}
+
+func (_c *MockController_ListPlaylists_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListPlaylists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_ListPlaylists_Call) Return() *MockController_ListPlaylists_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_ListPlaylists_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListPlaylists_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListTracks provides a mock function for the type MockController
+func (_mock *MockController) ListTracks(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_ListTracks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTracks'
+type MockController_ListTracks_Call struct {
+	*mock.Call
+}
+
+// ListTracks is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) ListTracks(w interface{}, r interface{}) *MockController_ListTracks_Call {
+	return &MockController_ListTracks_Call{Call: _e.mock.On("ListTracks", w, r)}
+}
+
+func (_c *MockController_ListTracks_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListTracks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_ListTracks_Call) Return() *MockController_ListTracks_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_ListTracks_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListTracks_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListTrash provides a mock function for the type MockController
+func (_mock *MockController) ListTrash(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type MockController_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) ListTrash(w interface{}, r interface{}) *MockController_ListTrash_Call {
+	return &MockController_ListTrash_Call{Call: _e.mock.On("ListTrash", w, r)}
+}
+
+func (_c *MockController_ListTrash_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_ListTrash_Call) Return() *MockController_ListTrash_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_ListTrash_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_ListTrash_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Patch provides a mock function for the type MockController
+func (_mock *MockController) Patch(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockController_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Patch(w interface{}, r interface{}) *MockController_Patch_Call {
+	return &MockController_Patch_Call{Call: _e.mock.On("Patch", w, r)}
+}
+
+func (_c *MockController_Patch_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Patch_Call) Return() *MockController_Patch_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Patch_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Patch_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PlaybackToken provides a mock function for the type MockController
+func (_mock *MockController) PlaybackToken(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_PlaybackToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaybackToken'
+type MockController_PlaybackToken_Call struct {
+	*mock.Call
+}
+
+// PlaybackToken is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) PlaybackToken(w interface{}, r interface{}) *MockController_PlaybackToken_Call {
+	return &MockController_PlaybackToken_Call{Call: _e.mock.On("PlaybackToken", w, r)}
+}
+
+func (_c *MockController_PlaybackToken_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_PlaybackToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_PlaybackToken_Call) Return() *MockController_PlaybackToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_PlaybackToken_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_PlaybackToken_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RemovePlaylistVideo provides a mock function for the type MockController
+func (_mock *MockController) RemovePlaylistVideo(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_RemovePlaylistVideo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePlaylistVideo'
+type MockController_RemovePlaylistVideo_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// RemovePlaylistVideo is a helper method to define mock.On call
 //   - w http.ResponseWriter
 //   - r *http.Request
-func (_e *MockController_Expecter) Create(w interface{}, r interface{}) *MockController_Create_Call {
-	return &MockController_Create_Call{Call: _e.mock.On("Create", w, r)}
+func (_e *MockController_Expecter) RemovePlaylistVideo(w interface{}, r interface{}) *MockController_RemovePlaylistVideo_Call {
+	return &MockController_RemovePlaylistVideo_Call{Call: _e.mock.On("RemovePlaylistVideo", w, r)}
 }
 
-func (_c *MockController_Create_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Create_Call {
+func (_c *MockController_RemovePlaylistVideo_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_RemovePlaylistVideo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 http.ResponseWriter
 		if args[0] != nil {
@@ -73,35 +1410,35 @@ func (_c *MockController_Create_Call) Run(run func(w http.ResponseWriter, r *htt
 	return _c
 }
 
-func (_c *MockController_Create_Call) Return() *MockController_Create_Call {
+func (_c *MockController_RemovePlaylistVideo_Call) Return() *MockController_RemovePlaylistVideo_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockController_Create_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Create_Call {
+func (_c *MockController_RemovePlaylistVideo_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_RemovePlaylistVideo_Call {
 	_c.Run(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockController
-func (_mock *MockController) GetByID(w http.ResponseWriter, r *http.Request) {
+// Restore provides a mock function for the type MockController
+func (_mock *MockController) Restore(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
 	return
 }
 
-// MockController_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockController_GetByID_Call struct {
+// MockController_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockController_Restore_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - w http.ResponseWriter
 //   - r *http.Request
-func (_e *MockController_Expecter) GetByID(w interface{}, r interface{}) *MockController_GetByID_Call {
-	return &MockController_GetByID_Call{Call: _e.mock.On("GetByID", w, r)}
+func (_e *MockController_Expecter) Restore(w interface{}, r interface{}) *MockController_Restore_Call {
+	return &MockController_Restore_Call{Call: _e.mock.On("Restore", w, r)}
 }
 
-func (_c *MockController_GetByID_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetByID_Call {
+func (_c *MockController_Restore_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 http.ResponseWriter
 		if args[0] != nil {
@@ -119,35 +1456,35 @@ func (_c *MockController_GetByID_Call) Run(run func(w http.ResponseWriter, r *ht
 	return _c
 }
 
-func (_c *MockController_GetByID_Call) Return() *MockController_GetByID_Call {
+func (_c *MockController_Restore_Call) Return() *MockController_Restore_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockController_GetByID_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_GetByID_Call {
+func (_c *MockController_Restore_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Restore_Call {
 	_c.Run(run)
 	return _c
 }
 
-// Healthz provides a mock function for the type MockController
-func (_mock *MockController) Healthz(w http.ResponseWriter, r *http.Request) {
+// RetryIngestion provides a mock function for the type MockController
+func (_mock *MockController) RetryIngestion(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
 	return
 }
 
-// MockController_Healthz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Healthz'
-type MockController_Healthz_Call struct {
+// MockController_RetryIngestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryIngestion'
+type MockController_RetryIngestion_Call struct {
 	*mock.Call
 }
 
-// Healthz is a helper method to define mock.On call
+// RetryIngestion is a helper method to define mock.On call
 //   - w http.ResponseWriter
 //   - r *http.Request
-func (_e *MockController_Expecter) Healthz(w interface{}, r interface{}) *MockController_Healthz_Call {
-	return &MockController_Healthz_Call{Call: _e.mock.On("Healthz", w, r)}
+func (_e *MockController_Expecter) RetryIngestion(w interface{}, r interface{}) *MockController_RetryIngestion_Call {
+	return &MockController_RetryIngestion_Call{Call: _e.mock.On("RetryIngestion", w, r)}
 }
 
-func (_c *MockController_Healthz_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Healthz_Call {
+func (_c *MockController_RetryIngestion_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_RetryIngestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 http.ResponseWriter
 		if args[0] != nil {
@@ -165,35 +1502,35 @@ func (_c *MockController_Healthz_Call) Run(run func(w http.ResponseWriter, r *ht
 	return _c
 }
 
-func (_c *MockController_Healthz_Call) Return() *MockController_Healthz_Call {
+func (_c *MockController_RetryIngestion_Call) Return() *MockController_RetryIngestion_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockController_Healthz_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Healthz_Call {
+func (_c *MockController_RetryIngestion_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_RetryIngestion_Call {
 	_c.Run(run)
 	return _c
 }
 
-// List provides a mock function for the type MockController
-func (_mock *MockController) List(w http.ResponseWriter, r *http.Request) {
+// Search provides a mock function for the type MockController
+func (_mock *MockController) Search(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
 	return
 }
 
-// MockController_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockController_List_Call struct {
+// MockController_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockController_Search_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// Search is a helper method to define mock.On call
 //   - w http.ResponseWriter
 //   - r *http.Request
-func (_e *MockController_Expecter) List(w interface{}, r interface{}) *MockController_List_Call {
-	return &MockController_List_Call{Call: _e.mock.On("List", w, r)}
+func (_e *MockController_Expecter) Search(w interface{}, r interface{}) *MockController_Search_Call {
+	return &MockController_Search_Call{Call: _e.mock.On("Search", w, r)}
 }
 
-func (_c *MockController_List_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_List_Call {
+func (_c *MockController_Search_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 http.ResponseWriter
 		if args[0] != nil {
@@ -211,12 +1548,12 @@ func (_c *MockController_List_Call) Run(run func(w http.ResponseWriter, r *http.
 	return _c
 }
 
-func (_c *MockController_List_Call) Return() *MockController_List_Call {
+func (_c *MockController_Search_Call) Return() *MockController_Search_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockController_List_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_List_Call {
+func (_c *MockController_Search_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Search_Call {
 	_c.Run(run)
 	return _c
 }
@@ -266,3 +1603,372 @@ func (_c *MockController_Statusz_Call) RunAndReturn(run func(w http.ResponseWrit
 	_c.Run(run)
 	return _c
 }
+
+// Update provides a mock function for the type MockController
+func (_mock *MockController) Update(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockController_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Update(w interface{}, r interface{}) *MockController_Update_Call {
+	return &MockController_Update_Call{Call: _e.mock.On("Update", w, r)}
+}
+
+func (_c *MockController_Update_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Update_Call) Return() *MockController_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Update_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdatePlaylist provides a mock function for the type MockController
+func (_mock *MockController) UpdatePlaylist(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_UpdatePlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlaylist'
+type MockController_UpdatePlaylist_Call struct {
+	*mock.Call
+}
+
+// UpdatePlaylist is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) UpdatePlaylist(w interface{}, r interface{}) *MockController_UpdatePlaylist_Call {
+	return &MockController_UpdatePlaylist_Call{Call: _e.mock.On("UpdatePlaylist", w, r)}
+}
+
+func (_c *MockController_UpdatePlaylist_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_UpdatePlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_UpdatePlaylist_Call) Return() *MockController_UpdatePlaylist_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_UpdatePlaylist_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_UpdatePlaylist_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Webhook provides a mock function for the type MockController
+func (_mock *MockController) Webhook(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockController_Webhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Webhook'
+type MockController_Webhook_Call struct {
+	*mock.Call
+}
+
+// Webhook is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockController_Expecter) Webhook(w interface{}, r interface{}) *MockController_Webhook_Call {
+	return &MockController_Webhook_Call{Call: _e.mock.On("Webhook", w, r)}
+}
+
+func (_c *MockController_Webhook_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockController_Webhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockController_Webhook_Call) Return() *MockController_Webhook_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockController_Webhook_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockController_Webhook_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIdempotencyKeys creates a new instance of MockIdempotencyKeys. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyKeys(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyKeys {
+	mock := &MockIdempotencyKeys{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyKeys is an autogenerated mock type for the IdempotencyKeys type
+type MockIdempotencyKeys struct {
+	mock.Mock
+}
+
+type MockIdempotencyKeys_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyKeys) EXPECT() *MockIdempotencyKeys_Expecter {
+	return &MockIdempotencyKeys_Expecter{mock: &_m.Mock}
+}
+
+// CompleteKey provides a mock function for the type MockIdempotencyKeys
+func (_mock *MockIdempotencyKeys) CompleteKey(ctx context.Context, key string, response model.IdempotencyKey) error {
+	ret := _mock.Called(ctx, key, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.IdempotencyKey) error); ok {
+		r0 = returnFunc(ctx, key, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyKeys_CompleteKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteKey'
+type MockIdempotencyKeys_CompleteKey_Call struct {
+	*mock.Call
+}
+
+// CompleteKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - response model.IdempotencyKey
+func (_e *MockIdempotencyKeys_Expecter) CompleteKey(ctx interface{}, key interface{}, response interface{}) *MockIdempotencyKeys_CompleteKey_Call {
+	return &MockIdempotencyKeys_CompleteKey_Call{Call: _e.mock.On("CompleteKey", ctx, key, response)}
+}
+
+func (_c *MockIdempotencyKeys_CompleteKey_Call) Run(run func(ctx context.Context, key string, response model.IdempotencyKey)) *MockIdempotencyKeys_CompleteKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.IdempotencyKey
+		if args[2] != nil {
+			arg2 = args[2].(model.IdempotencyKey)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyKeys_CompleteKey_Call) Return(err error) *MockIdempotencyKeys_CompleteKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyKeys_CompleteKey_Call) RunAndReturn(run func(ctx context.Context, key string, response model.IdempotencyKey) error) *MockIdempotencyKeys_CompleteKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseKey provides a mock function for the type MockIdempotencyKeys
+func (_mock *MockIdempotencyKeys) ReleaseKey(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyKeys_ReleaseKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseKey'
+type MockIdempotencyKeys_ReleaseKey_Call struct {
+	*mock.Call
+}
+
+// ReleaseKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockIdempotencyKeys_Expecter) ReleaseKey(ctx interface{}, key interface{}) *MockIdempotencyKeys_ReleaseKey_Call {
+	return &MockIdempotencyKeys_ReleaseKey_Call{Call: _e.mock.On("ReleaseKey", ctx, key)}
+}
+
+func (_c *MockIdempotencyKeys_ReleaseKey_Call) Run(run func(ctx context.Context, key string)) *MockIdempotencyKeys_ReleaseKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyKeys_ReleaseKey_Call) Return(err error) *MockIdempotencyKeys_ReleaseKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyKeys_ReleaseKey_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockIdempotencyKeys_ReleaseKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveKey provides a mock function for the type MockIdempotencyKeys
+func (_mock *MockIdempotencyKeys) ReserveKey(ctx context.Context, key string, requestHash string, lease time.Duration) (model.IdempotencyKey, bool, error) {
+	ret := _mock.Called(ctx, key, requestHash, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveKey")
+	}
+
+	var r0 model.IdempotencyKey
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (model.IdempotencyKey, bool, error)); ok {
+		return returnFunc(ctx, key, requestHash, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) model.IdempotencyKey); ok {
+		r0 = returnFunc(ctx, key, requestHash, lease)
+	} else {
+		r0 = ret.Get(0).(model.IdempotencyKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) bool); ok {
+		r1 = returnFunc(ctx, key, requestHash, lease)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, time.Duration) error); ok {
+		r2 = returnFunc(ctx, key, requestHash, lease)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIdempotencyKeys_ReserveKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveKey'
+type MockIdempotencyKeys_ReserveKey_Call struct {
+	*mock.Call
+}
+
+// ReserveKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - requestHash string
+//   - lease time.Duration
+func (_e *MockIdempotencyKeys_Expecter) ReserveKey(ctx interface{}, key interface{}, requestHash interface{}, lease interface{}) *MockIdempotencyKeys_ReserveKey_Call {
+	return &MockIdempotencyKeys_ReserveKey_Call{Call: _e.mock.On("ReserveKey", ctx, key, requestHash, lease)}
+}
+
+func (_c *MockIdempotencyKeys_ReserveKey_Call) Run(run func(ctx context.Context, key string, requestHash string, lease time.Duration)) *MockIdempotencyKeys_ReserveKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyKeys_ReserveKey_Call) Return(idempotencyKey model.IdempotencyKey, b bool, err error) *MockIdempotencyKeys_ReserveKey_Call {
+	_c.Call.Return(idempotencyKey, b, err)
+	return _c
+}
+
+func (_c *MockIdempotencyKeys_ReserveKey_Call) RunAndReturn(run func(ctx context.Context, key string, requestHash string, lease time.Duration) (model.IdempotencyKey, bool, error)) *MockIdempotencyKeys_ReserveKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
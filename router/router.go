@@ -14,20 +14,92 @@ type Controller interface {
 	Create(w http.ResponseWriter, r *http.Request)
 	GetByID(w http.ResponseWriter, r *http.Request)
 	List(w http.ResponseWriter, r *http.Request)
+	Update(w http.ResponseWriter, r *http.Request)
+	Patch(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	ListTrash(w http.ResponseWriter, r *http.Request)
+	Search(w http.ResponseWriter, r *http.Request)
+	Restore(w http.ResponseWriter, r *http.Request)
+	RetryIngestion(w http.ResponseWriter, r *http.Request)
+	EnableDownload(w http.ResponseWriter, r *http.Request)
+	CreateTrack(w http.ResponseWriter, r *http.Request)
+	ListTracks(w http.ResponseWriter, r *http.Request)
+	DeleteTrack(w http.ResponseWriter, r *http.Request)
+	Caption(w http.ResponseWriter, r *http.Request)
+	PlaybackToken(w http.ResponseWriter, r *http.Request)
+	Images(w http.ResponseWriter, r *http.Request)
+	Chapters(w http.ResponseWriter, r *http.Request)
+	CreateClip(w http.ResponseWriter, r *http.Request)
+	ListClips(w http.ResponseWriter, r *http.Request)
+
+	CreatePlaylist(w http.ResponseWriter, r *http.Request)
+	GetPlaylist(w http.ResponseWriter, r *http.Request)
+	ListPlaylists(w http.ResponseWriter, r *http.Request)
+	UpdatePlaylist(w http.ResponseWriter, r *http.Request)
+	DeletePlaylist(w http.ResponseWriter, r *http.Request)
+	AddPlaylistVideo(w http.ResponseWriter, r *http.Request)
+	RemovePlaylistVideo(w http.ResponseWriter, r *http.Request)
+	CreateUpload(w http.ResponseWriter, r *http.Request)
+	GetUpload(w http.ResponseWriter, r *http.Request)
+
+	CreateLiveStream(w http.ResponseWriter, r *http.Request)
+	GetLiveStream(w http.ResponseWriter, r *http.Request)
+	ListLiveStreams(w http.ResponseWriter, r *http.Request)
+	DisableLiveStream(w http.ResponseWriter, r *http.Request)
+	DeleteLiveStream(w http.ResponseWriter, r *http.Request)
+
+	Webhook(w http.ResponseWriter, r *http.Request)
 }
 
-// New returns a *mux.Router
+// New returns a *mux.Router, keys backs the Idempotency-Key of the creation endpoints
 func New(
 	controller Controller,
+	keys IdempotencyKeys,
 ) *mux.Router {
 	router := mux.NewRouter()
 
 	router.HandleFunc("/app/healthz", controller.Healthz).Methods("GET")
 	router.HandleFunc("/app/statusz", controller.Statusz).Methods("GET")
 
-	router.HandleFunc("/videos", controller.Create).Methods("POST")
+	router.Handle("/videos", idempotent(keys)(http.HandlerFunc(controller.Create))).Methods("POST")
+	router.HandleFunc("/videos/trash", controller.ListTrash).Methods("GET")
+	router.HandleFunc("/videos/search", controller.Search).Methods("GET")
 	router.HandleFunc("/videos/{id}", controller.GetByID).Methods("GET")
 	router.HandleFunc("/videos", controller.List).Methods("GET")
+	router.HandleFunc("/videos/{id}", controller.Update).Methods("PUT")
+	router.HandleFunc("/videos/{id}", controller.Patch).Methods("PATCH")
+	router.HandleFunc("/videos/{id}", controller.Delete).Methods("DELETE")
+	router.HandleFunc("/videos/{id}/restore", controller.Restore).Methods("POST")
+	router.HandleFunc("/videos/{id}/retry-ingestion", controller.RetryIngestion).Methods("POST")
+	router.HandleFunc("/videos/{id}/renditions", controller.EnableDownload).Methods("POST")
+	router.HandleFunc("/videos/{id}/playback-token", controller.PlaybackToken).Methods("POST")
+	router.HandleFunc("/videos/{id}/images", controller.Images).Methods("GET")
+	router.HandleFunc("/videos/{id}/chapters.vtt", controller.Chapters).Methods("GET")
+	router.HandleFunc("/videos/{id}/clips", controller.CreateClip).Methods("POST")
+	router.HandleFunc("/videos/{id}/clips", controller.ListClips).Methods("GET")
+	router.HandleFunc("/videos/{id}/tracks", controller.CreateTrack).Methods("POST")
+	router.HandleFunc("/videos/{id}/tracks", controller.ListTracks).Methods("GET")
+	router.HandleFunc("/videos/{id}/tracks/{trackId}", controller.DeleteTrack).Methods("DELETE")
+	router.HandleFunc("/captions/{id}.vtt", controller.Caption).Methods("GET")
+
+	router.HandleFunc("/playlists", controller.CreatePlaylist).Methods("POST")
+	router.HandleFunc("/playlists", controller.ListPlaylists).Methods("GET")
+	router.HandleFunc("/playlists/{id}", controller.GetPlaylist).Methods("GET")
+	router.HandleFunc("/playlists/{id}", controller.UpdatePlaylist).Methods("PUT")
+	router.HandleFunc("/playlists/{id}", controller.DeletePlaylist).Methods("DELETE")
+	router.HandleFunc("/playlists/{id}/videos/{videoId}", controller.AddPlaylistVideo).Methods("POST")
+	router.HandleFunc("/playlists/{id}/videos/{videoId}", controller.RemovePlaylistVideo).Methods("DELETE")
+
+	router.HandleFunc("/uploads", controller.CreateUpload).Methods("POST")
+	router.HandleFunc("/uploads/{id}", controller.GetUpload).Methods("GET")
+
+	router.HandleFunc("/livestreams", controller.CreateLiveStream).Methods("POST")
+	router.HandleFunc("/livestreams", controller.ListLiveStreams).Methods("GET")
+	router.HandleFunc("/livestreams/{id}", controller.GetLiveStream).Methods("GET")
+	router.HandleFunc("/livestreams/{id}", controller.DeleteLiveStream).Methods("DELETE")
+	router.HandleFunc("/livestreams/{id}/disable", controller.DisableLiveStream).Methods("POST")
+
+	router.HandleFunc("/webhooks/mux", controller.Webhook).Methods("POST")
 
 	return router
 }
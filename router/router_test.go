@@ -13,7 +13,7 @@ import (
 func TestNew(t *testing.T) {
 	mockController := NewMockController(t)
 
-	router := New(mockController)
+	router := New(mockController, NewMockIdempotencyKeys(t))
 
 	assert.NotNil(t, router)
 	assert.IsType(t, &mux.Router{}, router)
@@ -56,6 +56,198 @@ func TestRouter_Routes(t *testing.T) {
 			path:         "/videos",
 			expectedCode: http.StatusOK,
 		},
+		{
+			name:         "Update endpoint",
+			method:       "PUT",
+			path:         "/videos/123",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Patch endpoint",
+			method:       "PATCH",
+			path:         "/videos/123",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Delete endpoint",
+			method:       "DELETE",
+			path:         "/videos/123",
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "Trash endpoint",
+			method:       "GET",
+			path:         "/videos/trash",
+			expectedCode: http.StatusPartialContent,
+		},
+		{
+			name:         "Search endpoint",
+			method:       "GET",
+			path:         "/videos/search?q=oasis",
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name:         "Restore endpoint",
+			method:       "POST",
+			path:         "/videos/123/restore",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Retry ingestion endpoint",
+			method:       "POST",
+			path:         "/videos/123/retry-ingestion",
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name:         "Create track endpoint",
+			method:       "POST",
+			path:         "/videos/123/tracks",
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "List tracks endpoint",
+			method:       "GET",
+			path:         "/videos/123/tracks",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Delete track endpoint",
+			method:       "DELETE",
+			path:         "/videos/123/tracks/en",
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "Caption file endpoint",
+			method:       "GET",
+			path:         "/captions/123.vtt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Renditions endpoint",
+			method:       "POST",
+			path:         "/videos/123/renditions",
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name:         "Create clip endpoint",
+			method:       "POST",
+			path:         "/videos/123/clips",
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "List clips endpoint",
+			method:       "GET",
+			path:         "/videos/123/clips?page=2",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Playback token endpoint",
+			method:       "POST",
+			path:         "/videos/123/playback-token",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Images endpoint",
+			method:       "GET",
+			path:         "/videos/123/images?width=320&format=gif",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Chapters endpoint",
+			method:       "GET",
+			path:         "/videos/123/chapters.vtt",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Create playlist endpoint",
+			method:       "POST",
+			path:         "/playlists",
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "List playlists endpoint",
+			method:       "GET",
+			path:         "/playlists",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Get playlist endpoint",
+			method:       "GET",
+			path:         "/playlists/123",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Update playlist endpoint",
+			method:       "PUT",
+			path:         "/playlists/123",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Delete playlist endpoint",
+			method:       "DELETE",
+			path:         "/playlists/123",
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "Add playlist video endpoint",
+			method:       "POST",
+			path:         "/playlists/123/videos/456",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Create upload endpoint",
+			method:       "POST",
+			path:         "/uploads",
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "Get upload endpoint",
+			method:       "GET",
+			path:         "/uploads/123",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Create live stream endpoint",
+			method:       "POST",
+			path:         "/livestreams",
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "List live streams endpoint",
+			method:       "GET",
+			path:         "/livestreams?page=2",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Get live stream endpoint",
+			method:       "GET",
+			path:         "/livestreams/abc",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Disable live stream endpoint",
+			method:       "POST",
+			path:         "/livestreams/abc/disable",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Delete live stream endpoint",
+			method:       "DELETE",
+			path:         "/livestreams/abc",
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "Remove playlist video endpoint",
+			method:       "DELETE",
+			path:         "/playlists/123/videos/456",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Mux webhook endpoint",
+			method:       "POST",
+			path:         "/webhooks/mux",
+			expectedCode: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
@@ -81,8 +273,124 @@ func TestRouter_Routes(t *testing.T) {
 				w := args.Get(0).(http.ResponseWriter)
 				w.WriteHeader(http.StatusOK)
 			}).Return()
+			mockController.On("Update", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("Patch", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("Delete", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusNoContent)
+			}).Return()
+			mockController.On("ListTrash", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				// Distinct code proves /videos/trash is not routed to GetByID
+				w.WriteHeader(http.StatusPartialContent)
+			}).Return()
+			mockController.On("Search", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				// Distinct code proves /videos/search is not routed to GetByID
+				w.WriteHeader(http.StatusAccepted)
+			}).Return()
+			mockController.On("Restore", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("RetryIngestion", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusAccepted)
+			}).Return()
+			mockController.On("CreateTrack", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusCreated)
+			}).Return()
+			mockController.On("ListTracks", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("DeleteTrack", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusNoContent)
+			}).Return()
+			mockController.On("Caption", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("EnableDownload", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusAccepted)
+			}).Return()
+			mockController.On("CreateClip", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusCreated)
+			}).Return()
+			mockController.On("ListClips", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("PlaybackToken", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("Images", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("Chapters", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("CreatePlaylist", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusCreated)
+			}).Return()
+			for _, method := range []string{"ListPlaylists", "GetPlaylist", "UpdatePlaylist", "AddPlaylistVideo", "RemovePlaylistVideo"} {
+				mockController.On(method, mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+					w := args.Get(0).(http.ResponseWriter)
+					w.WriteHeader(http.StatusOK)
+				}).Return()
+			}
+			mockController.On("DeletePlaylist", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusNoContent)
+			}).Return()
+			mockController.On("CreateUpload", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusCreated)
+			}).Return()
+			mockController.On("GetUpload", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("CreateLiveStream", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusCreated)
+			}).Return()
+			mockController.On("ListLiveStreams", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("GetLiveStream", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("DisableLiveStream", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
+			mockController.On("DeleteLiveStream", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusNoContent)
+			}).Return()
+			mockController.On("Webhook", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+				w := args.Get(0).(http.ResponseWriter)
+				w.WriteHeader(http.StatusOK)
+			}).Return()
 
-			router := New(mockController)
+			router := New(mockController, NewMockIdempotencyKeys(t))
 
 			req, err := http.NewRequest(tt.method, tt.path, nil)
 			assert.NoError(t, err)
@@ -122,7 +430,7 @@ func TestRouter_InvalidRoutes(t *testing.T) {
 		},
 		{
 			name:         "Method not allowed on videos by ID",
-			method:       "PUT",
+			method:       "POST",
 			path:         "/videos/123",
 			expectedCode: http.StatusMethodNotAllowed,
 		},
@@ -131,7 +439,7 @@ func TestRouter_InvalidRoutes(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockController := NewMockController(t)
-			router := New(mockController)
+			router := New(mockController, NewMockIdempotencyKeys(t))
 
 			req, err := http.NewRequest(tt.method, tt.path, nil)
 			assert.NoError(t, err)
@@ -153,7 +461,7 @@ func TestRouter_PathParameters(t *testing.T) {
 			assert.Equal(t, "test-id-123", vars["id"])
 		}).Return()
 
-		router := New(mockController)
+		router := New(mockController, NewMockIdempotencyKeys(t))
 
 		req, err := http.NewRequest("GET", "/videos/test-id-123", nil)
 		assert.NoError(t, err)
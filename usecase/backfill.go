@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// backfillBatch is the number of videos read on each page
+const backfillBatch = 100
+
+type backfill struct {
+	assets Assets
+	videos Videos
+	logger *logrus.Logger
+}
+
+// Backfill returns the usecase implementation
+func Backfill(
+	a Assets,
+	v Videos,
+	l *logrus.Logger,
+) backfill {
+	return backfill{
+		assets: a,
+		videos: v,
+		logger: l,
+	}
+}
+
+// Run hydrates from Mux.com the videos whose asset state was never stored
+func (u backfill) Run(ctx context.Context) (model.BackfillReport, error) {
+	var report model.BackfillReport
+
+	after := ""
+	for {
+		videos, err := u.videos.ListIncomplete(ctx, after, backfillBatch)
+		if err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return report, err
+		}
+
+		if len(videos) == 0 {
+			return report, nil
+		}
+
+		for _, video := range videos {
+			after = video.ID
+			report.Scanned++
+
+			asset, err := u.assets.GetByID(ctx, video.Asset.ID)
+			if err == errorcodes.ErrAssetNotFound {
+				// Keep the document, but stop pointing players at a missing asset
+				asset = model.Asset{ID: video.Asset.ID, Status: "deleted"}
+				report.Missing++
+			} else if err != nil {
+				u.logger.WithError(err).WithField("video_id", video.ID).Error("error retrieving asset")
+				report.Failed++
+				continue
+			}
+
+			if _, err := u.videos.UpdateAsset(ctx, video.ID, asset); err != nil {
+				u.logger.WithError(err).WithField("video_id", video.ID).Error("error updating video")
+				report.Failed++
+				continue
+			}
+
+			report.Updated++
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// TestBackfill tests the Backfill constructor function
+func TestBackfill(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	assets := NewMockAssets(t)
+	videos := NewMockVideos(t)
+
+	usecase := Backfill(assets, videos, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, assets, usecase.assets)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestBackfill_Run(t *testing.T) {
+	ready := model.Asset{
+		ID:          "ready-asset",
+		Status:      "ready",
+		Duration:    10.5,
+		PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "signed"}},
+	}
+
+	t.Run("Updates, marks missing and counts failures", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &backfill{assets, videos, testLogger}
+		ctx := context.Background()
+
+		videos.On("ListIncomplete", ctx, "", backfillBatch).Return([]model.Video{
+			{ID: "a", Asset: &model.Asset{ID: "ready-asset"}},
+			{ID: "b", Asset: &model.Asset{ID: "missing-asset"}},
+			{ID: "c", Asset: &model.Asset{ID: "broken-asset"}},
+		}, nil)
+		videos.On("ListIncomplete", ctx, "c", backfillBatch).Return([]model.Video{}, nil)
+
+		assets.On("GetByID", ctx, "ready-asset").Return(ready, nil)
+		assets.On("GetByID", ctx, "missing-asset").Return(model.Asset{}, errorcodes.ErrAssetNotFound)
+		assets.On("GetByID", ctx, "broken-asset").Return(model.Asset{}, errors.New("mux unavailable"))
+
+		videos.On("UpdateAsset", ctx, "a", ready).Return(model.Video{}, nil)
+		videos.On("UpdateAsset", ctx, "b", model.Asset{ID: "missing-asset", Status: "deleted"}).Return(model.Video{}, nil)
+
+		report, err := usecase.Run(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.BackfillReport{Scanned: 3, Updated: 2, Missing: 1, Failed: 1}, report)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &backfill{assets, videos, testLogger}
+		ctx := context.Background()
+
+		videos.On("ListIncomplete", ctx, "", backfillBatch).Return(nil, errors.New("connection refused"))
+
+		_, err := usecase.Run(ctx)
+
+		assert.Error(t, err)
+	})
+}
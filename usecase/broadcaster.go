@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// errSealedKey is returned when a stored stream key can't be opened
+var errSealedKey = errors.New("stream key can't be opened")
+
+type broadcaster struct {
+	liveStreams LiveStreams
+	streams     Streams
+	key         []byte
+	logger      *logrus.Logger
+}
+
+// Broadcaster returns the usecase implementation, the stream keys are stored
+// sealed with a key derived from the secret. Without a secret no live stream
+// can be created.
+func Broadcaster(
+	ls LiveStreams,
+	s Streams,
+	secret string,
+	l *logrus.Logger,
+) broadcaster {
+	var key []byte
+	if len(secret) > 0 {
+		sum := sha256.Sum256([]byte(secret))
+		key = sum[:]
+	}
+
+	return broadcaster{
+		liveStreams: ls,
+		streams:     s,
+		key:         key,
+		logger:      l,
+	}
+}
+
+// Create method asks Mux.com for a live stream and stores it, the response
+// carries the stream key the broadcasting software needs
+func (u broadcaster) Create(ctx context.Context, request model.LiveStream) (model.LiveStream, error) {
+	// Title and Description are mandatory, the recordings inherit them
+	if len(request.Title) == 0 || len(request.Description) == 0 {
+		return model.LiveStream{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	var isPublic bool
+	switch request.Policy {
+	case "public":
+		isPublic = true
+	case "signed":
+		isPublic = false
+	default:
+		return model.LiveStream{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	if len(u.key) == 0 {
+		u.logger.Warn("live streams need STREAM_KEY_SECRET")
+		return model.LiveStream{}, errorcodes.ErrLiveStreamsDisabled
+	}
+
+	stream, err := u.liveStreams.CreateLiveStream(ctx, model.AssetSettings{Public: isPublic})
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.LiveStream{}, err
+	}
+
+	streamKey := stream.StreamKey
+	sealed, err := u.seal(streamKey)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		u.removeLiveStream(ctx, stream.ID)
+		return model.LiveStream{}, err
+	}
+
+	stream.Title = request.Title
+	stream.Description = request.Description
+	stream.Policy = request.Policy
+	stream.StreamKey = sealed
+
+	response, err := u.streams.CreateStream(ctx, stream)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		u.removeLiveStream(ctx, stream.ID)
+		return model.LiveStream{}, err
+	}
+
+	response.StreamKey = streamKey
+
+	return u.hydrate(ctx, response), nil
+}
+
+// GetByID method returns a live stream with its stream key
+func (u broadcaster) GetByID(ctx context.Context, id string) (model.LiveStream, error) {
+	stream, err := u.get(ctx, id)
+	if err != nil {
+		return model.LiveStream{}, err
+	}
+
+	return u.hydrate(ctx, stream), nil
+}
+
+// List method returns a page of the live streams, newest first, read after
+// the cursor. The stream keys are only returned for a single live stream.
+func (u broadcaster) List(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error) {
+	list, err := u.streams.ListStreams(ctx, cursor, limit)
+	if err != nil {
+		if err != errorcodes.ErrInvalidCursor {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.LiveStreamList{}, err
+	}
+
+	for i, stream := range list.Items {
+		stream.StreamKey = ""
+		list.Items[i] = u.hydrate(ctx, stream)
+	}
+
+	return list, nil
+}
+
+// Disable method stops a live stream from accepting broadcasts, the webhooks
+// report when it becomes active or idle again
+func (u broadcaster) Disable(ctx context.Context, id string) (model.LiveStream, error) {
+	stream, err := u.get(ctx, id)
+	if err != nil {
+		return model.LiveStream{}, err
+	}
+
+	if err := u.liveStreams.DisableLiveStream(ctx, id); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.LiveStream{}, err
+	}
+
+	updated, err := u.streams.UpdateStream(ctx, id, model.LiveStreamDisabled)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.LiveStream{}, err
+	}
+	updated.StreamKey = stream.StreamKey
+
+	return u.hydrate(ctx, updated), nil
+}
+
+// Delete method removes a live stream from Mux.com and the library, the
+// videos of its recordings are kept
+func (u broadcaster) Delete(ctx context.Context, id string) error {
+	if _, err := u.streams.GetStream(ctx, id); err != nil {
+		if err != errorcodes.ErrLiveStreamNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return err
+	}
+
+	// A live stream already gone from Mux.com is only removed from the library
+	err := u.liveStreams.DeleteLiveStream(ctx, id)
+	if err != nil && err != errorcodes.ErrLiveStreamNotFound {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	if err := u.streams.DeleteStream(ctx, id); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// get returns a stored live stream with its stream key opened
+func (u broadcaster) get(ctx context.Context, id string) (model.LiveStream, error) {
+	stream, err := u.streams.GetStream(ctx, id)
+	if err != nil {
+		if err != errorcodes.ErrLiveStreamNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.LiveStream{}, err
+	}
+
+	stream.StreamKey, err = u.open(stream.StreamKey)
+	if err != nil {
+		u.logger.WithError(err).WithField("live_stream_id", id).Error("error opening stream key")
+		return model.LiveStream{}, err
+	}
+
+	return stream, nil
+}
+
+// hydrate fills the playback URLs of a live stream, it is returned as stored
+// when they can't be built
+func (u broadcaster) hydrate(ctx context.Context, stream model.LiveStream) model.LiveStream {
+	response, err := u.liveStreams.HydrateLiveStream(ctx, stream)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return stream
+	}
+
+	return response
+}
+
+// removeLiveStream deletes a live stream that could not be stored
+func (u broadcaster) removeLiveStream(ctx context.Context, id string) {
+	if err := u.liveStreams.DeleteLiveStream(ctx, id); err != nil {
+		u.logger.WithError(err).WithField("live_stream_id", id).Error("error removing live stream")
+	}
+}
+
+// seal encrypts a stream key with AES-GCM, the nonce is prepended to the
+// ciphertext and the result base64 encoded
+func (u broadcaster) seal(streamKey string) (string, error) {
+	gcm, err := u.cipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(streamKey), nil)), nil
+}
+
+// open decrypts a stream key sealed by seal
+func (u broadcaster) open(sealed string) (string, error) {
+	if len(u.key) == 0 {
+		return "", errSealedKey
+	}
+
+	gcm, err := u.cipher()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < gcm.NonceSize() {
+		return "", errSealedKey
+	}
+
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	streamKey, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errSealedKey
+	}
+
+	return string(streamKey), nil
+}
+
+// cipher returns the AES-GCM cipher of the key
+func (u broadcaster) cipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(u.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,476 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	liveStreamID  = "ZEBrNTpHC02iUah025KM3te6ylM7W4S4silsrFtUkn3Ag"
+	streamKey     = "super-secret-stream-key"
+	streamSecret  = "l1v3-s3cr3t"
+	liveIngestURL = "rtmps://global-live.mux.com:443/app"
+)
+
+// streamCipherKey is the key Broadcaster derives from streamSecret
+var streamCipherKey = func() []byte {
+	sum := sha256.Sum256([]byte(streamSecret))
+	return sum[:]
+}()
+
+// TestBroadcaster tests the Broadcaster constructor function
+func TestBroadcaster(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	liveStreams := NewMockLiveStreams(t)
+	streams := NewMockStreams(t)
+
+	usecase := Broadcaster(liveStreams, streams, streamSecret, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, liveStreams, usecase.liveStreams)
+	assert.Equal(t, streams, usecase.streams)
+	assert.Equal(t, streamCipherKey, usecase.key)
+	assert.Equal(t, logger, usecase.logger)
+
+	assert.Nil(t, Broadcaster(liveStreams, streams, "", logger).key, "No secret derives no key")
+}
+
+func TestBroadcaster_Create(t *testing.T) {
+	request := model.LiveStream{Title: "Weekly webinar", Description: "Britpop 101", Policy: "signed"}
+	created := model.LiveStream{
+		ID:          liveStreamID,
+		Status:      model.LiveStreamIdle,
+		StreamKey:   streamKey,
+		PlaybackIDs: []model.PlaybackID{{ID: "pb1", Policy: "signed"}},
+	}
+	stored := created
+	stored.Title = request.Title
+	stored.Description = request.Description
+	stored.Policy = request.Policy
+	hydrated := stored
+	hydrated.IngestURL = liveIngestURL
+	hydrated.Sources = []model.Source{{Source: "https://stream.mux.com/pb1.m3u8?token=abc", Type: "application/x-mpegURL"}}
+
+	// sealed matches the stored live stream, its key is never the plain one
+	sealed := mock.MatchedBy(func(s model.LiveStream) bool {
+		u := broadcaster{key: streamCipherKey}
+		opened, err := u.open(s.StreamKey)
+
+		return s.StreamKey != streamKey && err == nil && opened == streamKey &&
+			s.ID == liveStreamID && s.Title == request.Title && s.Description == request.Description && s.Policy == "signed"
+	})
+
+	tests := []struct {
+		name      string
+		request   model.LiveStream
+		key       []byte
+		mocks     func(ctx context.Context, l *MockLiveStreams, s *MockStreams)
+		expected  model.LiveStream
+		wantedErr error
+	}{
+		{
+			name:    "Success",
+			request: request,
+			key:     streamCipherKey,
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				l.On("CreateLiveStream", ctx, model.AssetSettings{Public: false}).Return(created, nil)
+				s.On("CreateStream", ctx, sealed).Return(func(ctx context.Context, stream model.LiveStream) model.LiveStream { return stream }, nil)
+				l.On("HydrateLiveStream", ctx, stored).Return(hydrated, nil)
+			},
+			expected: hydrated,
+		},
+		{
+			name:    "Hydration error returns the stored live stream",
+			request: request,
+			key:     streamCipherKey,
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				l.On("CreateLiveStream", ctx, model.AssetSettings{Public: false}).Return(created, nil)
+				s.On("CreateStream", ctx, sealed).Return(func(ctx context.Context, stream model.LiveStream) model.LiveStream { return stream }, nil)
+				l.On("HydrateLiveStream", ctx, stored).Return(model.LiveStream{}, errors.New("signing error"))
+			},
+			expected: stored,
+		},
+		{
+			name:      "Missing description",
+			request:   model.LiveStream{Title: "Weekly webinar", Policy: "public"},
+			key:       streamCipherKey,
+			wantedErr: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:      "Unknown policy",
+			request:   model.LiveStream{Title: "Weekly webinar", Description: "Britpop 101", Policy: "private"},
+			key:       streamCipherKey,
+			wantedErr: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:      "No secret",
+			request:   request,
+			wantedErr: errorcodes.ErrLiveStreamsDisabled,
+		},
+		{
+			name:    "Mux.com error",
+			request: request,
+			key:     streamCipherKey,
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				l.On("CreateLiveStream", ctx, model.AssetSettings{Public: false}).Return(model.LiveStream{}, errors.New("mux error"))
+			},
+			wantedErr: errors.New("mux error"),
+		},
+		{
+			name:    "Repository error removes the live stream",
+			request: request,
+			key:     streamCipherKey,
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				l.On("CreateLiveStream", ctx, model.AssetSettings{Public: false}).Return(created, nil)
+				s.On("CreateStream", ctx, sealed).Return(model.LiveStream{}, errors.New("db error"))
+				l.On("DeleteLiveStream", ctx, liveStreamID).Return(nil)
+			},
+			wantedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			liveStreams := NewMockLiveStreams(t)
+			streams := NewMockStreams(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &broadcaster{liveStreams, streams, tt.key, testLogger}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, liveStreams, streams)
+			}
+
+			got, err := usecase.Create(ctx, tt.request)
+
+			if tt.wantedErr != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.wantedErr.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBroadcaster_GetByID(t *testing.T) {
+	sealed, err := broadcaster{key: streamCipherKey}.seal(streamKey)
+	assert.NoError(t, err)
+
+	stored := model.LiveStream{ID: liveStreamID, Title: "Weekly webinar", Status: model.LiveStreamActive, StreamKey: sealed}
+	opened := stored
+	opened.StreamKey = streamKey
+	hydrated := opened
+	hydrated.IngestURL = liveIngestURL
+
+	tests := []struct {
+		name      string
+		key       []byte
+		mocks     func(ctx context.Context, l *MockLiveStreams, s *MockStreams)
+		expected  model.LiveStream
+		wantedErr error
+	}{
+		{
+			name: "Success",
+			key:  streamCipherKey,
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("HydrateLiveStream", ctx, opened).Return(hydrated, nil)
+			},
+			expected: hydrated,
+		},
+		{
+			name: "Not found",
+			key:  streamCipherKey,
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(model.LiveStream{}, errorcodes.ErrLiveStreamNotFound)
+			},
+			wantedErr: errorcodes.ErrLiveStreamNotFound,
+		},
+		{
+			name: "Another secret can't open the key",
+			key:  make([]byte, 32),
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+			},
+			wantedErr: errSealedKey,
+		},
+		{
+			name: "No secret",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+			},
+			wantedErr: errSealedKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			liveStreams := NewMockLiveStreams(t)
+			streams := NewMockStreams(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &broadcaster{liveStreams, streams, tt.key, testLogger}
+
+			ctx := context.Background()
+			tt.mocks(ctx, liveStreams, streams)
+
+			got, err := usecase.GetByID(ctx, liveStreamID)
+
+			if tt.wantedErr != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.wantedErr.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBroadcaster_List(t *testing.T) {
+	stored := model.LiveStreamList{
+		Items: []model.LiveStream{
+			{ID: liveStreamID, Title: "Weekly webinar", StreamKey: "sealed"},
+			{ID: "other", Title: "Launch", StreamKey: "sealed"},
+		},
+		NextCursor: "next",
+	}
+
+	t.Run("Stream keys are left out", func(t *testing.T) {
+		liveStreams := NewMockLiveStreams(t)
+		streams := NewMockStreams(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &broadcaster{liveStreams, streams, streamCipherKey, testLogger}
+		ctx := context.Background()
+
+		streams.On("ListStreams", ctx, "abc", 5).Return(stored, nil)
+		liveStreams.On("HydrateLiveStream", ctx, model.LiveStream{ID: liveStreamID, Title: "Weekly webinar"}).
+			Return(model.LiveStream{ID: liveStreamID, Title: "Weekly webinar", IngestURL: liveIngestURL}, nil)
+		liveStreams.On("HydrateLiveStream", ctx, model.LiveStream{ID: "other", Title: "Launch"}).
+			Return(model.LiveStream{ID: "other", Title: "Launch", IngestURL: liveIngestURL}, nil)
+
+		got, err := usecase.List(ctx, "abc", 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.LiveStreamList{
+			Items: []model.LiveStream{
+				{ID: liveStreamID, Title: "Weekly webinar", IngestURL: liveIngestURL},
+				{ID: "other", Title: "Launch", IngestURL: liveIngestURL},
+			},
+			NextCursor: "next",
+		}, got)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		streams := NewMockStreams(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &broadcaster{NewMockLiveStreams(t), streams, streamCipherKey, testLogger}
+		ctx := context.Background()
+
+		streams.On("ListStreams", ctx, "", 10).Return(model.LiveStreamList{}, errors.New("db error"))
+
+		_, err := usecase.List(ctx, "", 10)
+
+		assert.EqualError(t, err, "db error")
+	})
+}
+
+func TestBroadcaster_Disable(t *testing.T) {
+	sealed, err := broadcaster{key: streamCipherKey}.seal(streamKey)
+	assert.NoError(t, err)
+
+	stored := model.LiveStream{ID: liveStreamID, Status: model.LiveStreamActive, StreamKey: sealed}
+	disabled := model.LiveStream{ID: liveStreamID, Status: model.LiveStreamDisabled, StreamKey: sealed}
+	opened := disabled
+	opened.StreamKey = streamKey
+
+	tests := []struct {
+		name      string
+		mocks     func(ctx context.Context, l *MockLiveStreams, s *MockStreams)
+		expected  model.LiveStream
+		wantedErr error
+	}{
+		{
+			name: "Success",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("DisableLiveStream", ctx, liveStreamID).Return(nil)
+				s.On("UpdateStream", ctx, liveStreamID, model.LiveStreamDisabled).Return(disabled, nil)
+				l.On("HydrateLiveStream", ctx, opened).Return(opened, nil)
+			},
+			expected: opened,
+		},
+		{
+			name: "Not found",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(model.LiveStream{}, errorcodes.ErrLiveStreamNotFound)
+			},
+			wantedErr: errorcodes.ErrLiveStreamNotFound,
+		},
+		{
+			name: "Mux.com error",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("DisableLiveStream", ctx, liveStreamID).Return(errors.New("mux error"))
+			},
+			wantedErr: errors.New("mux error"),
+		},
+		{
+			name: "Repository error",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("DisableLiveStream", ctx, liveStreamID).Return(nil)
+				s.On("UpdateStream", ctx, liveStreamID, model.LiveStreamDisabled).Return(model.LiveStream{}, errors.New("db error"))
+			},
+			wantedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			liveStreams := NewMockLiveStreams(t)
+			streams := NewMockStreams(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &broadcaster{liveStreams, streams, streamCipherKey, testLogger}
+
+			ctx := context.Background()
+			tt.mocks(ctx, liveStreams, streams)
+
+			got, err := usecase.Disable(ctx, liveStreamID)
+
+			if tt.wantedErr != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.wantedErr.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBroadcaster_Delete(t *testing.T) {
+	stored := model.LiveStream{ID: liveStreamID, StreamKey: "sealed"}
+
+	tests := []struct {
+		name      string
+		mocks     func(ctx context.Context, l *MockLiveStreams, s *MockStreams)
+		wantedErr error
+	}{
+		{
+			name: "Success",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("DeleteLiveStream", ctx, liveStreamID).Return(nil)
+				s.On("DeleteStream", ctx, liveStreamID).Return(nil)
+			},
+		},
+		{
+			name: "Already gone from Mux.com",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("DeleteLiveStream", ctx, liveStreamID).Return(errorcodes.ErrLiveStreamNotFound)
+				s.On("DeleteStream", ctx, liveStreamID).Return(nil)
+			},
+		},
+		{
+			name: "Not found",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(model.LiveStream{}, errorcodes.ErrLiveStreamNotFound)
+			},
+			wantedErr: errorcodes.ErrLiveStreamNotFound,
+		},
+		{
+			name: "Mux.com error keeps the live stream",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("DeleteLiveStream", ctx, liveStreamID).Return(errors.New("mux error"))
+			},
+			wantedErr: errors.New("mux error"),
+		},
+		{
+			name: "Repository error",
+			mocks: func(ctx context.Context, l *MockLiveStreams, s *MockStreams) {
+				s.On("GetStream", ctx, liveStreamID).Return(stored, nil)
+				l.On("DeleteLiveStream", ctx, liveStreamID).Return(nil)
+				s.On("DeleteStream", ctx, liveStreamID).Return(errors.New("db error"))
+			},
+			wantedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			liveStreams := NewMockLiveStreams(t)
+			streams := NewMockStreams(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &broadcaster{liveStreams, streams, streamCipherKey, testLogger}
+
+			ctx := context.Background()
+			tt.mocks(ctx, liveStreams, streams)
+
+			err := usecase.Delete(ctx, liveStreamID)
+
+			if tt.wantedErr != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.wantedErr.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+		})
+	}
+}
+
+func TestBroadcaster_seal(t *testing.T) {
+	u := broadcaster{key: streamCipherKey}
+
+	first, err := u.seal(streamKey)
+	assert.NoError(t, err)
+	second, err := u.seal(streamKey)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second, "Each seal uses its own nonce")
+
+	opened, err := u.open(first)
+	assert.NoError(t, err)
+	assert.Equal(t, streamKey, opened)
+
+	_, err = u.open(first[:len(first)-4] + "AAAA")
+	assert.Equal(t, errSealedKey, err, "A tampered key is rejected")
+
+	_, err = u.open("not base64!")
+	assert.Equal(t, errSealedKey, err, "A malformed key is rejected")
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+)
+
+// languageCode matches the BCP 47 language codes of the tracks, e.g. en or pt-BR
+var languageCode = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// vttTiming matches the timing line of a WebVTT cue, hours are optional
+var vttTiming = regexp.MustCompile(`(?m)^(\d{2,}:)?\d{2}:\d{2}\.\d{3}[ \t]+-->`)
+
+// srtTiming matches the timing line of a SRT cue
+var srtTiming = regexp.MustCompile(`^\d{2,}:\d{2}:\d{2},\d{3}[ \t]+-->[ \t]+\d{2,}:\d{2}:\d{2},\d{3}`)
+
+// srtTimestamp matches a SRT timestamp, its milliseconds follow a comma
+var srtTimestamp = regexp.MustCompile(`(\d{2,}:\d{2}:\d{2}),(\d{3})`)
+
+// toVTT returns a caption file as WebVTT, SRT files are converted. A file
+// without cues is rejected.
+func toVTT(content string) (string, error) {
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	if strings.HasPrefix(content, "WEBVTT") {
+		if !vttTiming.MatchString(content) {
+			return "", errorcodes.ErrInvalidTrack
+		}
+
+		return content, nil
+	}
+
+	// The SRT cue numbers are kept as WebVTT cue identifiers
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+
+	cues := 0
+	for _, line := range strings.Split(strings.TrimSpace(content), "\n") {
+		if srtTiming.MatchString(line) {
+			line = srtTimestamp.ReplaceAllString(line, "$1.$2")
+			cues++
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	if cues == 0 {
+		return "", errorcodes.ErrInvalidTrack
+	}
+
+	return b.String(), nil
+}
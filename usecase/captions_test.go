@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+)
+
+func TestToVTT(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		err     error
+	}{
+		{
+			name:    "WebVTT is kept",
+			content: "WEBVTT\n\n00:01.000 --> 00:04.000\nToday is gonna be the day\n",
+			want:    "WEBVTT\n\n00:01.000 --> 00:04.000\nToday is gonna be the day\n",
+		},
+		{
+			name:    "SRT is converted",
+			content: "1\r\n00:00:01,000 --> 00:00:04,000\r\nToday is gonna be the day\r\n\r\n2\r\n00:00:04,500 --> 00:00:07,250\r\nThat they're gonna throw it back to you\r\n",
+			want:    "WEBVTT\n\n1\n00:00:01.000 --> 00:00:04.000\nToday is gonna be the day\n\n2\n00:00:04.500 --> 00:00:07.250\nThat they're gonna throw it back to you\n",
+		},
+		{
+			name:    "Byte order mark is removed",
+			content: "\ufeff1\n00:00:01,000 --> 00:00:04,000\nWonderwall\n",
+			want:    "WEBVTT\n\n1\n00:00:01.000 --> 00:00:04.000\nWonderwall\n",
+		},
+		{
+			name:    "Commas of the cue text are kept",
+			content: "1\n00:00:01,000 --> 00:00:04,000\nAnd after all, you're my wonderwall\n",
+			want:    "WEBVTT\n\n1\n00:00:01.000 --> 00:00:04.000\nAnd after all, you're my wonderwall\n",
+		},
+		{
+			name:    "WebVTT without cues",
+			content: "WEBVTT\n\n",
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Not a caption file",
+			content: "Today is gonna be the day",
+			err:     errorcodes.ErrInvalidTrack,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toVTT(tt.content)
+
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err, "Error doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
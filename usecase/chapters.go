@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	maxChapters     = 100 // chapters per video
+	maxChapterTitle = 100 // characters per chapter title
+)
+
+// validateChapters checks the titles and times of the chapters, listed in
+// the order they start
+func validateChapters(chapters []model.Chapter) error {
+	if len(chapters) > maxChapters {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	for i, chapter := range chapters {
+		title := strings.TrimSpace(chapter.Title)
+		if title == "" || utf8.RuneCountInString(title) > maxChapterTitle {
+			return errorcodes.ErrVideoUnprocessable
+		}
+
+		if chapter.StartTime < 0 || (chapter.ThumbnailTime != nil && *chapter.ThumbnailTime < 0) {
+			return errorcodes.ErrVideoUnprocessable
+		}
+
+		if i > 0 && chapter.StartTime <= chapters[i-1].StartTime {
+			return errorcodes.ErrVideoUnprocessable
+		}
+	}
+
+	return nil
+}
+
+// chaptersWithin checks that the chapters start and show thumbnails within
+// the duration of the asset, a zero duration is not known yet
+func chaptersWithin(chapters []model.Chapter, duration float64) error {
+	if duration <= 0 {
+		return nil
+	}
+
+	for _, chapter := range chapters {
+		if chapter.StartTime >= duration || (chapter.ThumbnailTime != nil && *chapter.ThumbnailTime > duration) {
+			return errorcodes.ErrVideoUnprocessable
+		}
+	}
+
+	return nil
+}
+
+// posterWithin checks that the poster frame is within a video of the given
+// duration, like the thumbnail time of a chapter. An unknown duration is not checked.
+func posterWithin(posterTime *float64, duration float64) error {
+	if duration <= 0 || posterTime == nil {
+		return nil
+	}
+
+	if *posterTime > duration {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	return nil
+}
+
+// chaptersVTT returns the WebVTT chapters file of a video, each chapter ends
+// when the next one starts and the last one with the video
+func chaptersVTT(chapters []model.Chapter, duration float64) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+
+	for i, chapter := range chapters {
+		if chapter.StartTime >= duration {
+			break
+		}
+
+		end := duration
+		if i+1 < len(chapters) && chapters[i+1].StartTime < duration {
+			end = chapters[i+1].StartTime
+		}
+
+		// A cue is a single line of text, never holds the timing arrow and
+		// escapes the characters WebVTT reads as markup
+		title := strings.Join(strings.Fields(chapter.Title), " ")
+		title = strings.ReplaceAll(title, "&", "&amp;")
+		title = strings.ReplaceAll(title, "<", "&lt;")
+		title = strings.ReplaceAll(title, "-->", "->")
+
+		fmt.Fprintf(&b, "\n%d\n%s --> %s\n%s\n", i+1, vttTimestamp(chapter.StartTime), vttTimestamp(end), title)
+	}
+
+	return b.String()
+}
+
+// vttTimestamp formats seconds as a WebVTT timestamp, hh:mm:ss.ttt
+func vttTimestamp(seconds float64) string {
+	ms := int64(seconds*1000 + 0.5)
+
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+func TestValidateChapters(t *testing.T) {
+	negative, frame := -1.0, 95.0
+
+	tests := []struct {
+		name     string
+		chapters []model.Chapter
+		duration float64
+		err      error
+	}{
+		{"No chapters", nil, 0, nil},
+		{"Chapters in order", []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 90, Title: "Chorus", ThumbnailTime: &frame}}, 120, nil},
+		{"Duration not known yet", []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 600, Title: "Outro"}}, 0, nil},
+		{"Missing title", []model.Chapter{{StartTime: 0, Title: " "}}, 0, errorcodes.ErrVideoUnprocessable},
+		{"Title too long", []model.Chapter{{StartTime: 0, Title: strings.Repeat("a", maxChapterTitle+1)}}, 0, errorcodes.ErrVideoUnprocessable},
+		{"Negative start time", []model.Chapter{{StartTime: -1, Title: "Intro"}}, 0, errorcodes.ErrVideoUnprocessable},
+		{"Negative thumbnail time", []model.Chapter{{StartTime: 0, Title: "Intro", ThumbnailTime: &negative}}, 0, errorcodes.ErrVideoUnprocessable},
+		{"Out of order", []model.Chapter{{StartTime: 90, Title: "Chorus"}, {StartTime: 0, Title: "Intro"}}, 0, errorcodes.ErrVideoUnprocessable},
+		{"Same start time", []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 0, Title: "Chorus"}}, 0, errorcodes.ErrVideoUnprocessable},
+		{"Too many chapters", make([]model.Chapter, maxChapters+1), 0, errorcodes.ErrVideoUnprocessable},
+		{"Starts after the video", []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 120, Title: "Outro"}}, 120, errorcodes.ErrVideoUnprocessable},
+		{"Thumbnail after the video", []model.Chapter{{StartTime: 0, Title: "Intro", ThumbnailTime: &frame}}, 60, errorcodes.ErrVideoUnprocessable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateChapters(tt.chapters)
+			if err == nil {
+				err = chaptersWithin(tt.chapters, tt.duration)
+			}
+
+			assert.Equal(t, tt.err, err, "Error doesn't match")
+		})
+	}
+}
+
+func TestChaptersVTT(t *testing.T) {
+	tests := []struct {
+		name     string
+		chapters []model.Chapter
+		duration float64
+		want     string
+	}{
+		{
+			name:     "No chapters",
+			duration: 120,
+			want:     "WEBVTT\n",
+		},
+		{
+			name:     "Last chapter ends with the video",
+			chapters: []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 90.5, Title: "Chorus"}},
+			duration: 3725.25,
+			want:     "WEBVTT\n\n1\n00:00:00.000 --> 00:01:30.500\nIntro\n\n2\n00:01:30.500 --> 01:02:05.250\nChorus\n",
+		},
+		{
+			name:     "Chapters after the video are left out",
+			chapters: []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 150, Title: "Outro"}},
+			duration: 120,
+			want:     "WEBVTT\n\n1\n00:00:00.000 --> 00:02:00.000\nIntro\n",
+		},
+		{
+			name:     "Titles are a single cue line",
+			chapters: []model.Chapter{{StartTime: 0, Title: "Verse\n-->  Chorus"}},
+			duration: 60,
+			want:     "WEBVTT\n\n1\n00:00:00.000 --> 00:01:00.000\nVerse -> Chorus\n",
+		},
+		{
+			name:     "Markup in titles is escaped",
+			chapters: []model.Chapter{{StartTime: 0, Title: "Q&A <intro>"}},
+			duration: 60,
+			want:     "WEBVTT\n\n1\n00:00:00.000 --> 00:01:00.000\nQ&amp;A &lt;intro>\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, chaptersVTT(tt.chapters, tt.duration), "Response doesn't match expected")
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+type collections struct {
+	assets    Assets
+	videos    Videos
+	playlists Playlists
+	logger    *logrus.Logger
+}
+
+// Collections returns the usecase implementation
+func Collections(
+	a Assets,
+	v Videos,
+	p Playlists,
+	l *logrus.Logger,
+) collections {
+	return collections{
+		assets:    a,
+		videos:    v,
+		playlists: p,
+		logger:    l,
+	}
+}
+
+// Create method
+func (u collections) Create(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	if len(anyPlaylist.Title) == 0 {
+		return model.Playlist{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	// Every video in the initial order must exist
+	anyPlaylist.VideoIDs = uniqueIDs(anyPlaylist.VideoIDs)
+	if len(anyPlaylist.VideoIDs) > 0 {
+		for _, id := range anyPlaylist.VideoIDs {
+			if _, err := uuid.Parse(id); err != nil {
+				return model.Playlist{}, errorcodes.ErrVideoUnprocessable
+			}
+		}
+
+		videos, err := u.videos.ListByIDs(ctx, anyPlaylist.VideoIDs)
+		if err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return model.Playlist{}, err
+		}
+		if len(videos) != len(anyPlaylist.VideoIDs) {
+			return model.Playlist{}, errorcodes.ErrVideoUnprocessable
+		}
+	}
+
+	response, err := u.playlists.CreatePlaylist(ctx, anyPlaylist)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Playlist{}, err
+	}
+
+	return response, nil
+}
+
+// GetByID method returns the playlist with its videos in order, ready to play
+func (u collections) GetByID(ctx context.Context, id string) (model.Playlist, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Playlist{}, errorcodes.ErrInvalidID
+	}
+
+	response, err := u.playlists.GetPlaylist(ctx, id)
+	if err != nil {
+		if err != errorcodes.ErrPlaylistNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.Playlist{}, err
+	}
+
+	if len(response.VideoIDs) == 0 {
+		return response, nil
+	}
+
+	videos, err := u.videos.ListByIDs(ctx, response.VideoIDs)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Playlist{}, err
+	}
+
+	byID := make(map[string]model.Video, len(videos))
+	for _, video := range videos {
+		byID[video.ID] = video
+	}
+
+	// Trashed or deleted videos are skipped, their IDs stay in the order
+	for _, videoID := range response.VideoIDs {
+		if video, ok := byID[videoID]; ok {
+			response.Videos = append(response.Videos, hydrate(ctx, u.assets, u.logger, video, 0))
+		}
+	}
+
+	return response, nil
+}
+
+// List method
+func (u collections) List(ctx context.Context, page, limit int) ([]model.Playlist, error) {
+	playlists, err := u.playlists.ListPlaylists(ctx, page, limit)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return nil, err
+	}
+	return playlists, nil
+}
+
+// Update method replaces the title and description of a playlist
+func (u collections) Update(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(anyPlaylist.ID); err != nil {
+		return model.Playlist{}, errorcodes.ErrInvalidID
+	}
+
+	if len(anyPlaylist.Title) == 0 {
+		return model.Playlist{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	response, err := u.playlists.UpdatePlaylist(ctx, anyPlaylist)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Playlist{}, err
+	}
+
+	return response, nil
+}
+
+// Delete method removes a playlist, the videos are kept
+func (u collections) Delete(ctx context.Context, id string) error {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return errorcodes.ErrInvalidID
+	}
+
+	if err := u.playlists.DeletePlaylist(ctx, id); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// AddVideo method places a video at the position of a playlist, a negative or
+// out of range position appends it. A video already in the playlist is moved.
+// The order is changed in a single update, so concurrent edits are all kept.
+func (u collections) AddVideo(ctx context.Context, id, videoID string, position int) (model.Playlist, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Playlist{}, errorcodes.ErrInvalidID
+	}
+	if _, err := uuid.Parse(videoID); err != nil {
+		return model.Playlist{}, errorcodes.ErrInvalidID
+	}
+
+	if _, err := u.videos.GetByID(ctx, videoID); err != nil {
+		if err != errorcodes.ErrVideoNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.Playlist{}, err
+	}
+
+	response, err := u.playlists.InsertPlaylistVideo(ctx, id, videoID, position)
+	if err != nil {
+		if err != errorcodes.ErrPlaylistNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.Playlist{}, err
+	}
+
+	return response, nil
+}
+
+// RemoveVideo method takes a video out of a playlist
+func (u collections) RemoveVideo(ctx context.Context, id, videoID string) (model.Playlist, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Playlist{}, errorcodes.ErrInvalidID
+	}
+	if _, err := uuid.Parse(videoID); err != nil {
+		return model.Playlist{}, errorcodes.ErrInvalidID
+	}
+
+	response, err := u.playlists.RemovePlaylistVideo(ctx, id, videoID)
+	if err != nil {
+		if err != errorcodes.ErrPlaylistNotFound && err != errorcodes.ErrVideoNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.Playlist{}, err
+	}
+
+	return response, nil
+}
+
+// uniqueIDs removes the repeated IDs, keeping the first occurrence
+func uniqueIDs(ids []string) []string {
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	playlistID = "6f1c1ba0-2f3b-4e8e-9c1a-1b5f0e4d7a11"
+	firstID    = "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	secondID   = "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	thirdID    = "0b8a3c1e-7d4f-4c5a-9e2b-3f6d8a1c5e70"
+)
+
+// TestCollections tests the Collections constructor function
+func TestCollections(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	assets := NewMockAssets(t)
+	videos := NewMockVideos(t)
+	playlists := NewMockPlaylists(t)
+
+	usecase := Collections(assets, videos, playlists, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, assets, usecase.assets)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, playlists, usecase.playlists)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestCollections_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		playlist model.Playlist
+		listIDs  []string
+		stored   []model.Video
+		created  *model.Playlist
+		err      error
+	}{
+		{
+			name:     "Empty playlist",
+			playlist: model.Playlist{Title: "Morning Glory"},
+			created:  &model.Playlist{Title: "Morning Glory", VideoIDs: []string{}},
+		},
+		{
+			name:     "Repeated videos are removed",
+			playlist: model.Playlist{Title: "Morning Glory", VideoIDs: []string{firstID, secondID, firstID}},
+			listIDs:  []string{firstID, secondID},
+			stored:   []model.Video{{ID: firstID}, {ID: secondID}},
+			created:  &model.Playlist{Title: "Morning Glory", VideoIDs: []string{firstID, secondID}},
+		},
+		{
+			name:     "Missing video",
+			playlist: model.Playlist{Title: "Morning Glory", VideoIDs: []string{firstID, secondID}},
+			listIDs:  []string{firstID, secondID},
+			stored:   []model.Video{{ID: firstID}},
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:     "Invalid video ID",
+			playlist: model.Playlist{Title: "Morning Glory", VideoIDs: []string{"invalid"}},
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:     "Missing title",
+			playlist: model.Playlist{},
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			playlists := NewMockPlaylists(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &collections{NewMockAssets(t), videos, playlists, testLogger}
+			ctx := context.Background()
+
+			if tt.listIDs != nil {
+				videos.On("ListByIDs", ctx, tt.listIDs).Return(tt.stored, nil)
+			}
+
+			var want model.Playlist
+			if tt.created != nil {
+				want = *tt.created
+				want.ID = playlistID
+				playlists.On("CreatePlaylist", ctx, *tt.created).Return(want, nil)
+			}
+
+			got, err := usecase.Create(ctx, tt.playlist)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestCollections_GetByID(t *testing.T) {
+	playbackAsset := &model.Asset{ID: "asset", PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "public"}}}
+	hydrated := model.Asset{Poster: "poster.jpg", Thumbnail: "thumbnail.jpg", Sources: []model.Source{{Source: "video.m3u8"}}}
+
+	t.Run("Videos are hydrated in playlist order", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		playlists := NewMockPlaylists(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &collections{assets, videos, playlists, testLogger}
+		ctx := context.Background()
+
+		order := []string{secondID, thirdID, firstID}
+		playlists.On("GetPlaylist", ctx, playlistID).Return(model.Playlist{ID: playlistID, VideoIDs: order}, nil)
+		// The third video was trashed, so it is not returned
+		videos.On("ListByIDs", ctx, order).Return([]model.Video{
+			{ID: firstID, Title: "First"},
+			{ID: secondID, Title: "Second", Asset: playbackAsset},
+		}, nil)
+		assets.On("Hydrate", ctx, *playbackAsset, model.HydrateOptions{}).Return(hydrated, nil)
+
+		got, err := usecase.GetByID(ctx, playlistID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, order, got.VideoIDs)
+		assert.Equal(t, []model.Video{
+			{
+				ID:        secondID,
+				Title:     "Second",
+				Asset:     playbackAsset,
+				Poster:    "poster.jpg",
+				Thumbnail: "thumbnail.jpg",
+				Sources:   []model.Source{{Source: "video.m3u8"}},
+			},
+			{ID: firstID, Title: "First"},
+		}, got.Videos)
+	})
+
+	t.Run("Empty playlist", func(t *testing.T) {
+		playlists := NewMockPlaylists(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &collections{NewMockAssets(t), NewMockVideos(t), playlists, testLogger}
+		ctx := context.Background()
+
+		playlists.On("GetPlaylist", ctx, playlistID).Return(model.Playlist{ID: playlistID, VideoIDs: []string{}}, nil)
+
+		got, err := usecase.GetByID(ctx, playlistID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, got.Videos)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		playlists := NewMockPlaylists(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &collections{NewMockAssets(t), NewMockVideos(t), playlists, testLogger}
+		ctx := context.Background()
+
+		playlists.On("GetPlaylist", ctx, playlistID).Return(model.Playlist{}, errorcodes.ErrPlaylistNotFound)
+
+		_, err := usecase.GetByID(ctx, playlistID)
+
+		assert.Equal(t, errorcodes.ErrPlaylistNotFound, err)
+	})
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &collections{NewMockAssets(t), NewMockVideos(t), NewMockPlaylists(t), testLogger}
+
+		_, err := usecase.GetByID(context.Background(), "invalid")
+
+		assert.Equal(t, errorcodes.ErrInvalidID, err)
+	})
+}
+
+func TestCollections_AddVideo(t *testing.T) {
+	tests := []struct {
+		name        string
+		videoID     string
+		position    int
+		videoErr    error
+		playlistErr error
+		want        []string
+		err         error
+	}{
+		{"Append", thirdID, -1, nil, nil, []string{firstID, secondID, thirdID}, nil},
+		{"Move", firstID, 1, nil, nil, []string{secondID, firstID, thirdID}, nil},
+		{"Unknown video", thirdID, -1, errorcodes.ErrVideoNotFound, nil, nil, errorcodes.ErrVideoNotFound},
+		{"Unknown playlist", thirdID, 0, nil, errorcodes.ErrPlaylistNotFound, nil, errorcodes.ErrPlaylistNotFound},
+		{"Invalid video ID", "invalid", -1, nil, nil, nil, errorcodes.ErrInvalidID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			playlists := NewMockPlaylists(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &collections{NewMockAssets(t), videos, playlists, testLogger}
+			ctx := context.Background()
+
+			if tt.videoID != "invalid" {
+				videos.On("GetByID", ctx, tt.videoID).Return(model.Video{ID: tt.videoID}, tt.videoErr)
+			}
+			if tt.videoErr == nil && tt.videoID != "invalid" {
+				playlists.On("InsertPlaylistVideo", ctx, playlistID, tt.videoID, tt.position).Return(model.Playlist{ID: playlistID, VideoIDs: tt.want}, tt.playlistErr)
+			}
+
+			got, err := usecase.AddVideo(ctx, playlistID, tt.videoID, tt.position)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.want, got.VideoIDs)
+		})
+	}
+}
+
+func TestCollections_RemoveVideo(t *testing.T) {
+	tests := []struct {
+		name        string
+		videoID     string
+		playlistErr error
+		want        []string
+		err         error
+	}{
+		{"Success", secondID, nil, []string{firstID, thirdID}, nil},
+		{"Not in playlist", thirdID, errorcodes.ErrVideoNotFound, nil, errorcodes.ErrVideoNotFound},
+		{"Internal error", thirdID, assert.AnError, nil, assert.AnError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playlists := NewMockPlaylists(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &collections{NewMockAssets(t), NewMockVideos(t), playlists, testLogger}
+			ctx := context.Background()
+
+			playlists.On("RemovePlaylistVideo", ctx, playlistID, tt.videoID).Return(model.Playlist{ID: playlistID, VideoIDs: tt.want}, tt.playlistErr)
+
+			got, err := usecase.RemoveVideo(ctx, playlistID, tt.videoID)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.want, got.VideoIDs)
+		})
+	}
+}
+
+func TestCollections_UpdateListDelete(t *testing.T) {
+	testLogger := logrus.New()
+	testLogger.Out = io.Discard
+	ctx := context.Background()
+
+	t.Run("Update", func(t *testing.T) {
+		playlists := NewMockPlaylists(t)
+		usecase := &collections{NewMockAssets(t), NewMockVideos(t), playlists, testLogger}
+
+		playlist := model.Playlist{ID: playlistID, Title: "Be Here Now"}
+		playlists.On("UpdatePlaylist", ctx, playlist).Return(playlist, nil)
+
+		got, err := usecase.Update(ctx, playlist)
+
+		assert.NoError(t, err)
+		assert.Equal(t, playlist, got)
+
+		_, err = usecase.Update(ctx, model.Playlist{ID: playlistID})
+		assert.Equal(t, errorcodes.ErrVideoUnprocessable, err)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		playlists := NewMockPlaylists(t)
+		usecase := &collections{NewMockAssets(t), NewMockVideos(t), playlists, testLogger}
+
+		playlists.On("ListPlaylists", ctx, 1, 10).Return(nil, errors.New("db error"))
+
+		_, err := usecase.List(ctx, 1, 10)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		playlists := NewMockPlaylists(t)
+		usecase := &collections{NewMockAssets(t), NewMockVideos(t), playlists, testLogger}
+
+		playlists.On("DeletePlaylist", ctx, playlistID).Return(errorcodes.ErrPlaylistNotFound)
+
+		assert.Equal(t, errorcodes.ErrPlaylistNotFound, usecase.Delete(ctx, playlistID))
+		assert.Equal(t, errorcodes.ErrInvalidID, usecase.Delete(ctx, "invalid"))
+	})
+}
@@ -2,6 +2,9 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -10,6 +13,22 @@ import (
 	"github.com/javiertlopez/idlemux/model"
 )
 
+const (
+	minQueryLength     = 2     // shortest search query, in characters
+	maxQueryLength     = 100   // longest search query, in characters
+	defaultSearchLimit = 10    // search results when no limit is given
+	maxSearchLimit     = 50    // most search results returned
+	snippetWidth       = 160   // description snippet length, in characters
+	maxViewerID        = 255   // longest viewer ID bound to a token, in bytes
+	maxTokenClaims     = 20    // most custom claims in a viewer token
+	maxImageSize       = 3840  // widest and tallest image, in pixels
+	maxGIFSize         = 640   // widest and tallest animated GIF, in pixels
+	defaultImageFormat = "jpg" // format of an image when none is given
+)
+
+// imageFormats are the formats of the images of a video, gif is animated
+var imageFormats = map[string]bool{"jpg": true, "png": true, "webp": true, "gif": true}
+
 type delivery struct {
 	assets Assets
 	videos Videos
@@ -29,8 +48,8 @@ func Delivery(
 	}
 }
 
-// GetByID methods
-func (u delivery) GetByID(ctx context.Context, id string) (model.Video, error) {
+// GetByID methods, a non zero ttl requests the lifetime of the signed tokens
+func (u delivery) GetByID(ctx context.Context, id string, ttl time.Duration) (model.Video, error) {
 	// Validate UUID format
 	if _, err := uuid.Parse(id); err != nil {
 		return model.Video{}, errorcodes.ErrInvalidID
@@ -44,27 +63,255 @@ func (u delivery) GetByID(ctx context.Context, id string) (model.Video, error) {
 	}
 
 	// If video document contains an Asset ID, retrieve the information
-	if response.Asset != nil {
-		asset, err := u.assets.GetByID(ctx, response.Asset.ID)
-		if err != nil {
-			u.logger.WithError(err).Error(err.Error())
-			return response, nil
-		}
+	return hydrate(ctx, u.assets, u.logger, response, ttl), nil
+}
+
+// ChaptersVTT returns the chapters of a video as a WebVTT file
+func (u delivery) ChaptersVTT(ctx context.Context, id string) (string, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return "", errorcodes.ErrInvalidID
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return "", err
+	}
+
+	if video.Asset == nil {
+		return "", errorcodes.ErrNoPlayback
+	}
 
-		response.Poster = asset.Poster
-		response.Thumbnail = asset.Thumbnail
-		response.Sources = asset.Sources
+	// The last chapter ends with the video, its duration comes with the ready asset
+	if len(video.Chapters) > 0 && video.Duration <= 0 {
+		return "", errorcodes.ErrAssetNotReady
 	}
 
-	return response, nil
+	return chaptersVTT(video.Chapters, video.Duration), nil
+}
+
+// PlaybackToken issues fresh signed tokens of a video for a viewer
+func (u delivery) PlaybackToken(ctx context.Context, id string, request model.TokenRequest) (model.PlaybackToken, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return model.PlaybackToken{}, errorcodes.ErrInvalidID
+	}
+
+	if request.TTL < 0 || len(request.ViewerID) > maxViewerID || len(request.Claims) > maxTokenClaims {
+		return model.PlaybackToken{}, errorcodes.ErrInvalidTokenRequest
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.PlaybackToken{}, err
+	}
+
+	// Nothing can be played yet, whatever the policy
+	if video.Asset == nil {
+		return model.PlaybackToken{}, errorcodes.ErrNoPlayback
+	}
+
+	asset, err := assetOf(ctx, u.assets, video)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.PlaybackToken{}, err
+	}
+
+	return u.assets.Token(ctx, asset, request)
+}
+
+// Images returns the URL of an image of a video, signed for signed videos.
+// The poster time of the video is used when no time is given.
+func (u delivery) Images(ctx context.Context, id string, opts model.ImageOptions) (model.Image, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Image{}, errorcodes.ErrInvalidID
+	}
+
+	if len(opts.Format) == 0 {
+		opts.Format = defaultImageFormat
+	}
+	if err := validateImageOptions(opts); err != nil {
+		return model.Image{}, err
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Image{}, err
+	}
+
+	if video.Asset == nil {
+		return model.Image{}, errorcodes.ErrNoPlayback
+	}
+
+	if opts.Time == nil {
+		opts.Time = video.PosterTime
+	}
+
+	asset, err := assetOf(ctx, u.assets, video)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Image{}, err
+	}
+
+	return u.assets.Image(ctx, asset, opts)
+}
+
+// validateImageOptions checks the format, size and time of an image
+func validateImageOptions(opts model.ImageOptions) error {
+	if !imageFormats[opts.Format] {
+		return errorcodes.ErrInvalidImageOptions
+	}
+
+	maxSize := maxImageSize
+	if opts.Format == "gif" {
+		maxSize = maxGIFSize
+	}
+	if opts.Width < 0 || opts.Width > maxSize || opts.Height < 0 || opts.Height > maxSize {
+		return errorcodes.ErrInvalidImageOptions
+	}
+
+	if opts.Time != nil && *opts.Time < 0 {
+		return errorcodes.ErrInvalidImageOptions
+	}
+
+	return nil
 }
 
 // List method
-func (u delivery) List(ctx context.Context, page, limit int) ([]model.Video, error) {
-	videos, err := u.videos.List(ctx, page, limit)
+func (u delivery) List(ctx context.Context, opts model.ListOptions) (model.VideoList, error) {
+	opts.Tags = normalizeTags(opts.Tags)
+	if err := validateListOptions(opts); err != nil {
+		return model.VideoList{}, err
+	}
+
+	list, err := u.videos.List(ctx, opts)
+	if err != nil {
+		if err != errorcodes.ErrInvalidCursor {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.VideoList{}, err
+	}
+	return list, nil
+}
+
+// ListTrash method
+func (u delivery) ListTrash(ctx context.Context, page, limit int) ([]model.Video, error) {
+	videos, err := u.videos.ListTrash(ctx, page, limit)
 	if err != nil {
 		u.logger.WithError(err).Error(err.Error())
 		return nil, err
 	}
 	return videos, nil
 }
+
+// ListClips method returns a page of the clips cut from a video, newest
+// first, read after the cursor like the video list
+func (u delivery) ListClips(ctx context.Context, id, cursor string, limit int) (model.VideoList, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return model.VideoList{}, errorcodes.ErrInvalidID
+	}
+
+	// The parent is checked so an unknown video is not an empty list
+	if _, err := u.videos.GetByID(ctx, id); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.VideoList{}, err
+	}
+
+	list, err := u.videos.List(ctx, model.ListOptions{
+		Cursor:   cursor,
+		Limit:    limit,
+		Sort:     "-created_at",
+		MasterID: id,
+	})
+	if err != nil {
+		if err != errorcodes.ErrInvalidCursor {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.VideoList{}, err
+	}
+
+	for i, clip := range list.Items {
+		list.Items[i] = hydrate(ctx, u.assets, u.logger, clip, 0)
+	}
+
+	return list, nil
+}
+
+// Search method returns the videos matching the query, most relevant first
+func (u delivery) Search(ctx context.Context, query string, limit int) (model.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if n := utf8.RuneCountInString(query); n < minQueryLength || n > maxQueryLength {
+		return model.SearchResult{}, errorcodes.ErrInvalidQuery
+	}
+
+	if limit < 1 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	hits, err := u.videos.Search(ctx, query, limit)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.SearchResult{}, err
+	}
+
+	if hits == nil {
+		hits = []model.SearchHit{}
+	}
+
+	terms := searchTerms(query)
+	for i, hit := range hits {
+		highlights := map[string]string{}
+		if title := highlight(hit.Video.Title, terms, 0); title != "" {
+			highlights["title"] = title
+		}
+		if description := highlight(hit.Video.Description, terms, snippetWidth); description != "" {
+			highlights["description"] = description
+		}
+		if len(highlights) > 0 {
+			hits[i].Highlights = highlights
+		}
+	}
+
+	return model.SearchResult{Query: query, Items: hits}, nil
+}
+
+// validateListOptions checks the sort and filters of a listing
+func validateListOptions(opts model.ListOptions) error {
+	switch strings.TrimPrefix(opts.Sort, "-") {
+	case "", "created_at", "updated_at", "title", "duration":
+	default:
+		return errorcodes.ErrInvalidListOptions
+	}
+
+	switch opts.Policy {
+	case "", "public", "signed":
+	default:
+		return errorcodes.ErrInvalidListOptions
+	}
+
+	switch opts.Status {
+	case "", "preparing", "ready", "errored", "deleted":
+	default:
+		return errorcodes.ErrInvalidListOptions
+	}
+
+	if !opts.CreatedAfter.IsZero() && !opts.CreatedBefore.IsZero() && !opts.CreatedAfter.Before(opts.CreatedBefore) {
+		return errorcodes.ErrInvalidListOptions
+	}
+
+	if validateTags(opts.Tags) != nil || len(opts.Metadata) > maxMetadataKeys {
+		return errorcodes.ErrInvalidListOptions
+	}
+
+	for key := range opts.Metadata {
+		if !metadataKey.MatchString(key) {
+			return errorcodes.ErrInvalidListOptions
+		}
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -33,10 +35,15 @@ func TestDelivery_GetByID(t *testing.T) {
 	logger.Out = io.Discard
 
 	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	posterTime := 12.5
 	asset := model.Asset{
 		ID:        uuid,
 		Poster:    "https://image.mux.com/5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg/thumbnail.png?width=1920\u0026height=1080\u0026smart_crop=true\u0026time=7",
 		Thumbnail: "https://image.mux.com/5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg/thumbnail.png?width=640\u0026height=360\u0026smart_crop=true\u0026time=7",
+		Previews: &model.Previews{
+			GIF:           "https://image.mux.com/5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg/animated.gif",
+			StoryboardVTT: "https://image.mux.com/5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg/storyboard.vtt",
+		},
 		Sources: []model.Source{
 			{
 				Source: "https://stream.mux.com/5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg.m3u8",
@@ -48,6 +55,7 @@ func TestDelivery_GetByID(t *testing.T) {
 	type args struct {
 		ctx context.Context
 		id  string
+		ttl time.Duration
 	}
 
 	type mockReturns struct {
@@ -145,6 +153,138 @@ func TestDelivery_GetByID(t *testing.T) {
 				},
 				Poster:    asset.Poster,
 				Thumbnail: asset.Thumbnail,
+				Previews:  asset.Previews,
+				Sources:   asset.Sources,
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "With stored playback ID",
+			args: args{
+				ctx: context.Background(),
+				id:  uuid,
+			},
+			mocks: mockReturns{
+				assetResp: asset,
+				assetErr:  nil,
+				videoResp: model.Video{
+					ID: uuid,
+					Asset: &model.Asset{
+						ID:          uuid,
+						PlaybackIDs: []model.PlaybackID{{ID: "5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg", Policy: "public"}},
+					},
+				},
+				videoErr: nil,
+			},
+			want: model.Video{
+				ID: uuid,
+				Asset: &model.Asset{
+					ID:          uuid,
+					PlaybackIDs: []model.PlaybackID{{ID: "5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg", Policy: "public"}},
+				},
+				Poster:    asset.Poster,
+				Thumbnail: asset.Thumbnail,
+				Previews:  asset.Previews,
+				Sources:   asset.Sources,
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "With requested token TTL",
+			args: args{
+				ctx: context.Background(),
+				id:  uuid,
+				ttl: time.Hour,
+			},
+			mocks: mockReturns{
+				assetResp: asset,
+				assetErr:  nil,
+				videoResp: model.Video{
+					ID: uuid,
+					Asset: &model.Asset{
+						ID: uuid,
+					},
+				},
+				videoErr: nil,
+			},
+			want: model.Video{
+				ID: uuid,
+				Asset: &model.Asset{
+					ID: uuid,
+				},
+				Poster:    asset.Poster,
+				Thumbnail: asset.Thumbnail,
+				Previews:  asset.Previews,
+				Sources:   asset.Sources,
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "With poster time",
+			args: args{
+				ctx: context.Background(),
+				id:  uuid,
+			},
+			mocks: mockReturns{
+				assetResp: asset,
+				assetErr:  nil,
+				videoResp: model.Video{
+					ID:         uuid,
+					PosterTime: &posterTime,
+					Asset: &model.Asset{
+						ID:          uuid,
+						PlaybackIDs: []model.PlaybackID{{ID: "5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg", Policy: "public"}},
+					},
+				},
+				videoErr: nil,
+			},
+			want: model.Video{
+				ID:         uuid,
+				PosterTime: &posterTime,
+				Asset: &model.Asset{
+					ID:          uuid,
+					PlaybackIDs: []model.PlaybackID{{ID: "5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg", Policy: "public"}},
+				},
+				Poster:    asset.Poster,
+				Thumbnail: asset.Thumbnail,
+				Previews:  asset.Previews,
+				Sources:   asset.Sources,
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "With downloads allowed",
+			args: args{
+				ctx: context.Background(),
+				id:  uuid,
+			},
+			mocks: mockReturns{
+				assetResp: asset,
+				assetErr:  nil,
+				videoResp: model.Video{
+					ID:            uuid,
+					AllowDownload: true,
+					Asset: &model.Asset{
+						ID:          uuid,
+						PlaybackIDs: []model.PlaybackID{{ID: "5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg", Policy: "public"}},
+					},
+				},
+				videoErr: nil,
+			},
+			want: model.Video{
+				ID:            uuid,
+				AllowDownload: true,
+				Asset: &model.Asset{
+					ID:          uuid,
+					PlaybackIDs: []model.PlaybackID{{ID: "5iNFJg9dIww2AgUryhgghbP00Dc4ogoxn00gzitOdjICg", Policy: "public"}},
+				},
+				Poster:    asset.Poster,
+				Thumbnail: asset.Thumbnail,
+				Previews:  asset.Previews,
 				Sources:   asset.Sources,
 			},
 			wantErr: false,
@@ -216,15 +356,22 @@ func TestDelivery_GetByID(t *testing.T) {
 				// Set up asset expectations for cases with assets
 				if tt.mocks.videoResp.Asset != nil {
 					// Only set up GetByID expectation if the Asset ID is not empty
-					if tt.mocks.videoResp.Asset.ID != "" {
+					if len(tt.mocks.videoResp.Asset.PlaybackIDs) > 0 {
+						// Stored playback IDs are hydrated without calling Mux.com
+						assets.On("Hydrate", tt.args.ctx, *tt.mocks.videoResp.Asset, model.HydrateOptions{TokenTTL: tt.args.ttl, PosterTime: tt.mocks.videoResp.PosterTime, Downloads: tt.mocks.videoResp.AllowDownload}).Return(tt.mocks.assetResp, tt.mocks.assetErr)
+					} else if tt.mocks.videoResp.Asset.ID != "" {
 						assets.On("GetByID", tt.args.ctx, tt.mocks.videoResp.Asset.ID).Return(tt.mocks.assetResp, tt.mocks.assetErr)
+						if (tt.args.ttl > 0 || tt.mocks.videoResp.PosterTime != nil || tt.mocks.videoResp.AllowDownload) && tt.mocks.assetErr == nil {
+							// URLs are signed again with the requested lifetime
+							assets.On("Hydrate", tt.args.ctx, tt.mocks.assetResp, model.HydrateOptions{TokenTTL: tt.args.ttl, PosterTime: tt.mocks.videoResp.PosterTime, Downloads: tt.mocks.videoResp.AllowDownload}).Return(tt.mocks.assetResp, nil)
+						}
 					}
 					// Note: If the Asset ID is empty, the code shouldn't call GetByID
 				}
 			}
 			// Don't use AssertNotCalled here - it will be checked before the function is executed
 
-			got, err := usecase.GetByID(tt.args.ctx, tt.args.id)
+			got, err := usecase.GetByID(tt.args.ctx, tt.args.id, tt.args.ttl)
 
 			// For invalid ID cases, verify we don't call the mocks at all
 			if tt.name == "With invalid ID" {
@@ -248,7 +395,7 @@ func TestDelivery_GetByID(t *testing.T) {
 				assert.Equal(t, tt.want.Asset.ID, got.Asset.ID, "Asset ID doesn't match")
 
 				// If we expect populated assets, verify those fields too
-				if tt.name == "With asset" {
+				if tt.name == "With asset" || tt.name == "With stored playback ID" {
 					assert.Equal(t, tt.want.Poster, got.Poster, "Poster field doesn't match")
 					assert.Equal(t, tt.want.Thumbnail, got.Thumbnail, "Thumbnail field doesn't match")
 					assert.Equal(t, tt.want.Sources, got.Sources, "Sources field doesn't match")
@@ -258,83 +405,584 @@ func TestDelivery_GetByID(t *testing.T) {
 	}
 }
 
-func TestDelivery_List(t *testing.T) {
+func TestDelivery_PlaybackToken(t *testing.T) {
 	logger := logrus.New()
 	logger.Out = io.Discard
 
-	videosList := []model.Video{
-		{ID: "id1", Title: "Video 1"},
-		{ID: "id2", Title: "Video 2"},
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	signed := model.Asset{ID: "asset", PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "signed"}}}
+	token := model.PlaybackToken{PlaybackID: "playback", Playback: "v-token", Thumbnail: "t-token", Storyboard: "s-token"}
+	request := model.TokenRequest{ViewerID: "viewer", TTL: 600}
+
+	t.Run("Signs the stored playback ID", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		usecase := &delivery{assets, videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid, Asset: &signed}, nil)
+		assets.On("Token", ctx, signed, request).Return(token, nil)
+
+		got, err := usecase.PlaybackToken(ctx, uuid, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, token, got)
+	})
+
+	t.Run("Retrieves the playback ID from Mux.com", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		usecase := &delivery{assets, videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid, Asset: &model.Asset{ID: "asset"}}, nil)
+		assets.On("GetByID", ctx, "asset").Return(signed, nil)
+		assets.On("Token", ctx, signed, request).Return(token, nil)
+
+		got, err := usecase.PlaybackToken(ctx, uuid, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, token, got)
+	})
+
+	t.Run("Video without asset", func(t *testing.T) {
+		videos := NewMockVideos(t)
+		usecase := &delivery{NewMockAssets(t), videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid}, nil)
+
+		_, err := usecase.PlaybackToken(ctx, uuid, request)
+
+		assert.Equal(t, errorcodes.ErrNoPlayback, err)
+	})
+
+	t.Run("Video not found", func(t *testing.T) {
+		videos := NewMockVideos(t)
+		usecase := &delivery{NewMockAssets(t), videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+
+		_, err := usecase.PlaybackToken(ctx, uuid, request)
+
+		assert.Equal(t, errorcodes.ErrVideoNotFound, err)
+	})
+
+	invalid := []struct {
+		name    string
+		id      string
+		request model.TokenRequest
+		err     error
+	}{
+		{"Invalid ID", "invalid", request, errorcodes.ErrInvalidID},
+		{"Negative TTL", uuid, model.TokenRequest{TTL: -1}, errorcodes.ErrInvalidTokenRequest},
+		{"Long viewer ID", uuid, model.TokenRequest{ViewerID: strings.Repeat("v", maxViewerID+1)}, errorcodes.ErrInvalidTokenRequest},
 	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := &delivery{NewMockAssets(t), NewMockVideos(t), logger}
 
-	type args struct {
-		ctx   context.Context
-		page  int
-		limit int
+			_, err := usecase.PlaybackToken(context.Background(), tt.id, tt.request)
+
+			assert.Equal(t, tt.err, err)
+		})
 	}
+}
 
-	type mockReturns struct {
-		videosResp []model.Video
-		videosErr  error
+func TestDelivery_Images(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	posterTime, frame, negative := 12.5, 30.0, -1.0
+	signed := model.Asset{ID: "asset", PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "signed"}}}
+	image := model.Image{URL: "https://image.mux.com/playback/thumbnail.jpg?token=t-token", Format: "jpg"}
+
+	t.Run("Uses the poster time of the video", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		usecase := &delivery{assets, videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid, PosterTime: &posterTime, Asset: &signed}, nil)
+		assets.On("Image", ctx, signed, model.ImageOptions{Width: 320, Time: &posterTime, Format: "jpg"}).Return(image, nil)
+
+		got, err := usecase.Images(ctx, uuid, model.ImageOptions{Width: 320})
+
+		assert.NoError(t, err)
+		assert.Equal(t, image, got)
+	})
+
+	t.Run("Retrieves the playback ID from Mux.com", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		usecase := &delivery{assets, videos, logger}
+		ctx := context.Background()
+
+		opts := model.ImageOptions{Width: 480, Height: 270, Time: &frame, Format: "gif"}
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid, PosterTime: &posterTime, Asset: &model.Asset{ID: "asset"}}, nil)
+		assets.On("GetByID", ctx, "asset").Return(signed, nil)
+		assets.On("Image", ctx, signed, opts).Return(image, nil)
+
+		got, err := usecase.Images(ctx, uuid, opts)
+
+		assert.NoError(t, err)
+		assert.Equal(t, image, got)
+	})
+
+	t.Run("Video without asset", func(t *testing.T) {
+		videos := NewMockVideos(t)
+		usecase := &delivery{NewMockAssets(t), videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid}, nil)
+
+		_, err := usecase.Images(ctx, uuid, model.ImageOptions{})
+
+		assert.Equal(t, errorcodes.ErrNoPlayback, err)
+	})
+
+	invalid := []struct {
+		name string
+		id   string
+		opts model.ImageOptions
+		err  error
+	}{
+		{"Invalid ID", "invalid", model.ImageOptions{}, errorcodes.ErrInvalidID},
+		{"Unknown format", uuid, model.ImageOptions{Format: "bmp"}, errorcodes.ErrInvalidImageOptions},
+		{"Too wide", uuid, model.ImageOptions{Width: maxImageSize + 1}, errorcodes.ErrInvalidImageOptions},
+		{"GIF too wide", uuid, model.ImageOptions{Width: maxGIFSize + 1, Format: "gif"}, errorcodes.ErrInvalidImageOptions},
+		{"Negative height", uuid, model.ImageOptions{Height: -1}, errorcodes.ErrInvalidImageOptions},
+		{"Negative time", uuid, model.ImageOptions{Time: &negative}, errorcodes.ErrInvalidImageOptions},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := &delivery{NewMockAssets(t), NewMockVideos(t), logger}
+
+			_, err := usecase.Images(context.Background(), tt.id, tt.opts)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDelivery_GetByIDWithChapters(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	frame := 95.0
+	signed := model.Asset{ID: "asset", Duration: 120, PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "signed"}}}
+	chapters := []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 90, Title: "Chorus", ThumbnailTime: &frame}}
+	withThumbnails := []model.Chapter{
+		{StartTime: 0, Title: "Intro", Thumbnail: "https://image.mux.com/playback/thumbnail.png?token=intro"},
+		{StartTime: 90, Title: "Chorus", ThumbnailTime: &frame, Thumbnail: "https://image.mux.com/playback/thumbnail.png?token=chorus"},
+	}
+
+	t.Run("Chapter thumbnails are signed", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		usecase := &delivery{assets, videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid, Asset: &signed, Chapters: chapters}, nil)
+		assets.On("Hydrate", ctx, signed, model.HydrateOptions{}).Return(signed, nil)
+		assets.On("Chapters", ctx, signed, chapters, model.HydrateOptions{}).Return(withThumbnails, nil)
+
+		got, err := usecase.GetByID(ctx, uuid, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, withThumbnails, got.Chapters)
+	})
+
+	t.Run("Signing error keeps the stored chapters", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		usecase := &delivery{assets, videos, logger}
+		ctx := context.Background()
+
+		videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid, Asset: &signed, Chapters: chapters}, nil)
+		assets.On("Hydrate", ctx, signed, model.HydrateOptions{}).Return(signed, nil)
+		assets.On("Chapters", ctx, signed, chapters, model.HydrateOptions{}).Return(nil, errors.New("signing error"))
+
+		got, err := usecase.GetByID(ctx, uuid, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, chapters, got.Chapters)
+	})
+}
+
+func TestDelivery_ChaptersVTT(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	chapters := []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 90, Title: "Chorus"}}
+	asset := &model.Asset{ID: "asset"}
+
+	tests := []struct {
+		name     string
+		id       string
+		video    model.Video
+		videoErr error
+		want     string
+		err      error
+	}{
+		{"Chapters file", uuid, model.Video{ID: uuid, Asset: asset, Duration: 120, Chapters: chapters}, nil, "WEBVTT\n\n1\n00:00:00.000 --> 00:01:30.000\nIntro\n\n2\n00:01:30.000 --> 00:02:00.000\nChorus\n", nil},
+		{"Video without chapters", uuid, model.Video{ID: uuid, Asset: asset}, nil, "WEBVTT\n", nil},
+		{"Asset not ready", uuid, model.Video{ID: uuid, Asset: asset, Chapters: chapters}, nil, "", errorcodes.ErrAssetNotReady},
+		{"Video without asset", uuid, model.Video{ID: uuid, Chapters: chapters}, nil, "", errorcodes.ErrNoPlayback},
+		{"Not found", uuid, model.Video{}, errorcodes.ErrVideoNotFound, "", errorcodes.ErrVideoNotFound},
+		{"Invalid ID", "invalid", model.Video{}, nil, "", errorcodes.ErrInvalidID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			usecase := &delivery{NewMockAssets(t), videos, logger}
+			ctx := context.Background()
+
+			if tt.id == uuid {
+				videos.On("GetByID", ctx, uuid).Return(tt.video, tt.videoErr)
+			}
+
+			got, err := usecase.ChaptersVTT(ctx, tt.id)
+
+			assert.Equal(t, tt.err, err, "Error doesn't match")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
 	}
+}
+
+func TestDelivery_ListClips(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	uuid := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	asset := model.Asset{ID: "clip-asset", PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "public"}}}
+	hydrated := asset
+	hydrated.Poster = "https://image.mux.com/playback/thumbnail.png"
+	pending := model.Video{ID: "pending", MasterID: uuid, Ingestion: &model.JobStatus{Status: model.JobPending}}
+	ready := model.Video{ID: "ready", MasterID: uuid, Asset: &asset}
+	opts := model.ListOptions{Cursor: "abc", Limit: 10, Sort: "-created_at", MasterID: uuid}
 
 	tests := []struct {
-		name    string
-		args    args
-		mocks   mockReturns
-		want    []model.Video
-		wantErr bool
-		err     error
+		name  string
+		id    string
+		mocks func(ctx context.Context, assets *MockAssets, videos *MockVideos)
+		want  model.VideoList
+		err   error
 	}{
 		{
-			name: "Success",
-			args: args{
-				ctx:   context.Background(),
-				page:  1,
-				limit: 10,
+			name: "Clips are hydrated",
+			id:   uuid,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid}, nil)
+				videos.On("List", ctx, opts).Return(model.VideoList{Items: []model.Video{ready, pending}, NextCursor: "def"}, nil)
+				assets.On("Hydrate", ctx, asset, model.HydrateOptions{}).Return(hydrated, nil)
 			},
-			mocks: mockReturns{
-				videosResp: videosList,
-				videosErr:  nil,
+			want: model.VideoList{
+				Items: []model.Video{
+					{ID: "ready", MasterID: uuid, Asset: &asset, Poster: hydrated.Poster},
+					pending,
+				},
+				NextCursor: "def",
 			},
-			want:    videosList,
-			wantErr: false,
-			err:     nil,
 		},
 		{
-			name: "Error",
-			args: args{
-				ctx:   context.Background(),
-				page:  1,
-				limit: 10,
+			name: "Video without clips",
+			id:   uuid,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid}, nil)
+				videos.On("List", ctx, opts).Return(model.VideoList{Items: []model.Video{}}, nil)
 			},
-			mocks: mockReturns{
-				videosResp: nil,
-				videosErr:  errors.New("db error"),
+			want: model.VideoList{Items: []model.Video{}},
+		},
+		{
+			name: "Repository error",
+			id:   uuid,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid}, nil)
+				videos.On("List", ctx, opts).Return(model.VideoList{}, errors.New("db error"))
 			},
-			want:    nil,
-			wantErr: true,
-			err:     errors.New("db error"),
+			err: errors.New("db error"),
+		},
+		{
+			name: "Invalid cursor",
+			id:   uuid,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, uuid).Return(model.Video{ID: uuid}, nil)
+				videos.On("List", ctx, opts).Return(model.VideoList{}, errorcodes.ErrInvalidCursor)
+			},
+			err: errorcodes.ErrInvalidCursor,
+		},
+		{
+			name: "Not found",
+			id:   uuid,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, uuid).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+			err: errorcodes.ErrVideoNotFound,
+		},
+		{
+			name: "Invalid ID",
+			id:   "invalid",
+			err:  errorcodes.ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+			usecase := &delivery{assets, videos, logger}
+			ctx := context.Background()
+
+			if tt.mocks != nil {
+				tt.mocks(ctx, assets, videos)
+			}
+
+			got, err := usecase.ListClips(ctx, tt.id, "abc", 10)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
+
+func TestDelivery_List(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	list := model.VideoList{
+		Items:      []model.Video{{ID: "id1", Title: "Video 1"}, {ID: "id2", Title: "Video 2"}},
+		NextCursor: "def",
+	}
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		opts    model.ListOptions
+		callDB  bool
+		resp    model.VideoList
+		respErr error
+		want    model.VideoList
+		wantErr error
+	}{
+		{
+			name:   "Success",
+			opts:   model.ListOptions{Cursor: "abc", Limit: 10},
+			callDB: true,
+			resp:   list,
+			want:   list,
+		},
+		{
+			name:   "Newest ready videos",
+			opts:   model.ListOptions{Limit: 10, Sort: "-created_at", Status: "ready", Policy: "signed"},
+			callDB: true,
+			resp:   list,
+			want:   list,
+		},
+		{
+			name:    "Invalid cursor",
+			opts:    model.ListOptions{Cursor: "abc", Limit: 10},
+			callDB:  true,
+			respErr: errorcodes.ErrInvalidCursor,
+			wantErr: errorcodes.ErrInvalidCursor,
+		},
+		{
+			name:    "Error",
+			opts:    model.ListOptions{Page: 1, Limit: 10},
+			callDB:  true,
+			respErr: errors.New("db error"),
+			wantErr: errors.New("db error"),
+		},
+		{
+			name:   "Tags and metadata",
+			opts:   model.ListOptions{Limit: 10, Tags: []string{"britpop"}, Metadata: map[string]string{"campaign": "summer"}},
+			callDB: true,
+			resp:   list,
+			want:   list,
+		},
+		{
+			name:    "Invalid metadata key",
+			opts:    model.ListOptions{Limit: 10, Metadata: map[string]string{"$where": "1"}},
+			wantErr: errorcodes.ErrInvalidListOptions,
+		},
+		{
+			name:    "Unknown sort",
+			opts:    model.ListOptions{Limit: 10, Sort: "-views"},
+			wantErr: errorcodes.ErrInvalidListOptions,
+		},
+		{
+			name:    "Unknown status",
+			opts:    model.ListOptions{Limit: 10, Status: "done"},
+			wantErr: errorcodes.ErrInvalidListOptions,
+		},
+		{
+			name:    "Unknown policy",
+			opts:    model.ListOptions{Limit: 10, Policy: "private"},
+			wantErr: errorcodes.ErrInvalidListOptions,
+		},
+		{
+			name:    "Empty created range",
+			opts:    model.ListOptions{Limit: 10, CreatedAfter: created, CreatedBefore: created},
+			wantErr: errorcodes.ErrInvalidListOptions,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			videos := NewMockVideos(t)
-			assets := NewMockAssets(t)
 			usecase := &delivery{
-				assets,
+				NewMockAssets(t),
+				videos,
+				logger,
+			}
+
+			ctx := context.Background()
+			if tt.callDB {
+				videos.On("List", ctx, tt.opts).Return(tt.resp, tt.respErr)
+			}
+
+			got, err := usecase.List(ctx, tt.opts)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDelivery_Search(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	hit := model.SearchHit{
+		Video: model.Video{ID: "id1", Title: "Some Might Say", Description: "(What's the Story) Morning Glory?"},
+		Score: 1.5,
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		limit     int
+		callDB    bool
+		wantQuery string
+		wantLimit int
+		resp      []model.SearchHit
+		respErr   error
+		want      model.SearchResult
+		wantErr   error
+	}{
+		{
+			name:      "Success",
+			query:     " morning ",
+			callDB:    true,
+			wantQuery: "morning",
+			wantLimit: defaultSearchLimit,
+			resp:      []model.SearchHit{hit},
+			want: model.SearchResult{
+				Query: "morning",
+				Items: []model.SearchHit{{
+					Video:      hit.Video,
+					Score:      1.5,
+					Highlights: map[string]string{"description": "(What&#39;s the Story) <em>Morning</em> Glory?"},
+				}},
+			},
+		},
+		{
+			name:      "Limit is capped",
+			query:     "oasis",
+			limit:     1000,
+			callDB:    true,
+			wantQuery: "oasis",
+			wantLimit: maxSearchLimit,
+			want:      model.SearchResult{Query: "oasis", Items: []model.SearchHit{}},
+		},
+		{
+			name:    "Query too short",
+			query:   " a ",
+			wantErr: errorcodes.ErrInvalidQuery,
+		},
+		{
+			name:    "Query too long",
+			query:   strings.Repeat("a", maxQueryLength+1),
+			wantErr: errorcodes.ErrInvalidQuery,
+		},
+		{
+			name:      "Repository error",
+			query:     "oasis",
+			limit:     5,
+			callDB:    true,
+			wantQuery: "oasis",
+			wantLimit: 5,
+			respErr:   errors.New("db error"),
+			wantErr:   errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			usecase := &delivery{
+				NewMockAssets(t),
+				videos,
+				logger,
+			}
+
+			ctx := context.Background()
+			if tt.callDB {
+				videos.On("Search", ctx, tt.wantQuery, tt.wantLimit).Return(tt.resp, tt.respErr)
+			}
+
+			got, err := usecase.Search(ctx, tt.query, tt.limit)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDelivery_ListTrash(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+
+	trashed := []model.Video{
+		{ID: "id1", Title: "Video 1", DeletedAt: "2025-01-01 00:00:00 +0000 UTC"},
+	}
+
+	tests := []struct {
+		name    string
+		resp    []model.Video
+		respErr error
+		want    []model.Video
+		wantErr bool
+	}{
+		{"Success", trashed, nil, trashed, false},
+		{"Error", nil, errors.New("db error"), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			usecase := &delivery{
+				NewMockAssets(t),
 				videos,
 				logger,
 			}
 
-			videos.On("List", tt.args.ctx, tt.args.page, tt.args.limit).Return(tt.mocks.videosResp, tt.mocks.videosErr)
+			ctx := context.Background()
+			videos.On("ListTrash", ctx, 1, 10).Return(tt.resp, tt.respErr)
 
-			got, err := usecase.List(tt.args.ctx, tt.args.page, tt.args.limit)
+			got, err := usecase.ListTrash(ctx, 1, 10)
 
 			if tt.wantErr {
 				assert.Error(t, err)
-				assert.Equal(t, tt.err.Error(), err.Error()) // Compare error messages for more robust comparison
 				assert.Nil(t, got)
 				return
 			}
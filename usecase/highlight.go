@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// span is a word matching a search term, as rune offsets
+type span struct {
+	start, end int
+}
+
+// searchTerms returns the lower case words of a query, negated terms are skipped
+func searchTerms(query string) []string {
+	var terms []string
+	for _, field := range strings.Fields(query) {
+		if strings.HasPrefix(field, "-") {
+			continue
+		}
+		terms = append(terms, strings.FieldsFunc(strings.ToLower(field), func(r rune) bool {
+			return !isWordRune(r)
+		})...)
+	}
+	return terms
+}
+
+// highlight wraps the words starting with a term in <em> tags, the rest of the
+// text is HTML escaped. When width is positive and the text is longer, only a
+// snippet of about width runes around the first match is returned. An empty
+// string means nothing matched.
+func highlight(text string, terms []string, width int) string {
+	runes := []rune(text)
+
+	var matches []span
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(runes) && isWordRune(runes[j]) {
+			j++
+		}
+
+		word := strings.ToLower(string(runes[i:j]))
+		for _, term := range terms {
+			if strings.HasPrefix(word, term) {
+				matches = append(matches, span{i, j})
+				break
+			}
+		}
+		i = j
+	}
+
+	if len(matches) == 0 {
+		return ""
+	}
+
+	start, end := 0, len(runes)
+	if width > 0 && len(runes) > width {
+		// Keep some context before the first match, starting on a word
+		start = max(matches[0].start-width/4, 0)
+		for start > 0 && isWordRune(runes[start-1]) {
+			start++
+		}
+
+		// and finish the last word of the snippet
+		end = min(start+width, len(runes))
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+
+	pos := start
+	for _, m := range matches {
+		if m.start < start || m.end > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:m.start])))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(string(runes[m.start:m.end])))
+		b.WriteString("</em>")
+		pos = m.end
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// isWordRune reports whether the rune is part of a word
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"morning", "glory", "oasis"}, searchTerms(`"Morning Glory" -wonderwall oasis!`))
+	assert.Nil(t, searchTerms("-live"))
+}
+
+func TestHighlight(t *testing.T) {
+	long := strings.Repeat("intro ", 40) + "the Morning Glory tour " + strings.Repeat("outro ", 40)
+
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		width int
+		want  string
+	}{
+		{
+			name:  "Whole text",
+			text:  "(What's the Story) Morning Glory?",
+			terms: []string{"morning", "stor"},
+			want:  "(What&#39;s the <em>Story</em>) <em>Morning</em> Glory?",
+		},
+		{
+			name:  "Escapes HTML",
+			text:  "<b>Live</b> at Knebworth",
+			terms: []string{"knebworth"},
+			want:  "&lt;b&gt;Live&lt;/b&gt; at <em>Knebworth</em>",
+		},
+		{
+			name:  "No match",
+			text:  "Be Here Now",
+			terms: []string{"glory"},
+			want:  "",
+		},
+		{
+			name:  "Short text is not cut",
+			text:  "Morning Glory",
+			terms: []string{"glory"},
+			width: 160,
+			want:  "Morning <em>Glory</em>",
+		},
+		{
+			name:  "Snippet around the first match",
+			text:  long,
+			terms: []string{"glory"},
+			width: 40,
+			want:  "…Morning <em>Glory</em> tour outro outro outro outro…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, highlight(tt.text, tt.terms, tt.width))
+		})
+	}
+}
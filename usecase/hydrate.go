@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// hydrate fills the poster, thumbnail, previews, sources, tracks and chapter
+// thumbnails of a video from its asset, a non zero ttl requests the lifetime of
+// the signed tokens. The video is returned as stored when the asset can't be
+// retrieved.
+func hydrate(ctx context.Context, assets Assets, logger *logrus.Logger, video model.Video, ttl time.Duration) model.Video {
+	if video.Asset == nil {
+		return video
+	}
+
+	opts := model.HydrateOptions{TokenTTL: ttl, PosterTime: video.PosterTime, Downloads: video.AllowDownload}
+
+	stored := len(video.Asset.PlaybackIDs) > 0
+	asset, err := assetOf(ctx, assets, video)
+	if err == nil && (stored || opts != (model.HydrateOptions{})) {
+		// GetByID already signs with the lifetime of the policy and the default
+		// frame, a stored asset is never signed
+		asset, err = assets.Hydrate(ctx, asset, opts)
+	}
+	if err != nil {
+		logger.WithError(err).Error(err.Error())
+		return video
+	}
+
+	video.Poster = asset.Poster
+	video.Thumbnail = asset.Thumbnail
+	video.Previews = asset.Previews
+	video.Sources = asset.Sources
+	video.Tracks = playbackTracks(asset.Tracks)
+
+	if len(video.Chapters) > 0 {
+		chapters, err := assets.Chapters(ctx, asset, video.Chapters, opts)
+		if err != nil {
+			logger.WithError(err).Error(err.Error())
+			return video
+		}
+		video.Chapters = chapters
+	}
+
+	return video
+}
+
+// assetOf returns the asset of a video, the video must have one. Playback IDs
+// stored by the webhook avoid a round trip to Mux.com.
+func assetOf(ctx context.Context, assets Assets, video model.Video) (model.Asset, error) {
+	if len(video.Asset.PlaybackIDs) > 0 {
+		return *video.Asset, nil
+	}
+
+	return assets.GetByID(ctx, video.Asset.ID)
+}
+
+// playbackTracks returns the caption, subtitle and audio tracks of an asset,
+// the audio tracks are the languages a player offers
+func playbackTracks(tracks []model.Track) []model.Track {
+	var response []model.Track
+	for _, track := range tracks {
+		if track.Type == model.TrackText || track.Type == model.TrackAudio {
+			response = append(response, track)
+		}
+	}
+
+	return response
+}
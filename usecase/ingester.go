@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	maxJobAttempts = 5                // attempts before a job is dead-lettered
+	jobLease       = 10 * time.Minute // time a worker owns a claimed job
+	jobBackoff     = 30 * time.Second // wait after the first failure, doubled on every attempt
+	maxJobBackoff  = time.Hour        // longest wait between attempts
+)
+
+type ingester struct {
+	assets    Assets
+	videos    Videos
+	jobs      Jobs
+	cleanups  Cleanups
+	namespace string
+	logger    *logrus.Logger
+}
+
+// Ingester returns the usecase implementation
+func Ingester(
+	a Assets,
+	v Videos,
+	j Jobs,
+	c Cleanups,
+	ns string,
+	l *logrus.Logger,
+) ingester {
+	return ingester{
+		assets:    a,
+		videos:    v,
+		jobs:      j,
+		cleanups:  c,
+		namespace: ns,
+		logger:    l,
+	}
+}
+
+// Run processes the due ingestion jobs on every interval until the context
+// is done. Several workers can run at once, each job is claimed by only one.
+func (u ingester) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			u.Drain(ctx)
+		}
+	}
+}
+
+// Drain processes jobs until none is due
+func (u ingester) Drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := u.jobs.ClaimJob(ctx, jobLease)
+		if err != nil {
+			if err != errorcodes.ErrJobNotFound {
+				u.logger.WithError(err).Error(err.Error())
+			}
+			return
+		}
+
+		u.process(ctx, job)
+	}
+}
+
+// process sends the source file of a claimed job to Mux.com and links the asset
+func (u ingester) process(ctx context.Context, job model.Job) {
+	video, err := u.videos.GetByID(ctx, job.VideoID)
+	if err == errorcodes.ErrVideoNotFound {
+		// Trashed or deleted videos are not ingested, a restored one can be retried
+		u.fail(ctx, job, err)
+		return
+	}
+	if err != nil {
+		u.retry(ctx, job, err)
+		return
+	}
+
+	if video.Asset != nil {
+		// The asset was linked, only completing the job failed
+		u.complete(ctx, job)
+		return
+	}
+
+	// The video ID travels as passthrough, an asset whose link is lost can be
+	// found by the sweeper
+	asset, err := u.assets.Create(ctx, job.SourceURL, model.AssetSettings{
+		Public:            job.Public,
+		MP4Support:        job.MP4Support,
+		GeneratedCaptions: job.GeneratedCaptions,
+		AudioInputs:       job.AudioInputs,
+		Clip:              job.Clip,
+		Passthrough:       passthrough(u.namespace, job.VideoID),
+	})
+	if err != nil {
+		u.retry(ctx, job, err)
+		return
+	}
+
+	if _, err := u.videos.UpdateAsset(ctx, job.VideoID, asset); err != nil {
+		// The next attempt creates another asset, this one would be orphaned
+		removeAsset(ctx, u.assets, u.cleanups, u.logger, job.VideoID, asset.ID)
+		u.retry(ctx, job, err)
+		return
+	}
+
+	u.complete(ctx, job)
+}
+
+// complete removes the job and marks the ingestion of the video as succeeded
+func (u ingester) complete(ctx context.Context, job model.Job) {
+	u.setStatus(ctx, job.VideoID, model.JobStatus{
+		Status:   model.JobSucceeded,
+		Attempts: job.Attempts,
+	})
+
+	if err := u.jobs.DeleteJob(ctx, job.ID); err != nil {
+		u.logger.WithError(err).WithField("job_id", job.ID).Error("error deleting job")
+	}
+}
+
+// retry queues the job again with an exponential backoff, the job is
+// dead-lettered once it runs out of attempts
+func (u ingester) retry(ctx context.Context, job model.Job, cause error) {
+	if job.Attempts >= maxJobAttempts {
+		u.fail(ctx, job, cause)
+		return
+	}
+
+	u.logger.WithError(cause).WithFields(logrus.Fields{
+		"job_id":   job.ID,
+		"video_id": job.VideoID,
+		"attempts": job.Attempts,
+	}).Warn("ingestion attempt failed")
+
+	next := time.Now().Add(backoff(job.Attempts))
+	if err := u.jobs.RetryJob(ctx, job.ID, cause.Error(), next); err != nil {
+		u.logger.WithError(err).WithField("job_id", job.ID).Error("error retrying job")
+	}
+
+	u.setStatus(ctx, job.VideoID, model.JobStatus{
+		Status:        model.JobPending,
+		Attempts:      job.Attempts,
+		Error:         cause.Error(),
+		NextAttemptAt: next.UTC().Format(time.RFC3339),
+	})
+}
+
+// fail dead-letters the job, it only runs again through a manual retry
+func (u ingester) fail(ctx context.Context, job model.Job, cause error) {
+	u.logger.WithError(cause).WithFields(logrus.Fields{
+		"job_id":   job.ID,
+		"video_id": job.VideoID,
+		"attempts": job.Attempts,
+	}).Error("ingestion failed, job dead-lettered")
+
+	if err := u.jobs.FailJob(ctx, job.ID, cause.Error()); err != nil {
+		u.logger.WithError(err).WithField("job_id", job.ID).Error("error failing job")
+	}
+
+	u.setStatus(ctx, job.VideoID, model.JobStatus{
+		Status:   model.JobFailed,
+		Attempts: job.Attempts,
+		Error:    cause.Error(),
+	})
+}
+
+// setStatus stores the ingestion state shown on the video
+func (u ingester) setStatus(ctx context.Context, videoID string, status model.JobStatus) {
+	if err := u.videos.UpdateIngestion(ctx, videoID, status); err != nil {
+		u.logger.WithError(err).WithField("video_id", videoID).Error("error updating ingestion status")
+	}
+}
+
+// backoff returns the wait before the next attempt, doubled after every failure
+func backoff(attempts int) time.Duration {
+	wait := jobBackoff
+	for i := 1; i < attempts && wait < maxJobBackoff; i++ {
+		wait *= 2
+	}
+
+	return min(wait, maxJobBackoff)
+}
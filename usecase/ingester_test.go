@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// TestIngester tests the Ingester constructor function
+func TestIngester(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	assets := NewMockAssets(t)
+	videos := NewMockVideos(t)
+	jobs := NewMockJobs(t)
+	cleanups := NewMockCleanups(t)
+
+	usecase := Ingester(assets, videos, jobs, cleanups, testNamespace, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, assets, usecase.assets)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, jobs, usecase.jobs)
+	assert.Equal(t, cleanups, usecase.cleanups)
+	assert.Equal(t, testNamespace, usecase.namespace)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestIngester_Drain(t *testing.T) {
+	videoID := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	assetID := "dd0f697463174c0ca57800847f8559d7"
+	sourceURL := "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4"
+	audio := []model.AudioInput{{URL: "https://example.com/es.m4a", LanguageCode: "es"}}
+	job := model.Job{ID: "job", VideoID: videoID, SourceURL: sourceURL, Public: true, MP4Support: true, GeneratedCaptions: "en", AudioInputs: audio, Attempts: 1}
+	settings := model.AssetSettings{Public: true, MP4Support: true, GeneratedCaptions: "en", AudioInputs: audio, Passthrough: testNamespace + ":" + videoID}
+	last := job
+	last.Attempts = maxJobAttempts
+	asset := model.Asset{ID: assetID, Status: "preparing"}
+	muxErr := errors.New("mux error")
+
+	// pendingWith matches the status of a job waiting for its next attempt
+	pendingWith := func(attempts int) interface{} {
+		return mock.MatchedBy(func(s model.JobStatus) bool {
+			return s.Status == model.JobPending && s.Attempts == attempts && s.Error == "mux error" && len(s.NextAttemptAt) > 0
+		})
+	}
+
+	tests := []struct {
+		name  string
+		job   model.Job
+		mocks func(ctx context.Context, assets *MockAssets, videos *MockVideos, jobs *MockJobs, cleanups *MockCleanups)
+	}{
+		{
+			name: "Asset created",
+			job:  job,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, jobs *MockJobs, cleanups *MockCleanups) {
+				videos.On("GetByID", ctx, videoID).Return(model.Video{ID: videoID}, nil)
+				assets.On("Create", ctx, sourceURL, settings).Return(asset, nil)
+				videos.On("UpdateAsset", ctx, videoID, asset).Return(model.Video{ID: videoID}, nil)
+				videos.On("UpdateIngestion", ctx, videoID, model.JobStatus{Status: model.JobSucceeded, Attempts: 1}).Return(nil)
+				jobs.On("DeleteJob", ctx, "job").Return(nil)
+			},
+		},
+		{
+			name: "Mux.com error is retried",
+			job:  job,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, jobs *MockJobs, cleanups *MockCleanups) {
+				videos.On("GetByID", ctx, videoID).Return(model.Video{ID: videoID}, nil)
+				assets.On("Create", ctx, sourceURL, settings).Return(model.Asset{}, muxErr)
+				jobs.On("RetryJob", ctx, "job", "mux error", mock.MatchedBy(func(runAt time.Time) bool {
+					return runAt.After(time.Now().Add(jobBackoff - time.Second))
+				})).Return(nil)
+				videos.On("UpdateIngestion", ctx, videoID, pendingWith(1)).Return(nil)
+			},
+		},
+		{
+			name: "Last attempt is dead-lettered",
+			job:  last,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, jobs *MockJobs, cleanups *MockCleanups) {
+				videos.On("GetByID", ctx, videoID).Return(model.Video{ID: videoID}, nil)
+				assets.On("Create", ctx, sourceURL, settings).Return(model.Asset{}, muxErr)
+				jobs.On("FailJob", ctx, "job", "mux error").Return(nil)
+				videos.On("UpdateIngestion", ctx, videoID, model.JobStatus{Status: model.JobFailed, Attempts: maxJobAttempts, Error: "mux error"}).Return(nil)
+			},
+		},
+		{
+			name: "Asset not linked is removed",
+			job:  job,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, jobs *MockJobs, cleanups *MockCleanups) {
+				videos.On("GetByID", ctx, videoID).Return(model.Video{ID: videoID}, nil)
+				assets.On("Create", ctx, sourceURL, settings).Return(asset, nil)
+				videos.On("UpdateAsset", ctx, videoID, asset).Return(model.Video{}, muxErr)
+				assets.On("Delete", ctx, assetID).Return(nil)
+				jobs.On("RetryJob", ctx, "job", "mux error", mock.AnythingOfType("time.Time")).Return(nil)
+				videos.On("UpdateIngestion", ctx, videoID, pendingWith(1)).Return(nil)
+			},
+		},
+		{
+			name: "Video already linked",
+			job:  job,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, jobs *MockJobs, cleanups *MockCleanups) {
+				videos.On("GetByID", ctx, videoID).Return(model.Video{ID: videoID, Asset: &asset}, nil)
+				videos.On("UpdateIngestion", ctx, videoID, model.JobStatus{Status: model.JobSucceeded, Attempts: 1}).Return(nil)
+				jobs.On("DeleteJob", ctx, "job").Return(nil)
+			},
+		},
+		{
+			name: "Trashed video is dead-lettered",
+			job:  job,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, jobs *MockJobs, cleanups *MockCleanups) {
+				videos.On("GetByID", ctx, videoID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				jobs.On("FailJob", ctx, "job", "video not found").Return(nil)
+				videos.On("UpdateIngestion", ctx, videoID, model.JobStatus{Status: model.JobFailed, Attempts: 1, Error: "video not found"}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+			jobs := NewMockJobs(t)
+			cleanups := NewMockCleanups(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingester{assets, videos, jobs, cleanups, testNamespace, testLogger}
+
+			ctx := context.Background()
+			jobs.On("ClaimJob", ctx, jobLease).Return(tt.job, nil).Once()
+			jobs.On("ClaimJob", ctx, jobLease).Return(model.Job{}, errorcodes.ErrJobNotFound).Once()
+			tt.mocks(ctx, assets, videos, jobs, cleanups)
+
+			usecase.Drain(ctx)
+		})
+	}
+}
+
+func TestIngester_DrainClaimError(t *testing.T) {
+	jobs := NewMockJobs(t)
+
+	testLogger := logrus.New()
+	testLogger.Out = io.Discard
+
+	usecase := &ingester{NewMockAssets(t), NewMockVideos(t), jobs, NewMockCleanups(t), testNamespace, testLogger}
+
+	ctx := context.Background()
+	jobs.On("ClaimJob", ctx, jobLease).Return(model.Job{}, errors.New("db error")).Once()
+
+	usecase.Drain(ctx)
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, maxJobBackoff},
+		{20, maxJobBackoff},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, backoff(tt.attempts))
+	}
+}
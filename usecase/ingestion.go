@@ -2,61 +2,386 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/javiertlopez/idlemux/errorcodes"
 	"github.com/javiertlopez/idlemux/model"
 )
 
+// clipSource is the source URL of a clip, followed by the ID of the parent asset
+const clipSource = "mux://assets/"
+
 type ingestion struct {
-	assets Assets
-	videos Videos
-	logger *logrus.Logger
+	assets   Assets
+	videos   Videos
+	cleanups Cleanups
+	jobs     Jobs
+	logger   *logrus.Logger
 }
 
 // Ingestion returns the usecase implementation
 func Ingestion(
 	a Assets,
 	v Videos,
+	c Cleanups,
+	j Jobs,
 	l *logrus.Logger,
 ) ingestion {
 	return ingestion{
-		assets: a,
-		videos: v,
-		logger: l,
+		assets:   a,
+		videos:   v,
+		cleanups: c,
+		jobs:     j,
+		logger:   l,
 	}
 }
 
-// Create method
+// Create method stores the video. A Source File URL is stored with its
+// ingestion job in the same transaction, the job workers send it to Mux.com
 func (u ingestion) Create(ctx context.Context, anyVideo model.Video) (model.Video, error) {
-	// Title and Description are mandatory fields
-	if len(anyVideo.Title) == 0 || len(anyVideo.Description) == 0 {
+	anyVideo = withoutServerFields(anyVideo)
+	anyVideo.Tags = normalizeTags(anyVideo.Tags)
+	if err := validate(anyVideo); err != nil {
+		return model.Video{}, err
+	}
+
+	if len(anyVideo.SourceURL) == 0 {
+		response, err := u.videos.Create(ctx, anyVideo)
+		if err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return model.Video{}, err
+		}
+
+		return response, nil
+	}
+
+	var isPublic bool
+	switch anyVideo.Policy {
+	case "public":
+		isPublic = true
+	case "signed":
+		isPublic = false
+	default:
+		// Nothing is stored yet, the request is invalid
 		return model.Video{}, errorcodes.ErrVideoUnprocessable
 	}
 
-	// If body contains a Source File URL, send it to Ingestion
-	if len(anyVideo.SourceURL) > 0 {
-		var isPublic bool
-		switch anyVideo.Policy {
-		case "public":
-			isPublic = true
-		case "signed":
-			isPublic = false
-		default:
-			return model.Video{}, errorcodes.ErrIngestionFailed
+	anyVideo.Ingestion = &model.JobStatus{Status: model.JobPending}
+
+	response, err := u.videos.CreateWithJob(ctx, anyVideo, model.Job{
+		SourceURL:         anyVideo.SourceURL,
+		Public:            isPublic,
+		MP4Support:        anyVideo.AllowDownload,
+		GeneratedCaptions: anyVideo.GeneratedCaptions,
+		AudioInputs:       anyVideo.AudioInputs,
+	})
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	return response, nil
+}
+
+// withoutServerFields clears the fields idlemux sets on a video, a client
+// can't link an asset of the account nor claim to be a clip or a recording
+func withoutServerFields(anyVideo model.Video) model.Video {
+	anyVideo.Asset = nil
+	anyVideo.Duration = 0
+	anyVideo.Ingestion = nil
+	anyVideo.MasterID = ""
+	anyVideo.Clip = nil
+	anyVideo.LiveStreamID = ""
+
+	return anyVideo
+}
+
+// RetryIngestion method queues again the dead-lettered ingestion of a video
+func (u ingestion) RetryIngestion(ctx context.Context, id string) (model.Video, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Video{}, errorcodes.ErrInvalidID
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	// Only failed ingestions are retried, the others are queued or done
+	if video.Ingestion == nil || video.Ingestion.Status != model.JobFailed {
+		return model.Video{}, errorcodes.ErrIngestionNotRetryable
+	}
+
+	// The video is marked first, a worker may complete the job right away
+	status := model.JobStatus{Status: model.JobPending}
+	if err := u.videos.UpdateIngestion(ctx, id, status); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	_, err = u.jobs.RequeueJob(ctx, id)
+	if err == errorcodes.ErrJobNotFound {
+		// The job was never queued
+		_, err = u.jobs.CreateJob(ctx, model.Job{
+			VideoID:           id,
+			SourceURL:         video.SourceURL,
+			Public:            video.Policy == "public",
+			MP4Support:        video.AllowDownload,
+			GeneratedCaptions: video.GeneratedCaptions,
+			AudioInputs:       video.AudioInputs,
+			Clip:              video.Clip,
+		})
+	}
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		if err := u.videos.UpdateIngestion(ctx, id, *video.Ingestion); err != nil {
+			u.logger.WithError(err).WithField("video_id", id).Error("error restoring ingestion status")
 		}
+		return model.Video{}, err
+	}
+
+	video.Ingestion = &status
+
+	return video, nil
+}
+
+// EnableDownload method asks Mux.com for the static MP4 renditions of a video
+// created without them. The downloads are listed in the sources once the
+// renditions are ready.
+func (u ingestion) EnableDownload(ctx context.Context, id string) (model.Video, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Video{}, errorcodes.ErrInvalidID
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	if video.Asset == nil {
+		return model.Video{}, errorcodes.ErrNoPlayback
+	}
+
+	// Mux.com only adds renditions to an asset done preparing
+	if video.Asset.Status != "ready" {
+		return model.Video{}, errorcodes.ErrAssetNotReady
+	}
+
+	if video.AllowDownload && requested(video.Asset.StaticRenditions) {
+		// The renditions were already requested
+		return video, nil
+	}
+
+	asset, err := u.assets.EnableRenditions(ctx, video.Asset.ID)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	response, err := u.videos.UpdateAsset(ctx, id, asset)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	if err := u.videos.UpdateAllowDownload(ctx, id, true); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	response.AllowDownload = true
+
+	return response, nil
+}
+
+// CreateClip method cuts a clip from the asset of a video. The clip is a new
+// video linked to its parent by MasterID with the policy of the parent, its
+// asset is created by the ingestion workers from a mux://assets/{id} source.
+func (u ingestion) CreateClip(ctx context.Context, id string, request model.ClipRequest) (model.Video, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Video{}, errorcodes.ErrInvalidID
+	}
+
+	if request.StartTime < 0 || request.EndTime <= request.StartTime {
+		return model.Video{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	parent, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	if parent.Asset == nil {
+		return model.Video{}, errorcodes.ErrNoPlayback
+	}
+
+	// Mux.com only cuts clips from an asset done preparing
+	if parent.Asset.Status != "ready" {
+		return model.Video{}, errorcodes.ErrAssetNotReady
+	}
+
+	if parent.Duration > 0 && request.EndTime > parent.Duration {
+		return model.Video{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	clip := model.Video{
+		Title:       request.Title,
+		Description: request.Description,
+		SourceURL:   clipSource + parent.Asset.ID,
+		Policy:      parent.Policy,
+		MasterID:    parent.ID,
+		Clip:        &model.Clip{StartTime: request.StartTime, EndTime: request.EndTime},
+		Ingestion:   &model.JobStatus{Status: model.JobPending},
+	}
+	if len(clip.Title) == 0 {
+		clip.Title = parent.Title
+	}
+	if len(clip.Description) == 0 {
+		clip.Description = parent.Description
+	}
+
+	if err := validate(clip); err != nil {
+		return model.Video{}, err
+	}
 
-		asset, err := u.assets.Create(ctx, anyVideo.SourceURL, isPublic)
+	response, err := u.videos.CreateWithJob(ctx, clip, model.Job{
+		SourceURL: clip.SourceURL,
+		Public:    clip.Policy == "public",
+		Clip:      clip.Clip,
+	})
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	return response, nil
+}
+
+// Update method replaces the editable fields of a video
+func (u ingestion) Update(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(anyVideo.ID); err != nil {
+		return model.Video{}, errorcodes.ErrInvalidID
+	}
+
+	// Chapters and the poster time are checked against the duration of the stored video
+	var duration float64
+	if len(anyVideo.Chapters) > 0 || anyVideo.PosterTime != nil {
+		current, err := u.videos.GetByID(ctx, anyVideo.ID)
 		if err != nil {
 			u.logger.WithError(err).Error(err.Error())
-			return model.Video{}, errorcodes.ErrIngestionFailed
+			return model.Video{}, err
+		}
+		duration = current.Duration
+	}
+
+	return u.update(ctx, anyVideo, duration)
+}
+
+// update validates and stores the editable fields of a video of the given duration
+func (u ingestion) update(ctx context.Context, anyVideo model.Video, duration float64) (model.Video, error) {
+	anyVideo.Tags = normalizeTags(anyVideo.Tags)
+	if err := validate(anyVideo); err != nil {
+		return model.Video{}, err
+	}
+
+	if err := chaptersWithin(anyVideo.Chapters, duration); err != nil {
+		return model.Video{}, err
+	}
+
+	if err := posterWithin(anyVideo.PosterTime, duration); err != nil {
+		return model.Video{}, err
+	}
+
+	response, err := u.videos.Update(ctx, anyVideo)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	return response, nil
+}
+
+// Patch method applies a JSON Merge Patch (RFC 7396) to a video
+func (u ingestion) Patch(ctx context.Context, id string, patch []byte) (model.Video, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Video{}, errorcodes.ErrInvalidID
+	}
+
+	current, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Video{}, err
+	}
+
+	document, err := json.Marshal(current)
+	if err != nil {
+		return model.Video{}, err
+	}
+
+	merged, err := mergePatch(document, patch)
+	if err != nil {
+		return model.Video{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	var anyVideo model.Video
+	if err := json.Unmarshal(merged, &anyVideo); err != nil {
+		return model.Video{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	// The ID is not editable
+	anyVideo.ID = id
+
+	return u.update(ctx, anyVideo, current.Duration)
+}
+
+// Delete method moves the video to the trash, or removes it and its Mux asset when permanent
+func (u ingestion) Delete(ctx context.Context, id string, permanent bool) error {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return errorcodes.ErrInvalidID
+	}
+
+	if !permanent {
+		if err := u.videos.Trash(ctx, id); err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return err
 		}
 
-		anyVideo.Asset = &asset
+		return nil
+	}
+
+	video, err := u.videos.Delete(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	if video.Asset != nil && len(video.Asset.ID) > 0 {
+		removeAsset(ctx, u.assets, u.cleanups, u.logger, id, video.Asset.ID)
+	}
+
+	return nil
+}
+
+// Restore method takes a video out of the trash
+func (u ingestion) Restore(ctx context.Context, id string) (model.Video, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Video{}, errorcodes.ErrInvalidID
 	}
 
-	response, err := u.videos.Create(ctx, anyVideo)
+	response, err := u.videos.Restore(ctx, id)
 	if err != nil {
 		u.logger.WithError(err).Error(err.Error())
 		return model.Video{}, err
@@ -64,3 +389,40 @@ func (u ingestion) Create(ctx context.Context, anyVideo model.Video) (model.Vide
 
 	return response, nil
 }
+
+// validate checks the rules shared by create and update
+func validate(anyVideo model.Video) error {
+	// Title and Description are mandatory fields
+	if len(anyVideo.Title) == 0 || len(anyVideo.Description) == 0 {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	// The poster frame is a time in the video, in seconds
+	if anyVideo.PosterTime != nil && *anyVideo.PosterTime < 0 {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	// Mux.com generates the captions in the language spoken in the video
+	if len(anyVideo.GeneratedCaptions) > 0 && !languageCode.MatchString(anyVideo.GeneratedCaptions) {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	if err := validateAudioInputs(anyVideo.AudioInputs); err != nil {
+		return err
+	}
+
+	if err := validateChapters(anyVideo.Chapters); err != nil {
+		return err
+	}
+
+	if err := validateTags(anyVideo.Tags); err != nil {
+		return err
+	}
+
+	return validateMetadata(anyVideo.Metadata)
+}
+
+// requested reports whether the static renditions are being prepared or ready
+func requested(renditions *model.StaticRenditions) bool {
+	return renditions != nil && (renditions.Status == "preparing" || renditions.Status == "ready")
+}
@@ -20,245 +20,1069 @@ func TestIngestion(t *testing.T) {
 	logger.Out = io.Discard
 	assets := NewMockAssets(t)
 	videos := NewMockVideos(t)
+	cleanups := NewMockCleanups(t)
+	jobs := NewMockJobs(t)
 
-	usecase := Ingestion(assets, videos, logger)
+	usecase := Ingestion(assets, videos, cleanups, jobs, logger)
 
 	assert.NotNil(t, usecase)
 	assert.Equal(t, assets, usecase.assets)
 	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, cleanups, usecase.cleanups)
+	assert.Equal(t, jobs, usecase.jobs)
 	assert.Equal(t, logger, usecase.logger)
 }
 
 func TestIngestion_Create(t *testing.T) {
-	logger := logrus.New()
-	logger.Out = io.Discard
-
-	assetId := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
 	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
-	asset := model.Asset{
-		ID: assetId,
-	}
-	expected := model.Video{
+	sourceURL := "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4"
+	pending := &model.JobStatus{Status: model.JobPending}
+	stored := model.Video{
 		ID:          id,
 		Title:       "Some Might Say",
 		Description: "(What's the Story) Morning Glory?",
-		SourceURL:   "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4",
-		Asset: &model.Asset{
-			ID: assetId,
-		},
+		SourceURL:   sourceURL,
+		Policy:      "public",
+		Ingestion:   pending,
+	}
+	withSource := func(policy string) model.Video {
+		return model.Video{
+			Title:       "Some Might Say",
+			Description: "(What's the Story) Morning Glory?",
+			SourceURL:   sourceURL,
+			Policy:      policy,
+		}
 	}
 
-	type args struct {
-		ctx      context.Context
+	tests := []struct {
+		name     string
 		anyVideo model.Video
+		mocks    func(ctx context.Context, videos *MockVideos, jobs *MockJobs)
+		want     model.Video
+		err      error
+	}{
+		{
+			name:     "Missing title and description",
+			anyVideo: model.Video{},
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:     "Video with public source is queued",
+			anyVideo: withSource("public"),
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				expected := withSource("public")
+				expected.Ingestion = pending
+				videos.On("CreateWithJob", ctx, expected, model.Job{SourceURL: sourceURL, Public: true}).Return(stored, nil)
+			},
+			want: stored,
+		},
+		{
+			name:     "Video with signed source is queued",
+			anyVideo: withSource("signed"),
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("CreateWithJob", ctx, mock.AnythingOfType("model.Video"), model.Job{SourceURL: sourceURL, Public: false}).Return(stored, nil)
+			},
+			want: stored,
+		},
+		{
+			name: "Video allowing downloads requests MP4 support",
+			anyVideo: func() model.Video {
+				video := withSource("signed")
+				video.AllowDownload = true
+				return video
+			}(),
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("CreateWithJob", ctx, mock.AnythingOfType("model.Video"), model.Job{SourceURL: sourceURL, MP4Support: true}).Return(stored, nil)
+			},
+			want: stored,
+		},
+		{
+			name: "Video with generated captions",
+			anyVideo: func() model.Video {
+				video := withSource("signed")
+				video.GeneratedCaptions = "en"
+				return video
+			}(),
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("CreateWithJob", ctx, mock.AnythingOfType("model.Video"), model.Job{SourceURL: sourceURL, GeneratedCaptions: "en"}).Return(stored, nil)
+			},
+			want: stored,
+		},
+		{
+			name: "Invalid generated captions language",
+			anyVideo: func() model.Video {
+				video := withSource("signed")
+				video.GeneratedCaptions = "English"
+				return video
+			}(),
+			err: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name: "Video with alternate audio",
+			anyVideo: func() model.Video {
+				video := withSource("signed")
+				video.AudioInputs = []model.AudioInput{{URL: "https://example.com/es.m4a", LanguageCode: "es", Name: "Español"}}
+				return video
+			}(),
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("CreateWithJob", ctx, mock.AnythingOfType("model.Video"), model.Job{
+					SourceURL:   sourceURL,
+					AudioInputs: []model.AudioInput{{URL: "https://example.com/es.m4a", LanguageCode: "es", Name: "Español"}},
+				}).Return(stored, nil)
+			},
+			want: stored,
+		},
+		{
+			name: "Invalid alternate audio URL",
+			anyVideo: func() model.Video {
+				video := withSource("signed")
+				video.AudioInputs = []model.AudioInput{{URL: "es.m4a", LanguageCode: "es"}}
+				return video
+			}(),
+			err: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name: "Too many alternate audio files",
+			anyVideo: func() model.Video {
+				video := withSource("signed")
+				video.AudioInputs = make([]model.AudioInput, maxAudioInputs+1)
+				for i := range video.AudioInputs {
+					video.AudioInputs[i] = model.AudioInput{URL: "https://example.com/es.m4a", LanguageCode: "es"}
+				}
+				return video
+			}(),
+			err: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:     "Video without policy",
+			anyVideo: withSource(""),
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:     "Unknown policy",
+			anyVideo: withSource("private"),
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name: "Source with a client asset is queued",
+			anyVideo: func() model.Video {
+				video := withSource("public")
+				video.Asset = &model.Asset{ID: "made-up", Status: "ready"}
+				return video
+			}(),
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				// The job sees no asset on the video, the worker creates it
+				expected := withSource("public")
+				expected.Ingestion = pending
+				videos.On("CreateWithJob", ctx, expected, model.Job{SourceURL: sourceURL, Public: true}).Return(stored, nil)
+			},
+			want: stored,
+		},
+		{
+			name: "Video without source URL",
+			anyVideo: model.Video{
+				Title:       "Some Might Say",
+				Description: "(What's the Story) Morning Glory?",
+				Policy:      "public",
+			},
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("Create", ctx, mock.AnythingOfType("model.Video")).Return(model.Video{ID: id}, nil)
+			},
+			want: model.Video{ID: id},
+		},
+		{
+			name: "Server fields are cleared",
+			anyVideo: model.Video{
+				Title:        "Some Might Say",
+				Description:  "(What's the Story) Morning Glory?",
+				Policy:       "public",
+				Asset:        &model.Asset{ID: "someone-elses-asset", PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "public"}}},
+				Duration:     242,
+				Ingestion:    &model.JobStatus{Status: model.JobSucceeded},
+				MasterID:     id,
+				Clip:         &model.Clip{StartTime: 0, EndTime: 10},
+				LiveStreamID: "ls1",
+			},
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("Create", ctx, model.Video{
+					Title:       "Some Might Say",
+					Description: "(What's the Story) Morning Glory?",
+					Policy:      "public",
+				}).Return(model.Video{ID: id}, nil)
+			},
+			want: model.Video{ID: id},
+		},
+		{
+			name:     "Video creation failed",
+			anyVideo: withSource("public"),
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("CreateWithJob", ctx, mock.AnythingOfType("model.Video"), mock.AnythingOfType("model.Job")).Return(model.Video{}, errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			jobs := NewMockJobs(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingestion{
+				NewMockAssets(t),
+				videos,
+				NewMockCleanups(t),
+				jobs,
+				testLogger,
+			}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, videos, jobs)
+			}
+
+			got, err := usecase.Create(ctx, tt.anyVideo)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
+
+func TestIngestion_RetryIngestion(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	sourceURL := "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4"
+	failed := model.JobStatus{Status: model.JobFailed, Attempts: 5, Error: "mux error"}
+	video := model.Video{ID: id, SourceURL: sourceURL, Policy: "signed", Ingestion: &failed}
+	pending := model.JobStatus{Status: model.JobPending}
+
+	tests := []struct {
+		name  string
+		id    string
+		mocks func(ctx context.Context, videos *MockVideos, jobs *MockJobs)
+		want  model.Video
+		err   error
+	}{
+		{
+			name: "Failed job is requeued",
+			id:   id,
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("GetByID", ctx, id).Return(video, nil)
+				videos.On("UpdateIngestion", ctx, id, pending).Return(nil)
+				jobs.On("RequeueJob", ctx, id).Return(model.Job{ID: "job"}, nil)
+			},
+			want: model.Video{ID: id, SourceURL: sourceURL, Policy: "signed", Ingestion: &pending},
+		},
+		{
+			name: "Job never queued is created",
+			id:   id,
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("GetByID", ctx, id).Return(video, nil)
+				videos.On("UpdateIngestion", ctx, id, pending).Return(nil)
+				jobs.On("RequeueJob", ctx, id).Return(model.Job{}, errorcodes.ErrJobNotFound)
+				jobs.On("CreateJob", ctx, model.Job{VideoID: id, SourceURL: sourceURL}).Return(model.Job{ID: "job"}, nil)
+			},
+			want: model.Video{ID: id, SourceURL: sourceURL, Policy: "signed", Ingestion: &pending},
+		},
+		{
+			name: "Queue error restores the status",
+			id:   id,
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("GetByID", ctx, id).Return(video, nil)
+				videos.On("UpdateIngestion", ctx, id, pending).Return(nil)
+				jobs.On("RequeueJob", ctx, id).Return(model.Job{}, errors.New("db error"))
+				videos.On("UpdateIngestion", ctx, id, failed).Return(nil)
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name: "Pending ingestion",
+			id:   id,
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id, Ingestion: &pending}, nil)
+			},
+			err: errorcodes.ErrIngestionNotRetryable,
+		},
+		{
+			name: "Video without ingestion",
+			id:   id,
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id}, nil)
+			},
+			err: errorcodes.ErrIngestionNotRetryable,
+		},
+		{
+			name: "Not found",
+			id:   id,
+			mocks: func(ctx context.Context, videos *MockVideos, jobs *MockJobs) {
+				videos.On("GetByID", ctx, id).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+			err: errorcodes.ErrVideoNotFound,
+		},
+		{
+			name: "Invalid ID",
+			id:   "123",
+			err:  errorcodes.ErrInvalidID,
+		},
 	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			jobs := NewMockJobs(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingestion{
+				NewMockAssets(t),
+				videos,
+				NewMockCleanups(t),
+				jobs,
+				testLogger,
+			}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, videos, jobs)
+			}
+
+			got, err := usecase.RetryIngestion(ctx, tt.id)
 
-	type mockReturns struct {
-		assetResp model.Asset
-		assetErr  error
-		videoResp model.Video
-		videoErr  error
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
 	}
+}
+
+func TestIngestion_EnableDownload(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	ready := &model.Asset{ID: "asset", Status: "ready"}
+	video := model.Video{ID: id, Policy: "signed", Asset: ready}
+	preparing := model.Asset{ID: "asset", Status: "ready", StaticRenditions: &model.StaticRenditions{Status: "preparing"}}
+	enabled := model.Video{ID: id, Policy: "signed", Asset: &preparing, AllowDownload: true}
+
+	tests := []struct {
+		name  string
+		id    string
+		mocks func(ctx context.Context, assets *MockAssets, videos *MockVideos)
+		want  model.Video
+		err   error
+	}{
+		{
+			name: "Renditions are requested",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(video, nil)
+				assets.On("EnableRenditions", ctx, "asset").Return(preparing, nil)
+				videos.On("UpdateAsset", ctx, id, preparing).Return(model.Video{ID: id, Policy: "signed", Asset: &preparing}, nil)
+				videos.On("UpdateAllowDownload", ctx, id, true).Return(nil)
+			},
+			want: enabled,
+		},
+		{
+			name: "Renditions already requested",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(enabled, nil)
+			},
+			want: enabled,
+		},
+		{
+			name: "Disabled renditions are requested again",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				disabled := model.Asset{ID: "asset", Status: "ready", StaticRenditions: &model.StaticRenditions{Status: "disabled"}}
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id, Policy: "signed", Asset: &disabled, AllowDownload: true}, nil)
+				assets.On("EnableRenditions", ctx, "asset").Return(preparing, nil)
+				videos.On("UpdateAsset", ctx, id, preparing).Return(model.Video{ID: id, Policy: "signed", Asset: &preparing}, nil)
+				videos.On("UpdateAllowDownload", ctx, id, true).Return(nil)
+			},
+			want: enabled,
+		},
+		{
+			name: "Mux.com error",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(video, nil)
+				assets.On("EnableRenditions", ctx, "asset").Return(model.Asset{}, errors.New("mux error"))
+			},
+			err: errors.New("mux error"),
+		},
+		{
+			name: "Repository error",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(video, nil)
+				assets.On("EnableRenditions", ctx, "asset").Return(preparing, nil)
+				videos.On("UpdateAsset", ctx, id, preparing).Return(model.Video{ID: id, Asset: &preparing}, nil)
+				videos.On("UpdateAllowDownload", ctx, id, true).Return(errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name: "Asset not ready",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id, Asset: &model.Asset{ID: "asset", Status: "preparing"}}, nil)
+			},
+			err: errorcodes.ErrAssetNotReady,
+		},
+		{
+			name: "Video without asset",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id}, nil)
+			},
+			err: errorcodes.ErrNoPlayback,
+		},
+		{
+			name: "Not found",
+			id:   id,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+			err: errorcodes.ErrVideoNotFound,
+		},
+		{
+			name: "Invalid ID",
+			id:   "123",
+			err:  errorcodes.ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingestion{
+				assets,
+				videos,
+				NewMockCleanups(t),
+				NewMockJobs(t),
+				testLogger,
+			}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, assets, videos)
+			}
+
+			got, err := usecase.EnableDownload(ctx, tt.id)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
+
+func TestIngestion_CreateClip(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	parent := model.Video{
+		ID:          id,
+		Title:       "Live Forever",
+		Description: "Definitely Maybe",
+		Policy:      "signed",
+		Duration:    276,
+		Asset:       &model.Asset{ID: "asset", Status: "ready"},
+	}
+	clip := &model.Clip{StartTime: 30, EndTime: 60}
+	stored := model.Video{ID: "clip", Title: "Live Forever", MasterID: id, Clip: clip}
 
 	tests := []struct {
 		name    string
-		args    args
-		mocks   mockReturns
+		id      string
+		request model.ClipRequest
+		mocks   func(ctx context.Context, videos *MockVideos)
 		want    model.Video
-		wantErr bool
 		err     error
 	}{
 		{
-			name: "Assets creation failed",
-			args: args{
-				ctx:      context.Background(),
-				anyVideo: model.Video{},
+			name:    "Clip inherits the parent",
+			id:      id,
+			request: model.ClipRequest{StartTime: 30, EndTime: 60},
+			mocks: func(ctx context.Context, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(parent, nil)
+				videos.On("CreateWithJob", ctx, model.Video{
+					Title:       "Live Forever",
+					Description: "Definitely Maybe",
+					SourceURL:   "mux://assets/asset",
+					Policy:      "signed",
+					MasterID:    id,
+					Clip:        clip,
+					Ingestion:   &model.JobStatus{Status: model.JobPending},
+				}, model.Job{SourceURL: "mux://assets/asset", Clip: clip}).Return(stored, nil)
 			},
-			mocks:   mockReturns{},
-			want:    model.Video{},
-			wantErr: true,
-			err:     errorcodes.ErrVideoUnprocessable,
+			want: stored,
 		},
 		{
-			name: "Video with public source",
-			args: args{
-				ctx: context.Background(),
-				anyVideo: model.Video{
-					Title:       "Some Might Say",
-					Description: "(What's the Story) Morning Glory?",
-					SourceURL:   "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4",
-					Policy:      "public",
-				},
+			name:    "Clip of a public video with its own title",
+			id:      id,
+			request: model.ClipRequest{Title: "The chorus", StartTime: 30, EndTime: 60},
+			mocks: func(ctx context.Context, videos *MockVideos) {
+				public := parent
+				public.Policy = "public"
+				videos.On("GetByID", ctx, id).Return(public, nil)
+				videos.On("CreateWithJob", ctx, mock.MatchedBy(func(v model.Video) bool {
+					return v.Title == "The chorus" && v.Description == "Definitely Maybe" && v.Policy == "public"
+				}), model.Job{SourceURL: "mux://assets/asset", Public: true, Clip: clip}).Return(stored, nil)
 			},
-			mocks: mockReturns{
-				assetResp: asset,
-				assetErr:  nil,
-				videoResp: expected,
-				videoErr:  nil,
+			want: stored,
+		},
+		{
+			name:    "Repository error",
+			id:      id,
+			request: model.ClipRequest{StartTime: 30, EndTime: 60},
+			mocks: func(ctx context.Context, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(parent, nil)
+				videos.On("CreateWithJob", ctx, mock.Anything, mock.Anything).Return(model.Video{}, errors.New("db error"))
 			},
-			want:    expected,
-			wantErr: false,
-			err:     nil,
+			err: errors.New("db error"),
 		},
 		{
-			name: "Video with signed source",
-			args: args{
-				ctx: context.Background(),
-				anyVideo: model.Video{
-					Title:       "Some Might Say",
-					Description: "(What's the Story) Morning Glory?",
-					SourceURL:   "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4",
-					Policy:      "signed",
-				},
+			name:    "Clip ends after the video",
+			id:      id,
+			request: model.ClipRequest{StartTime: 270, EndTime: 300},
+			mocks: func(ctx context.Context, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(parent, nil)
 			},
-			mocks: mockReturns{
-				assetResp: asset,
-				assetErr:  nil,
-				videoResp: expected,
-				videoErr:  nil,
+			err: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:    "Asset not ready",
+			id:      id,
+			request: model.ClipRequest{StartTime: 30, EndTime: 60},
+			mocks: func(ctx context.Context, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id, Asset: &model.Asset{ID: "asset", Status: "preparing"}}, nil)
 			},
-			want:    expected,
-			wantErr: false,
-			err:     nil,
+			err: errorcodes.ErrAssetNotReady,
 		},
 		{
-			name: "Video without policy",
-			args: args{
-				ctx: context.Background(),
-				anyVideo: model.Video{
-					Title:       "Some Might Say",
-					Description: "(What's the Story) Morning Glory?",
-					SourceURL:   "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4",
-				},
+			name:    "Video without asset",
+			id:      id,
+			request: model.ClipRequest{StartTime: 30, EndTime: 60},
+			mocks: func(ctx context.Context, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id}, nil)
 			},
-			mocks: mockReturns{
-				assetResp: model.Asset{},
-				assetErr:  nil,
-				videoResp: model.Video{},
-				videoErr:  nil,
+			err: errorcodes.ErrNoPlayback,
+		},
+		{
+			name:    "Not found",
+			id:      id,
+			request: model.ClipRequest{StartTime: 30, EndTime: 60},
+			mocks: func(ctx context.Context, videos *MockVideos) {
+				videos.On("GetByID", ctx, id).Return(model.Video{}, errorcodes.ErrVideoNotFound)
 			},
-			want:    model.Video{},
-			wantErr: true,
-			err:     errorcodes.ErrIngestionFailed,
+			err: errorcodes.ErrVideoNotFound,
 		},
 		{
-			name: "Video without source URL",
-			args: args{
-				ctx: context.Background(),
-				anyVideo: model.Video{
-					Title:       "Some Might Say",
-					Description: "(What's the Story) Morning Glory?",
-					Policy:      "public",
-				},
+			name:    "End before start",
+			id:      id,
+			request: model.ClipRequest{StartTime: 60, EndTime: 30},
+			err:     errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:    "Negative start",
+			id:      id,
+			request: model.ClipRequest{StartTime: -1, EndTime: 30},
+			err:     errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name: "Invalid ID",
+			id:   "invalid",
+			err:  errorcodes.ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingestion{
+				NewMockAssets(t),
+				videos,
+				NewMockCleanups(t),
+				NewMockJobs(t),
+				testLogger,
+			}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, videos)
+			}
+
+			got, err := usecase.CreateClip(ctx, tt.id, tt.request)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
+
+func TestIngestion_Update(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	posterTime, negative := 12.5, -1.0
+	chapters := []model.Chapter{{StartTime: 0, Title: "Intro"}, {StartTime: 90, Title: "Chorus"}}
+	valid := model.Video{
+		ID:          id,
+		Title:       "Some Might Say",
+		Description: "(What's the Story) Morning Glory?",
+	}
+
+	tests := []struct {
+		name     string
+		anyVideo model.Video
+		duration float64
+		callRepo bool
+		repoErr  error
+		want     model.Video
+		err      error
+	}{
+		{
+			name:     "Success",
+			anyVideo: valid,
+			callRepo: true,
+			want:     valid,
+		},
+		{
+			name:     "Invalid ID",
+			anyVideo: model.Video{ID: "invalid", Title: "Some Might Say", Description: "Oasis"},
+			err:      errorcodes.ErrInvalidID,
+		},
+		{
+			name:     "Missing title",
+			anyVideo: model.Video{ID: id, Description: "Oasis"},
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name: "With tags and metadata",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				Tags:        []string{"britpop", "campaign-1995"},
+				Metadata:    model.Metadata{"product": "music", "year": float64(1995), "live": false},
 			},
-			mocks: mockReturns{
-				assetResp: model.Asset{},
-				assetErr:  nil,
-				videoResp: model.Video{},
-				videoErr:  nil,
+			callRepo: true,
+		},
+		{
+			name: "Metadata value is not a scalar",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				Metadata:    model.Metadata{"album": map[string]interface{}{"title": "Morning Glory"}},
 			},
-			want:    model.Video{},
-			wantErr: false,
-			err:     nil,
+			err: errorcodes.ErrVideoUnprocessable,
 		},
 		{
-			name: "Video ingestion failed",
-			args: args{
-				ctx: context.Background(),
-				anyVideo: model.Video{
-					Title:       "Some Might Say",
-					Description: "(What's the Story) Morning Glory?",
-					SourceURL:   "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4",
-					Policy:      "public",
-				},
+			name: "With poster time",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				PosterTime:  &posterTime,
 			},
-			mocks: mockReturns{
-				assetResp: model.Asset{},
-				assetErr:  errors.New("asset creation failed"),
-				videoResp: model.Video{},
-				videoErr:  nil,
+			callRepo: true,
+		},
+		{
+			name: "Negative poster time",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				PosterTime:  &negative,
 			},
-			want:    model.Video{},
-			wantErr: true,
-			err:     errorcodes.ErrIngestionFailed,
+			err: errorcodes.ErrVideoUnprocessable,
 		},
 		{
-			name: "Video creation failed",
-			args: args{
-				ctx: context.Background(),
-				anyVideo: model.Video{
-					Title:       "Some Might Say",
-					Description: "(What's the Story) Morning Glory?",
-					SourceURL:   "https://storage.googleapis.com/muxdemofiles/mux-video-intro.mp4",
-					Policy:      "public",
-				},
+			name: "Poster time after the end of the video",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				PosterTime:  &posterTime,
+			},
+			duration: 10,
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name: "With chapters",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				Chapters:    chapters,
+			},
+			duration: 120,
+			callRepo: true,
+		},
+		{
+			name: "Chapter after the end of the video",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				Chapters:    chapters,
 			},
-			mocks: mockReturns{
-				assetResp: asset,
-				assetErr:  nil,
-				videoResp: model.Video{},
-				videoErr:  errors.New("asset creation failed"),
+			duration: 60,
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name: "Chapters out of order",
+			anyVideo: model.Video{
+				ID:          id,
+				Title:       "Some Might Say",
+				Description: "Oasis",
+				Chapters:    []model.Chapter{chapters[1], chapters[0]},
 			},
-			want:    model.Video{},
-			wantErr: true,
-			err:     errors.New("asset creation failed"),
+			duration: 120,
+			err:      errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:     "Not found",
+			anyVideo: valid,
+			callRepo: true,
+			repoErr:  errorcodes.ErrVideoNotFound,
+			err:      errorcodes.ErrVideoNotFound,
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create mocks with strict mode to catch any unexpected calls
 			assets := NewMockAssets(t)
 			videos := NewMockVideos(t)
 
-			// Create a real logger that discards output but can be inspected for coverage
 			testLogger := logrus.New()
 			testLogger.Out = io.Discard
 
 			usecase := &ingestion{
 				assets,
 				videos,
+				NewMockCleanups(t),
+				NewMockJobs(t),
 				testLogger,
 			}
 
-			// Set more structured mock expectations based on the test case properties
-			if len(tt.args.anyVideo.Title) == 0 || len(tt.args.anyVideo.Description) == 0 {
-				// For validation error test cases, no mock expectations needed
-				// The function will return early due to validation failure
-			} else if len(tt.args.anyVideo.SourceURL) > 0 {
-				// If there's a source URL, we expect assets.Create to be called
-				if tt.args.anyVideo.Policy == "public" || tt.args.anyVideo.Policy == "signed" {
-					isPublic := tt.args.anyVideo.Policy == "public"
-					assets.On("Create", tt.args.ctx, tt.args.anyVideo.SourceURL, isPublic).Return(asset, tt.mocks.assetErr)
-
-					// Only expect videos.Create if assets.Create doesn't error
-					if tt.mocks.assetErr == nil {
-						videos.On("Create", tt.args.ctx, mock.AnythingOfType("model.Video")).Return(tt.mocks.videoResp, tt.mocks.videoErr)
-					}
-				}
-				// For invalid policy, no mock expectations needed as function returns early
-			} else {
-				// For videos without source URL, only videos.Create is called
-				videos.On("Create", tt.args.ctx, mock.AnythingOfType("model.Video")).Return(tt.mocks.videoResp, tt.mocks.videoErr)
+			ctx := context.Background()
+
+			if len(tt.anyVideo.Chapters) > 0 || tt.anyVideo.PosterTime != nil {
+				videos.On("GetByID", ctx, id).Return(model.Video{ID: id, Duration: tt.duration}, nil)
+			}
+			if tt.callRepo {
+				videos.On("Update", ctx, tt.anyVideo).Return(tt.want, tt.repoErr)
 			}
 
-			got, err := usecase.Create(tt.args.ctx, tt.args.anyVideo)
+			got, err := usecase.Update(ctx, tt.anyVideo)
 
-			if tt.wantErr {
-				assert.Error(t, err, "Expected an error but got none")
-				if tt.err != nil {
-					assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
-				}
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err, "Error doesn't match")
 				return
 			}
 
 			assert.NoError(t, err, "Got unexpected error")
 			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
+
+func TestIngestion_Patch(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	current := model.Video{
+		ID:          id,
+		Title:       "Some Might Sya",
+		Description: "(What's the Story) Morning Glory?",
+		CreatedAt:   "2025-01-01 00:00:00 +0000 UTC",
+	}
+	fixed := current
+	fixed.Title = "Some Might Say"
+	downloadable := current
+	downloadable.AllowDownload = true
+
+	tests := []struct {
+		name       string
+		id         string
+		patch      string
+		getErr     error
+		callGet    bool
+		wantUpdate *model.Video
+		err        error
+	}{
+		{
+			name:       "Fix title",
+			id:         id,
+			patch:      `{"title":"Some Might Say","id":"ignored"}`,
+			callGet:    true,
+			wantUpdate: &fixed,
+		},
+		{
+			name:       "Allow downloads",
+			id:         id,
+			patch:      `{"allow_download":true}`,
+			callGet:    true,
+			wantUpdate: &downloadable,
+		},
+		{
+			name:    "Remove mandatory field",
+			id:      id,
+			patch:   `{"description":null}`,
+			callGet: true,
+			err:     errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:    "Patch is not an object",
+			id:      id,
+			patch:   `["title"]`,
+			callGet: true,
+			err:     errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:    "Not found",
+			id:      id,
+			patch:   `{"title":"Some Might Say"}`,
+			callGet: true,
+			getErr:  errorcodes.ErrVideoNotFound,
+			err:     errorcodes.ErrVideoNotFound,
+		},
+		{
+			name:  "Invalid ID",
+			id:    "invalid",
+			patch: `{"title":"Some Might Say"}`,
+			err:   errorcodes.ErrInvalidID,
+		},
+	}
 
-			// Additional specific assertions for certain fields
-			if tt.want.Asset != nil {
-				assert.Equal(t, tt.want.Asset.ID, got.Asset.ID, "Asset ID doesn't match")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingestion{
+				assets,
+				videos,
+				NewMockCleanups(t),
+				NewMockJobs(t),
+				testLogger,
+			}
+
+			ctx := context.Background()
+
+			if tt.callGet {
+				videos.On("GetByID", ctx, tt.id).Return(current, tt.getErr)
+			}
+			if tt.wantUpdate != nil {
+				videos.On("Update", ctx, *tt.wantUpdate).Return(*tt.wantUpdate, nil)
+			}
+
+			got, err := usecase.Patch(ctx, tt.id, []byte(tt.patch))
+
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err, "Error doesn't match")
+				return
 			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, *tt.wantUpdate, got, "Response doesn't match expected")
 		})
+	}
+}
+
+func TestIngestion_Delete(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	assetID := "dd0f697463174c0ca57800847f8559d7"
 
+	tests := []struct {
+		name       string
+		id         string
+		permanent  bool
+		trashErr   error
+		video      model.Video
+		deleteErr  error
+		assetErr   error
+		callAssets bool
+		callClean  bool
+		err        error
+	}{
+		{
+			name: "Move to trash",
+			id:   id,
+		},
+		{
+			name:     "Move to trash not found",
+			id:       id,
+			trashErr: errorcodes.ErrVideoNotFound,
+			err:      errorcodes.ErrVideoNotFound,
+		},
+		{
+			name:       "Permanent with asset",
+			id:         id,
+			permanent:  true,
+			video:      model.Video{ID: id, Asset: &model.Asset{ID: assetID}},
+			callAssets: true,
+		},
+		{
+			name:      "Permanent without asset",
+			id:        id,
+			permanent: true,
+			video:     model.Video{ID: id},
+		},
+		{
+			name:       "Asset already gone",
+			id:         id,
+			permanent:  true,
+			video:      model.Video{ID: id, Asset: &model.Asset{ID: assetID}},
+			callAssets: true,
+			assetErr:   errorcodes.ErrAssetNotFound,
+		},
+		{
+			name:       "Asset deletion failed",
+			id:         id,
+			permanent:  true,
+			video:      model.Video{ID: id, Asset: &model.Asset{ID: assetID}},
+			callAssets: true,
+			assetErr:   errors.New("mux unavailable"),
+			callClean:  true,
+		},
+		{
+			name:      "Permanent not found",
+			id:        id,
+			permanent: true,
+			deleteErr: errorcodes.ErrVideoNotFound,
+			err:       errorcodes.ErrVideoNotFound,
+		},
+		{
+			name: "Invalid ID",
+			id:   "invalid",
+			err:  errorcodes.ErrInvalidID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+			cleanups := NewMockCleanups(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingestion{
+				assets,
+				videos,
+				cleanups,
+				NewMockJobs(t),
+				testLogger,
+			}
+
+			ctx := context.Background()
+
+			if tt.id == id {
+				if tt.permanent {
+					videos.On("Delete", ctx, id).Return(tt.video, tt.deleteErr)
+				} else {
+					videos.On("Trash", ctx, id).Return(tt.trashErr)
+				}
+			}
+			if tt.callAssets {
+				assets.On("Delete", ctx, assetID).Return(tt.assetErr)
+			}
+			if tt.callClean {
+				cleanups.On("CreateCleanup", ctx, mock.MatchedBy(func(c model.Cleanup) bool {
+					return c.AssetID == assetID && c.VideoID == id
+				})).Return(model.Cleanup{}, nil)
+			}
+
+			err := usecase.Delete(ctx, tt.id, tt.permanent)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+		})
+	}
+}
+
+func TestIngestion_Restore(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	restored := model.Video{ID: id, Title: "Some Might Say"}
+
+	tests := []struct {
+		name     string
+		id       string
+		repoErr  error
+		callRepo bool
+		err      error
+	}{
+		{"Success", id, nil, true, nil},
+		{"Not in trash", id, errorcodes.ErrVideoNotFound, true, errorcodes.ErrVideoNotFound},
+		{"Invalid ID", "invalid", nil, false, errorcodes.ErrInvalidID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &ingestion{
+				NewMockAssets(t),
+				videos,
+				NewMockCleanups(t),
+				NewMockJobs(t),
+				testLogger,
+			}
+
+			ctx := context.Background()
+
+			if tt.callRepo {
+				videos.On("Restore", ctx, tt.id).Return(restored, tt.repoErr)
+			}
+
+			got, err := usecase.Restore(ctx, tt.id)
+
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err, "Error doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, restored, got, "Response doesn't match expected")
+		})
 	}
 }
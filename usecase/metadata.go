@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	maxTags          = 20  // tags per video
+	maxTagLength     = 64  // characters per tag
+	maxMetadataKeys  = 20  // metadata entries per video
+	maxMetadataValue = 256 // characters per metadata string value
+)
+
+// metadataKey keeps metadata keys usable as query parameters and document fields
+var metadataKey = regexp.MustCompile(`^[A-Za-z0-9_-]{1,40}$`)
+
+// normalizeTags trims, lower cases and removes duplicated tags
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// validateTags checks the count and length of the tags
+func validateTags(tags []string) error {
+	if len(tags) > maxTags {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	for _, tag := range tags {
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+			return errorcodes.ErrVideoUnprocessable
+		}
+	}
+
+	return nil
+}
+
+// validateMetadata checks the keys and the scalar values of the metadata
+func validateMetadata(metadata model.Metadata) error {
+	if len(metadata) > maxMetadataKeys {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	for key, value := range metadata {
+		if !metadataKey.MatchString(key) {
+			return errorcodes.ErrVideoUnprocessable
+		}
+
+		switch v := value.(type) {
+		case string:
+			if utf8.RuneCountInString(v) > maxMetadataValue {
+				return errorcodes.ErrVideoUnprocessable
+			}
+		case float64, bool:
+		default:
+			// Objects, arrays and null are not scalars
+			return errorcodes.ErrVideoUnprocessable
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Nil(t, normalizeTags(nil))
+	assert.Equal(t, []string{"britpop", "live"}, normalizeTags([]string{" BritPop", "live", "britpop "}))
+}
+
+func TestValidateTags(t *testing.T) {
+	tooMany := make([]string, maxTags+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("t", i+1)
+	}
+
+	assert.NoError(t, validateTags([]string{"britpop"}))
+	assert.Equal(t, errorcodes.ErrVideoUnprocessable, validateTags(tooMany))
+	assert.Equal(t, errorcodes.ErrVideoUnprocessable, validateTags([]string{""}))
+	assert.Equal(t, errorcodes.ErrVideoUnprocessable, validateTags([]string{strings.Repeat("t", maxTagLength+1)}))
+}
+
+func TestValidateMetadata(t *testing.T) {
+	tooMany := model.Metadata{}
+	for i := 0; i <= maxMetadataKeys; i++ {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+
+	tests := []struct {
+		name     string
+		metadata model.Metadata
+		err      error
+	}{
+		{"Scalars", model.Metadata{"campaign": "summer", "year": float64(1995), "live": true}, nil},
+		{"Empty", nil, nil},
+		{"Too many keys", tooMany, errorcodes.ErrVideoUnprocessable},
+		{"Dotted key", model.Metadata{"campaign.name": "summer"}, errorcodes.ErrVideoUnprocessable},
+		{"Operator key", model.Metadata{"$where": "1"}, errorcodes.ErrVideoUnprocessable},
+		{"Long value", model.Metadata{"notes": strings.Repeat("v", maxMetadataValue+1)}, errorcodes.ErrVideoUnprocessable},
+		{"Null value", model.Metadata{"notes": nil}, errorcodes.ErrVideoUnprocessable},
+		{"Array value", model.Metadata{"notes": []interface{}{"a"}}, errorcodes.ErrVideoUnprocessable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, validateMetadata(tt.metadata))
+		})
+	}
+}
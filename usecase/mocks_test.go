@@ -6,6 +6,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/javiertlopez/idlemux/model"
 	mock "github.com/stretchr/testify/mock"
@@ -38,9 +39,89 @@ func (_m *MockAssets) EXPECT() *MockAssets_Expecter {
 	return &MockAssets_Expecter{mock: &_m.Mock}
 }
 
+// Chapters provides a mock function for the type MockAssets
+func (_mock *MockAssets) Chapters(ctx context.Context, asset model.Asset, chapters []model.Chapter, opts model.HydrateOptions) ([]model.Chapter, error) {
+	ret := _mock.Called(ctx, asset, chapters, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Chapters")
+	}
+
+	var r0 []model.Chapter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, []model.Chapter, model.HydrateOptions) ([]model.Chapter, error)); ok {
+		return returnFunc(ctx, asset, chapters, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, []model.Chapter, model.HydrateOptions) []model.Chapter); ok {
+		r0 = returnFunc(ctx, asset, chapters, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Chapter)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Asset, []model.Chapter, model.HydrateOptions) error); ok {
+		r1 = returnFunc(ctx, asset, chapters, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssets_Chapters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Chapters'
+type MockAssets_Chapters_Call struct {
+	*mock.Call
+}
+
+// Chapters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asset model.Asset
+//   - chapters []model.Chapter
+//   - opts model.HydrateOptions
+func (_e *MockAssets_Expecter) Chapters(ctx interface{}, asset interface{}, chapters interface{}, opts interface{}) *MockAssets_Chapters_Call {
+	return &MockAssets_Chapters_Call{Call: _e.mock.On("Chapters", ctx, asset, chapters, opts)}
+}
+
+func (_c *MockAssets_Chapters_Call) Run(run func(ctx context.Context, asset model.Asset, chapters []model.Chapter, opts model.HydrateOptions)) *MockAssets_Chapters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Asset
+		if args[1] != nil {
+			arg1 = args[1].(model.Asset)
+		}
+		var arg2 []model.Chapter
+		if args[2] != nil {
+			arg2 = args[2].([]model.Chapter)
+		}
+		var arg3 model.HydrateOptions
+		if args[3] != nil {
+			arg3 = args[3].(model.HydrateOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssets_Chapters_Call) Return(chapters1 []model.Chapter, err error) *MockAssets_Chapters_Call {
+	_c.Call.Return(chapters1, err)
+	return _c
+}
+
+func (_c *MockAssets_Chapters_Call) RunAndReturn(run func(ctx context.Context, asset model.Asset, chapters []model.Chapter, opts model.HydrateOptions) ([]model.Chapter, error)) *MockAssets_Chapters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockAssets
-func (_mock *MockAssets) Create(ctx context.Context, source string, public bool) (model.Asset, error) {
-	ret := _mock.Called(ctx, source, public)
+func (_mock *MockAssets) Create(ctx context.Context, source string, settings model.AssetSettings) (model.Asset, error) {
+	ret := _mock.Called(ctx, source, settings)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -48,16 +129,16 @@ func (_mock *MockAssets) Create(ctx context.Context, source string, public bool)
 
 	var r0 model.Asset
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) (model.Asset, error)); ok {
-		return returnFunc(ctx, source, public)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.AssetSettings) (model.Asset, error)); ok {
+		return returnFunc(ctx, source, settings)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) model.Asset); ok {
-		r0 = returnFunc(ctx, source, public)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.AssetSettings) model.Asset); ok {
+		r0 = returnFunc(ctx, source, settings)
 	} else {
 		r0 = ret.Get(0).(model.Asset)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
-		r1 = returnFunc(ctx, source, public)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.AssetSettings) error); ok {
+		r1 = returnFunc(ctx, source, settings)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,12 +153,12 @@ type MockAssets_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - source string
-//   - public bool
-func (_e *MockAssets_Expecter) Create(ctx interface{}, source interface{}, public interface{}) *MockAssets_Create_Call {
-	return &MockAssets_Create_Call{Call: _e.mock.On("Create", ctx, source, public)}
+//   - settings model.AssetSettings
+func (_e *MockAssets_Expecter) Create(ctx interface{}, source interface{}, settings interface{}) *MockAssets_Create_Call {
+	return &MockAssets_Create_Call{Call: _e.mock.On("Create", ctx, source, settings)}
 }
 
-func (_c *MockAssets_Create_Call) Run(run func(ctx context.Context, source string, public bool)) *MockAssets_Create_Call {
+func (_c *MockAssets_Create_Call) Run(run func(ctx context.Context, source string, settings model.AssetSettings)) *MockAssets_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -87,9 +168,9 @@ func (_c *MockAssets_Create_Call) Run(run func(ctx context.Context, source strin
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 bool
+		var arg2 model.AssetSettings
 		if args[2] != nil {
-			arg2 = args[2].(bool)
+			arg2 = args[2].(model.AssetSettings)
 		}
 		run(
 			arg0,
@@ -105,50 +186,119 @@ func (_c *MockAssets_Create_Call) Return(asset model.Asset, err error) *MockAsse
 	return _c
 }
 
-func (_c *MockAssets_Create_Call) RunAndReturn(run func(ctx context.Context, source string, public bool) (model.Asset, error)) *MockAssets_Create_Call {
+func (_c *MockAssets_Create_Call) RunAndReturn(run func(ctx context.Context, source string, settings model.AssetSettings) (model.Asset, error)) *MockAssets_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockAssets
-func (_mock *MockAssets) GetByID(ctx context.Context, id string) (model.Asset, error) {
-	ret := _mock.Called(ctx, id)
+// CreateTrack provides a mock function for the type MockAssets
+func (_mock *MockAssets) CreateTrack(ctx context.Context, assetID string, url string, track model.Track) (model.Track, error) {
+	ret := _mock.Called(ctx, assetID, url, track)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for CreateTrack")
 	}
 
-	var r0 model.Asset
+	var r0 model.Track
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Asset, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Track) (model.Track, error)); ok {
+		return returnFunc(ctx, assetID, url, track)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Asset); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, model.Track) model.Track); ok {
+		r0 = returnFunc(ctx, assetID, url, track)
 	} else {
-		r0 = ret.Get(0).(model.Asset)
+		r0 = ret.Get(0).(model.Track)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, model.Track) error); ok {
+		r1 = returnFunc(ctx, assetID, url, track)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAssets_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockAssets_GetByID_Call struct {
+// MockAssets_CreateTrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrack'
+type MockAssets_CreateTrack_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// CreateTrack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - url string
+//   - track model.Track
+func (_e *MockAssets_Expecter) CreateTrack(ctx interface{}, assetID interface{}, url interface{}, track interface{}) *MockAssets_CreateTrack_Call {
+	return &MockAssets_CreateTrack_Call{Call: _e.mock.On("CreateTrack", ctx, assetID, url, track)}
+}
+
+func (_c *MockAssets_CreateTrack_Call) Run(run func(ctx context.Context, assetID string, url string, track model.Track)) *MockAssets_CreateTrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 model.Track
+		if args[3] != nil {
+			arg3 = args[3].(model.Track)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssets_CreateTrack_Call) Return(track1 model.Track, err error) *MockAssets_CreateTrack_Call {
+	_c.Call.Return(track1, err)
+	return _c
+}
+
+func (_c *MockAssets_CreateTrack_Call) RunAndReturn(run func(ctx context.Context, assetID string, url string, track model.Track) (model.Track, error)) *MockAssets_CreateTrack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockAssets
+func (_mock *MockAssets) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssets_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAssets_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockAssets_Expecter) GetByID(ctx interface{}, id interface{}) *MockAssets_GetByID_Call {
-	return &MockAssets_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockAssets_Expecter) Delete(ctx interface{}, id interface{}) *MockAssets_Delete_Call {
+	return &MockAssets_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
 }
 
-func (_c *MockAssets_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockAssets_GetByID_Call {
+func (_c *MockAssets_Delete_Call) Run(run func(ctx context.Context, id string)) *MockAssets_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -166,90 +316,126 @@ func (_c *MockAssets_GetByID_Call) Run(run func(ctx context.Context, id string))
 	return _c
 }
 
-func (_c *MockAssets_GetByID_Call) Return(asset model.Asset, err error) *MockAssets_GetByID_Call {
-	_c.Call.Return(asset, err)
+func (_c *MockAssets_Delete_Call) Return(err error) *MockAssets_Delete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockAssets_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Asset, error)) *MockAssets_GetByID_Call {
+func (_c *MockAssets_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockAssets_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockVideos creates a new instance of MockVideos. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockVideos(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockVideos {
-	mock := &MockVideos{}
-	mock.Mock.Test(t)
+// DeleteTrack provides a mock function for the type MockAssets
+func (_mock *MockAssets) DeleteTrack(ctx context.Context, assetID string, trackID string) error {
+	ret := _mock.Called(ctx, assetID, trackID)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrack")
+	}
 
-	return mock
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, assetID, trackID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockVideos is an autogenerated mock type for the Videos type
-type MockVideos struct {
-	mock.Mock
+// MockAssets_DeleteTrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrack'
+type MockAssets_DeleteTrack_Call struct {
+	*mock.Call
 }
 
-type MockVideos_Expecter struct {
-	mock *mock.Mock
+// DeleteTrack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - trackID string
+func (_e *MockAssets_Expecter) DeleteTrack(ctx interface{}, assetID interface{}, trackID interface{}) *MockAssets_DeleteTrack_Call {
+	return &MockAssets_DeleteTrack_Call{Call: _e.mock.On("DeleteTrack", ctx, assetID, trackID)}
 }
 
-func (_m *MockVideos) EXPECT() *MockVideos_Expecter {
-	return &MockVideos_Expecter{mock: &_m.Mock}
+func (_c *MockAssets_DeleteTrack_Call) Run(run func(ctx context.Context, assetID string, trackID string)) *MockAssets_DeleteTrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockVideos
-func (_mock *MockVideos) Create(ctx context.Context, anyVideo model.Video) (model.Video, error) {
-	ret := _mock.Called(ctx, anyVideo)
+func (_c *MockAssets_DeleteTrack_Call) Return(err error) *MockAssets_DeleteTrack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssets_DeleteTrack_Call) RunAndReturn(run func(ctx context.Context, assetID string, trackID string) error) *MockAssets_DeleteTrack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableRenditions provides a mock function for the type MockAssets
+func (_mock *MockAssets) EnableRenditions(ctx context.Context, id string) (model.Asset, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for EnableRenditions")
 	}
 
-	var r0 model.Video
+	var r0 model.Asset
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) (model.Video, error)); ok {
-		return returnFunc(ctx, anyVideo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Asset, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) model.Video); ok {
-		r0 = returnFunc(ctx, anyVideo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Asset); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(model.Video)
+		r0 = ret.Get(0).(model.Asset)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video) error); ok {
-		r1 = returnFunc(ctx, anyVideo)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockVideos_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockVideos_Create_Call struct {
+// MockAssets_EnableRenditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableRenditions'
+type MockAssets_EnableRenditions_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// EnableRenditions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - anyVideo model.Video
-func (_e *MockVideos_Expecter) Create(ctx interface{}, anyVideo interface{}) *MockVideos_Create_Call {
-	return &MockVideos_Create_Call{Call: _e.mock.On("Create", ctx, anyVideo)}
+//   - id string
+func (_e *MockAssets_Expecter) EnableRenditions(ctx interface{}, id interface{}) *MockAssets_EnableRenditions_Call {
+	return &MockAssets_EnableRenditions_Call{Call: _e.mock.On("EnableRenditions", ctx, id)}
 }
 
-func (_c *MockVideos_Create_Call) Run(run func(ctx context.Context, anyVideo model.Video)) *MockVideos_Create_Call {
+func (_c *MockAssets_EnableRenditions_Call) Run(run func(ctx context.Context, id string)) *MockAssets_EnableRenditions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 model.Video
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(model.Video)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -259,33 +445,33 @@ func (_c *MockVideos_Create_Call) Run(run func(ctx context.Context, anyVideo mod
 	return _c
 }
 
-func (_c *MockVideos_Create_Call) Return(video model.Video, err error) *MockVideos_Create_Call {
-	_c.Call.Return(video, err)
+func (_c *MockAssets_EnableRenditions_Call) Return(asset model.Asset, err error) *MockAssets_EnableRenditions_Call {
+	_c.Call.Return(asset, err)
 	return _c
 }
 
-func (_c *MockVideos_Create_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video) (model.Video, error)) *MockVideos_Create_Call {
+func (_c *MockAssets_EnableRenditions_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Asset, error)) *MockAssets_EnableRenditions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockVideos
-func (_mock *MockVideos) GetByID(ctx context.Context, id string) (model.Video, error) {
+// GetByID provides a mock function for the type MockAssets
+func (_mock *MockAssets) GetByID(ctx context.Context, id string) (model.Asset, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
 	}
 
-	var r0 model.Video
+	var r0 model.Asset
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Asset, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Asset); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(model.Video)
+		r0 = ret.Get(0).(model.Asset)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = returnFunc(ctx, id)
@@ -295,19 +481,19 @@ func (_mock *MockVideos) GetByID(ctx context.Context, id string) (model.Video, e
 	return r0, r1
 }
 
-// MockVideos_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockVideos_GetByID_Call struct {
+// MockAssets_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockAssets_GetByID_Call struct {
 	*mock.Call
 }
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockVideos_Expecter) GetByID(ctx interface{}, id interface{}) *MockVideos_GetByID_Call {
-	return &MockVideos_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockAssets_Expecter) GetByID(ctx interface{}, id interface{}) *MockAssets_GetByID_Call {
+	return &MockAssets_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
 }
 
-func (_c *MockVideos_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockVideos_GetByID_Call {
+func (_c *MockAssets_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockAssets_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -325,70 +511,68 @@ func (_c *MockVideos_GetByID_Call) Run(run func(ctx context.Context, id string))
 	return _c
 }
 
-func (_c *MockVideos_GetByID_Call) Return(video model.Video, err error) *MockVideos_GetByID_Call {
-	_c.Call.Return(video, err)
+func (_c *MockAssets_GetByID_Call) Return(asset model.Asset, err error) *MockAssets_GetByID_Call {
+	_c.Call.Return(asset, err)
 	return _c
 }
 
-func (_c *MockVideos_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockVideos_GetByID_Call {
+func (_c *MockAssets_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Asset, error)) *MockAssets_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockVideos
-func (_mock *MockVideos) List(ctx context.Context, page int, limit int) ([]model.Video, error) {
-	ret := _mock.Called(ctx, page, limit)
+// Hydrate provides a mock function for the type MockAssets
+func (_mock *MockAssets) Hydrate(ctx context.Context, asset model.Asset, opts model.HydrateOptions) (model.Asset, error) {
+	ret := _mock.Called(ctx, asset, opts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for Hydrate")
 	}
 
-	var r0 []model.Video
+	var r0 model.Asset
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]model.Video, error)); ok {
-		return returnFunc(ctx, page, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, model.HydrateOptions) (model.Asset, error)); ok {
+		return returnFunc(ctx, asset, opts)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []model.Video); ok {
-		r0 = returnFunc(ctx, page, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, model.HydrateOptions) model.Asset); ok {
+		r0 = returnFunc(ctx, asset, opts)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Video)
-		}
+		r0 = ret.Get(0).(model.Asset)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
-		r1 = returnFunc(ctx, page, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Asset, model.HydrateOptions) error); ok {
+		r1 = returnFunc(ctx, asset, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockVideos_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockVideos_List_Call struct {
+// MockAssets_Hydrate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hydrate'
+type MockAssets_Hydrate_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// Hydrate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
-//   - limit int
-func (_e *MockVideos_Expecter) List(ctx interface{}, page interface{}, limit interface{}) *MockVideos_List_Call {
-	return &MockVideos_List_Call{Call: _e.mock.On("List", ctx, page, limit)}
+//   - asset model.Asset
+//   - opts model.HydrateOptions
+func (_e *MockAssets_Expecter) Hydrate(ctx interface{}, asset interface{}, opts interface{}) *MockAssets_Hydrate_Call {
+	return &MockAssets_Hydrate_Call{Call: _e.mock.On("Hydrate", ctx, asset, opts)}
 }
 
-func (_c *MockVideos_List_Call) Run(run func(ctx context.Context, page int, limit int)) *MockVideos_List_Call {
+func (_c *MockAssets_Hydrate_Call) Run(run func(ctx context.Context, asset model.Asset, opts model.HydrateOptions)) *MockAssets_Hydrate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 model.Asset
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(model.Asset)
 		}
-		var arg2 int
+		var arg2 model.HydrateOptions
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(model.HydrateOptions)
 		}
 		run(
 			arg0,
@@ -399,12 +583,4373 @@ func (_c *MockVideos_List_Call) Run(run func(ctx context.Context, page int, limi
 	return _c
 }
 
-func (_c *MockVideos_List_Call) Return(videos []model.Video, err error) *MockVideos_List_Call {
-	_c.Call.Return(videos, err)
+func (_c *MockAssets_Hydrate_Call) Return(asset1 model.Asset, err error) *MockAssets_Hydrate_Call {
+	_c.Call.Return(asset1, err)
+	return _c
+}
+
+func (_c *MockAssets_Hydrate_Call) RunAndReturn(run func(ctx context.Context, asset model.Asset, opts model.HydrateOptions) (model.Asset, error)) *MockAssets_Hydrate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Image provides a mock function for the type MockAssets
+func (_mock *MockAssets) Image(ctx context.Context, asset model.Asset, opts model.ImageOptions) (model.Image, error) {
+	ret := _mock.Called(ctx, asset, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Image")
+	}
+
+	var r0 model.Image
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, model.ImageOptions) (model.Image, error)); ok {
+		return returnFunc(ctx, asset, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, model.ImageOptions) model.Image); ok {
+		r0 = returnFunc(ctx, asset, opts)
+	} else {
+		r0 = ret.Get(0).(model.Image)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Asset, model.ImageOptions) error); ok {
+		r1 = returnFunc(ctx, asset, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssets_Image_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Image'
+type MockAssets_Image_Call struct {
+	*mock.Call
+}
+
+// Image is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asset model.Asset
+//   - opts model.ImageOptions
+func (_e *MockAssets_Expecter) Image(ctx interface{}, asset interface{}, opts interface{}) *MockAssets_Image_Call {
+	return &MockAssets_Image_Call{Call: _e.mock.On("Image", ctx, asset, opts)}
+}
+
+func (_c *MockAssets_Image_Call) Run(run func(ctx context.Context, asset model.Asset, opts model.ImageOptions)) *MockAssets_Image_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Asset
+		if args[1] != nil {
+			arg1 = args[1].(model.Asset)
+		}
+		var arg2 model.ImageOptions
+		if args[2] != nil {
+			arg2 = args[2].(model.ImageOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssets_Image_Call) Return(image model.Image, err error) *MockAssets_Image_Call {
+	_c.Call.Return(image, err)
+	return _c
+}
+
+func (_c *MockAssets_Image_Call) RunAndReturn(run func(ctx context.Context, asset model.Asset, opts model.ImageOptions) (model.Image, error)) *MockAssets_Image_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockAssets
+func (_mock *MockAssets) List(ctx context.Context, page int, limit int) ([]model.Asset, error) {
+	ret := _mock.Called(ctx, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.Asset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]model.Asset, error)); ok {
+		return returnFunc(ctx, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []model.Asset); ok {
+		r0 = returnFunc(ctx, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Asset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssets_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAssets_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - limit int
+func (_e *MockAssets_Expecter) List(ctx interface{}, page interface{}, limit interface{}) *MockAssets_List_Call {
+	return &MockAssets_List_Call{Call: _e.mock.On("List", ctx, page, limit)}
+}
+
+func (_c *MockAssets_List_Call) Run(run func(ctx context.Context, page int, limit int)) *MockAssets_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssets_List_Call) Return(assets []model.Asset, err error) *MockAssets_List_Call {
+	_c.Call.Return(assets, err)
+	return _c
+}
+
+func (_c *MockAssets_List_Call) RunAndReturn(run func(ctx context.Context, page int, limit int) ([]model.Asset, error)) *MockAssets_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Token provides a mock function for the type MockAssets
+func (_mock *MockAssets) Token(ctx context.Context, asset model.Asset, request model.TokenRequest) (model.PlaybackToken, error) {
+	ret := _mock.Called(ctx, asset, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Token")
+	}
+
+	var r0 model.PlaybackToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, model.TokenRequest) (model.PlaybackToken, error)); ok {
+		return returnFunc(ctx, asset, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Asset, model.TokenRequest) model.PlaybackToken); ok {
+		r0 = returnFunc(ctx, asset, request)
+	} else {
+		r0 = ret.Get(0).(model.PlaybackToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Asset, model.TokenRequest) error); ok {
+		r1 = returnFunc(ctx, asset, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssets_Token_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Token'
+type MockAssets_Token_Call struct {
+	*mock.Call
+}
+
+// Token is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asset model.Asset
+//   - request model.TokenRequest
+func (_e *MockAssets_Expecter) Token(ctx interface{}, asset interface{}, request interface{}) *MockAssets_Token_Call {
+	return &MockAssets_Token_Call{Call: _e.mock.On("Token", ctx, asset, request)}
+}
+
+func (_c *MockAssets_Token_Call) Run(run func(ctx context.Context, asset model.Asset, request model.TokenRequest)) *MockAssets_Token_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Asset
+		if args[1] != nil {
+			arg1 = args[1].(model.Asset)
+		}
+		var arg2 model.TokenRequest
+		if args[2] != nil {
+			arg2 = args[2].(model.TokenRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssets_Token_Call) Return(playbackToken model.PlaybackToken, err error) *MockAssets_Token_Call {
+	_c.Call.Return(playbackToken, err)
+	return _c
+}
+
+func (_c *MockAssets_Token_Call) RunAndReturn(run func(ctx context.Context, asset model.Asset, request model.TokenRequest) (model.PlaybackToken, error)) *MockAssets_Token_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCaptions creates a new instance of MockCaptions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCaptions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCaptions {
+	mock := &MockCaptions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCaptions is an autogenerated mock type for the Captions type
+type MockCaptions struct {
+	mock.Mock
+}
+
+type MockCaptions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCaptions) EXPECT() *MockCaptions_Expecter {
+	return &MockCaptions_Expecter{mock: &_m.Mock}
+}
+
+// CreateCaption provides a mock function for the type MockCaptions
+func (_mock *MockCaptions) CreateCaption(ctx context.Context, anyCaption model.Caption) (model.Caption, error) {
+	ret := _mock.Called(ctx, anyCaption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCaption")
+	}
+
+	var r0 model.Caption
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Caption) (model.Caption, error)); ok {
+		return returnFunc(ctx, anyCaption)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Caption) model.Caption); ok {
+		r0 = returnFunc(ctx, anyCaption)
+	} else {
+		r0 = ret.Get(0).(model.Caption)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Caption) error); ok {
+		r1 = returnFunc(ctx, anyCaption)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCaptions_CreateCaption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCaption'
+type MockCaptions_CreateCaption_Call struct {
+	*mock.Call
+}
+
+// CreateCaption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyCaption model.Caption
+func (_e *MockCaptions_Expecter) CreateCaption(ctx interface{}, anyCaption interface{}) *MockCaptions_CreateCaption_Call {
+	return &MockCaptions_CreateCaption_Call{Call: _e.mock.On("CreateCaption", ctx, anyCaption)}
+}
+
+func (_c *MockCaptions_CreateCaption_Call) Run(run func(ctx context.Context, anyCaption model.Caption)) *MockCaptions_CreateCaption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Caption
+		if args[1] != nil {
+			arg1 = args[1].(model.Caption)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCaptions_CreateCaption_Call) Return(caption model.Caption, err error) *MockCaptions_CreateCaption_Call {
+	_c.Call.Return(caption, err)
+	return _c
+}
+
+func (_c *MockCaptions_CreateCaption_Call) RunAndReturn(run func(ctx context.Context, anyCaption model.Caption) (model.Caption, error)) *MockCaptions_CreateCaption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCaption provides a mock function for the type MockCaptions
+func (_mock *MockCaptions) DeleteCaption(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCaption")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCaptions_DeleteCaption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCaption'
+type MockCaptions_DeleteCaption_Call struct {
+	*mock.Call
+}
+
+// DeleteCaption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCaptions_Expecter) DeleteCaption(ctx interface{}, id interface{}) *MockCaptions_DeleteCaption_Call {
+	return &MockCaptions_DeleteCaption_Call{Call: _e.mock.On("DeleteCaption", ctx, id)}
+}
+
+func (_c *MockCaptions_DeleteCaption_Call) Run(run func(ctx context.Context, id string)) *MockCaptions_DeleteCaption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCaptions_DeleteCaption_Call) Return(err error) *MockCaptions_DeleteCaption_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCaptions_DeleteCaption_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockCaptions_DeleteCaption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCaption provides a mock function for the type MockCaptions
+func (_mock *MockCaptions) GetCaption(ctx context.Context, id string) (model.Caption, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaption")
+	}
+
+	var r0 model.Caption
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Caption, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Caption); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Caption)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCaptions_GetCaption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCaption'
+type MockCaptions_GetCaption_Call struct {
+	*mock.Call
+}
+
+// GetCaption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCaptions_Expecter) GetCaption(ctx interface{}, id interface{}) *MockCaptions_GetCaption_Call {
+	return &MockCaptions_GetCaption_Call{Call: _e.mock.On("GetCaption", ctx, id)}
+}
+
+func (_c *MockCaptions_GetCaption_Call) Run(run func(ctx context.Context, id string)) *MockCaptions_GetCaption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCaptions_GetCaption_Call) Return(caption model.Caption, err error) *MockCaptions_GetCaption_Call {
+	_c.Call.Return(caption, err)
+	return _c
+}
+
+func (_c *MockCaptions_GetCaption_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Caption, error)) *MockCaptions_GetCaption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCleanups creates a new instance of MockCleanups. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCleanups(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCleanups {
+	mock := &MockCleanups{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCleanups is an autogenerated mock type for the Cleanups type
+type MockCleanups struct {
+	mock.Mock
+}
+
+type MockCleanups_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCleanups) EXPECT() *MockCleanups_Expecter {
+	return &MockCleanups_Expecter{mock: &_m.Mock}
+}
+
+// CreateCleanup provides a mock function for the type MockCleanups
+func (_mock *MockCleanups) CreateCleanup(ctx context.Context, anyCleanup model.Cleanup) (model.Cleanup, error) {
+	ret := _mock.Called(ctx, anyCleanup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCleanup")
+	}
+
+	var r0 model.Cleanup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Cleanup) (model.Cleanup, error)); ok {
+		return returnFunc(ctx, anyCleanup)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Cleanup) model.Cleanup); ok {
+		r0 = returnFunc(ctx, anyCleanup)
+	} else {
+		r0 = ret.Get(0).(model.Cleanup)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Cleanup) error); ok {
+		r1 = returnFunc(ctx, anyCleanup)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCleanups_CreateCleanup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCleanup'
+type MockCleanups_CreateCleanup_Call struct {
+	*mock.Call
+}
+
+// CreateCleanup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyCleanup model.Cleanup
+func (_e *MockCleanups_Expecter) CreateCleanup(ctx interface{}, anyCleanup interface{}) *MockCleanups_CreateCleanup_Call {
+	return &MockCleanups_CreateCleanup_Call{Call: _e.mock.On("CreateCleanup", ctx, anyCleanup)}
+}
+
+func (_c *MockCleanups_CreateCleanup_Call) Run(run func(ctx context.Context, anyCleanup model.Cleanup)) *MockCleanups_CreateCleanup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Cleanup
+		if args[1] != nil {
+			arg1 = args[1].(model.Cleanup)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCleanups_CreateCleanup_Call) Return(cleanup model.Cleanup, err error) *MockCleanups_CreateCleanup_Call {
+	_c.Call.Return(cleanup, err)
+	return _c
+}
+
+func (_c *MockCleanups_CreateCleanup_Call) RunAndReturn(run func(ctx context.Context, anyCleanup model.Cleanup) (model.Cleanup, error)) *MockCleanups_CreateCleanup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCleanup provides a mock function for the type MockCleanups
+func (_mock *MockCleanups) DeleteCleanup(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCleanup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCleanups_DeleteCleanup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCleanup'
+type MockCleanups_DeleteCleanup_Call struct {
+	*mock.Call
+}
+
+// DeleteCleanup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCleanups_Expecter) DeleteCleanup(ctx interface{}, id interface{}) *MockCleanups_DeleteCleanup_Call {
+	return &MockCleanups_DeleteCleanup_Call{Call: _e.mock.On("DeleteCleanup", ctx, id)}
+}
+
+func (_c *MockCleanups_DeleteCleanup_Call) Run(run func(ctx context.Context, id string)) *MockCleanups_DeleteCleanup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCleanups_DeleteCleanup_Call) Return(err error) *MockCleanups_DeleteCleanup_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCleanups_DeleteCleanup_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockCleanups_DeleteCleanup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCleanups provides a mock function for the type MockCleanups
+func (_mock *MockCleanups) ListCleanups(ctx context.Context, limit int) ([]model.Cleanup, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCleanups")
+	}
+
+	var r0 []model.Cleanup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]model.Cleanup, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []model.Cleanup); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Cleanup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCleanups_ListCleanups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCleanups'
+type MockCleanups_ListCleanups_Call struct {
+	*mock.Call
+}
+
+// ListCleanups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockCleanups_Expecter) ListCleanups(ctx interface{}, limit interface{}) *MockCleanups_ListCleanups_Call {
+	return &MockCleanups_ListCleanups_Call{Call: _e.mock.On("ListCleanups", ctx, limit)}
+}
+
+func (_c *MockCleanups_ListCleanups_Call) Run(run func(ctx context.Context, limit int)) *MockCleanups_ListCleanups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCleanups_ListCleanups_Call) Return(cleanups []model.Cleanup, err error) *MockCleanups_ListCleanups_Call {
+	_c.Call.Return(cleanups, err)
+	return _c
+}
+
+func (_c *MockCleanups_ListCleanups_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]model.Cleanup, error)) *MockCleanups_ListCleanups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryCleanup provides a mock function for the type MockCleanups
+func (_mock *MockCleanups) RetryCleanup(ctx context.Context, id string, reason string) error {
+	ret := _mock.Called(ctx, id, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryCleanup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCleanups_RetryCleanup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryCleanup'
+type MockCleanups_RetryCleanup_Call struct {
+	*mock.Call
+}
+
+// RetryCleanup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - reason string
+func (_e *MockCleanups_Expecter) RetryCleanup(ctx interface{}, id interface{}, reason interface{}) *MockCleanups_RetryCleanup_Call {
+	return &MockCleanups_RetryCleanup_Call{Call: _e.mock.On("RetryCleanup", ctx, id, reason)}
+}
+
+func (_c *MockCleanups_RetryCleanup_Call) Run(run func(ctx context.Context, id string, reason string)) *MockCleanups_RetryCleanup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCleanups_RetryCleanup_Call) Return(err error) *MockCleanups_RetryCleanup_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCleanups_RetryCleanup_Call) RunAndReturn(run func(ctx context.Context, id string, reason string) error) *MockCleanups_RetryCleanup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDirectUploads creates a new instance of MockDirectUploads. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDirectUploads(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDirectUploads {
+	mock := &MockDirectUploads{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDirectUploads is an autogenerated mock type for the DirectUploads type
+type MockDirectUploads struct {
+	mock.Mock
+}
+
+type MockDirectUploads_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDirectUploads) EXPECT() *MockDirectUploads_Expecter {
+	return &MockDirectUploads_Expecter{mock: &_m.Mock}
+}
+
+// CreateDirectUpload provides a mock function for the type MockDirectUploads
+func (_mock *MockDirectUploads) CreateDirectUpload(ctx context.Context, settings model.AssetSettings, corsOrigin string) (model.Upload, error) {
+	ret := _mock.Called(ctx, settings, corsOrigin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDirectUpload")
+	}
+
+	var r0 model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssetSettings, string) (model.Upload, error)); ok {
+		return returnFunc(ctx, settings, corsOrigin)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssetSettings, string) model.Upload); ok {
+		r0 = returnFunc(ctx, settings, corsOrigin)
+	} else {
+		r0 = ret.Get(0).(model.Upload)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.AssetSettings, string) error); ok {
+		r1 = returnFunc(ctx, settings, corsOrigin)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDirectUploads_CreateDirectUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDirectUpload'
+type MockDirectUploads_CreateDirectUpload_Call struct {
+	*mock.Call
+}
+
+// CreateDirectUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settings model.AssetSettings
+//   - corsOrigin string
+func (_e *MockDirectUploads_Expecter) CreateDirectUpload(ctx interface{}, settings interface{}, corsOrigin interface{}) *MockDirectUploads_CreateDirectUpload_Call {
+	return &MockDirectUploads_CreateDirectUpload_Call{Call: _e.mock.On("CreateDirectUpload", ctx, settings, corsOrigin)}
+}
+
+func (_c *MockDirectUploads_CreateDirectUpload_Call) Run(run func(ctx context.Context, settings model.AssetSettings, corsOrigin string)) *MockDirectUploads_CreateDirectUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssetSettings
+		if args[1] != nil {
+			arg1 = args[1].(model.AssetSettings)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDirectUploads_CreateDirectUpload_Call) Return(upload model.Upload, err error) *MockDirectUploads_CreateDirectUpload_Call {
+	_c.Call.Return(upload, err)
+	return _c
+}
+
+func (_c *MockDirectUploads_CreateDirectUpload_Call) RunAndReturn(run func(ctx context.Context, settings model.AssetSettings, corsOrigin string) (model.Upload, error)) *MockDirectUploads_CreateDirectUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDirectUpload provides a mock function for the type MockDirectUploads
+func (_mock *MockDirectUploads) GetDirectUpload(ctx context.Context, id string) (model.Upload, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDirectUpload")
+	}
+
+	var r0 model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Upload, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Upload); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Upload)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDirectUploads_GetDirectUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDirectUpload'
+type MockDirectUploads_GetDirectUpload_Call struct {
+	*mock.Call
+}
+
+// GetDirectUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockDirectUploads_Expecter) GetDirectUpload(ctx interface{}, id interface{}) *MockDirectUploads_GetDirectUpload_Call {
+	return &MockDirectUploads_GetDirectUpload_Call{Call: _e.mock.On("GetDirectUpload", ctx, id)}
+}
+
+func (_c *MockDirectUploads_GetDirectUpload_Call) Run(run func(ctx context.Context, id string)) *MockDirectUploads_GetDirectUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDirectUploads_GetDirectUpload_Call) Return(upload model.Upload, err error) *MockDirectUploads_GetDirectUpload_Call {
+	_c.Call.Return(upload, err)
+	return _c
+}
+
+func (_c *MockDirectUploads_GetDirectUpload_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Upload, error)) *MockDirectUploads_GetDirectUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJobs creates a new instance of MockJobs. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJobs(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJobs {
+	mock := &MockJobs{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJobs is an autogenerated mock type for the Jobs type
+type MockJobs struct {
+	mock.Mock
+}
+
+type MockJobs_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJobs) EXPECT() *MockJobs_Expecter {
+	return &MockJobs_Expecter{mock: &_m.Mock}
+}
+
+// ClaimJob provides a mock function for the type MockJobs
+func (_mock *MockJobs) ClaimJob(ctx context.Context, lease time.Duration) (model.Job, error) {
+	ret := _mock.Called(ctx, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJob")
+	}
+
+	var r0 model.Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) (model.Job, error)); ok {
+		return returnFunc(ctx, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) model.Job); ok {
+		r0 = returnFunc(ctx, lease)
+	} else {
+		r0 = ret.Get(0).(model.Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = returnFunc(ctx, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobs_ClaimJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJob'
+type MockJobs_ClaimJob_Call struct {
+	*mock.Call
+}
+
+// ClaimJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lease time.Duration
+func (_e *MockJobs_Expecter) ClaimJob(ctx interface{}, lease interface{}) *MockJobs_ClaimJob_Call {
+	return &MockJobs_ClaimJob_Call{Call: _e.mock.On("ClaimJob", ctx, lease)}
+}
+
+func (_c *MockJobs_ClaimJob_Call) Run(run func(ctx context.Context, lease time.Duration)) *MockJobs_ClaimJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobs_ClaimJob_Call) Return(job model.Job, err error) *MockJobs_ClaimJob_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockJobs_ClaimJob_Call) RunAndReturn(run func(ctx context.Context, lease time.Duration) (model.Job, error)) *MockJobs_ClaimJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJob provides a mock function for the type MockJobs
+func (_mock *MockJobs) CreateJob(ctx context.Context, anyJob model.Job) (model.Job, error) {
+	ret := _mock.Called(ctx, anyJob)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+
+	var r0 model.Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Job) (model.Job, error)); ok {
+		return returnFunc(ctx, anyJob)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Job) model.Job); ok {
+		r0 = returnFunc(ctx, anyJob)
+	} else {
+		r0 = ret.Get(0).(model.Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Job) error); ok {
+		r1 = returnFunc(ctx, anyJob)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobs_CreateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJob'
+type MockJobs_CreateJob_Call struct {
+	*mock.Call
+}
+
+// CreateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyJob model.Job
+func (_e *MockJobs_Expecter) CreateJob(ctx interface{}, anyJob interface{}) *MockJobs_CreateJob_Call {
+	return &MockJobs_CreateJob_Call{Call: _e.mock.On("CreateJob", ctx, anyJob)}
+}
+
+func (_c *MockJobs_CreateJob_Call) Run(run func(ctx context.Context, anyJob model.Job)) *MockJobs_CreateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Job
+		if args[1] != nil {
+			arg1 = args[1].(model.Job)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobs_CreateJob_Call) Return(job model.Job, err error) *MockJobs_CreateJob_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockJobs_CreateJob_Call) RunAndReturn(run func(ctx context.Context, anyJob model.Job) (model.Job, error)) *MockJobs_CreateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteJob provides a mock function for the type MockJobs
+func (_mock *MockJobs) DeleteJob(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJobs_DeleteJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJob'
+type MockJobs_DeleteJob_Call struct {
+	*mock.Call
+}
+
+// DeleteJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockJobs_Expecter) DeleteJob(ctx interface{}, id interface{}) *MockJobs_DeleteJob_Call {
+	return &MockJobs_DeleteJob_Call{Call: _e.mock.On("DeleteJob", ctx, id)}
+}
+
+func (_c *MockJobs_DeleteJob_Call) Run(run func(ctx context.Context, id string)) *MockJobs_DeleteJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobs_DeleteJob_Call) Return(err error) *MockJobs_DeleteJob_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJobs_DeleteJob_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockJobs_DeleteJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailJob provides a mock function for the type MockJobs
+func (_mock *MockJobs) FailJob(ctx context.Context, id string, reason string) error {
+	ret := _mock.Called(ctx, id, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailJob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJobs_FailJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailJob'
+type MockJobs_FailJob_Call struct {
+	*mock.Call
+}
+
+// FailJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - reason string
+func (_e *MockJobs_Expecter) FailJob(ctx interface{}, id interface{}, reason interface{}) *MockJobs_FailJob_Call {
+	return &MockJobs_FailJob_Call{Call: _e.mock.On("FailJob", ctx, id, reason)}
+}
+
+func (_c *MockJobs_FailJob_Call) Run(run func(ctx context.Context, id string, reason string)) *MockJobs_FailJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobs_FailJob_Call) Return(err error) *MockJobs_FailJob_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJobs_FailJob_Call) RunAndReturn(run func(ctx context.Context, id string, reason string) error) *MockJobs_FailJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeueJob provides a mock function for the type MockJobs
+func (_mock *MockJobs) RequeueJob(ctx context.Context, videoID string) (model.Job, error) {
+	ret := _mock.Called(ctx, videoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueJob")
+	}
+
+	var r0 model.Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Job, error)); ok {
+		return returnFunc(ctx, videoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Job); ok {
+		r0 = returnFunc(ctx, videoID)
+	} else {
+		r0 = ret.Get(0).(model.Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, videoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobs_RequeueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueJob'
+type MockJobs_RequeueJob_Call struct {
+	*mock.Call
+}
+
+// RequeueJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - videoID string
+func (_e *MockJobs_Expecter) RequeueJob(ctx interface{}, videoID interface{}) *MockJobs_RequeueJob_Call {
+	return &MockJobs_RequeueJob_Call{Call: _e.mock.On("RequeueJob", ctx, videoID)}
+}
+
+func (_c *MockJobs_RequeueJob_Call) Run(run func(ctx context.Context, videoID string)) *MockJobs_RequeueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobs_RequeueJob_Call) Return(job model.Job, err error) *MockJobs_RequeueJob_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockJobs_RequeueJob_Call) RunAndReturn(run func(ctx context.Context, videoID string) (model.Job, error)) *MockJobs_RequeueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryJob provides a mock function for the type MockJobs
+func (_mock *MockJobs) RetryJob(ctx context.Context, id string, reason string, runAt time.Time) error {
+	ret := _mock.Called(ctx, id, reason, runAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryJob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, reason, runAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJobs_RetryJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryJob'
+type MockJobs_RetryJob_Call struct {
+	*mock.Call
+}
+
+// RetryJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - reason string
+//   - runAt time.Time
+func (_e *MockJobs_Expecter) RetryJob(ctx interface{}, id interface{}, reason interface{}, runAt interface{}) *MockJobs_RetryJob_Call {
+	return &MockJobs_RetryJob_Call{Call: _e.mock.On("RetryJob", ctx, id, reason, runAt)}
+}
+
+func (_c *MockJobs_RetryJob_Call) Run(run func(ctx context.Context, id string, reason string, runAt time.Time)) *MockJobs_RetryJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobs_RetryJob_Call) Return(err error) *MockJobs_RetryJob_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJobs_RetryJob_Call) RunAndReturn(run func(ctx context.Context, id string, reason string, runAt time.Time) error) *MockJobs_RetryJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLeases creates a new instance of MockLeases. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLeases(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLeases {
+	mock := &MockLeases{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLeases is an autogenerated mock type for the Leases type
+type MockLeases struct {
+	mock.Mock
+}
+
+type MockLeases_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLeases) EXPECT() *MockLeases_Expecter {
+	return &MockLeases_Expecter{mock: &_m.Mock}
+}
+
+// ClaimLease provides a mock function for the type MockLeases
+func (_mock *MockLeases) ClaimLease(ctx context.Context, name string, holder string, lease time.Duration) (bool, error) {
+	ret := _mock.Called(ctx, name, holder, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimLease")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return returnFunc(ctx, name, holder, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
+		r0 = returnFunc(ctx, name, holder, lease)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, name, holder, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLeases_ClaimLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimLease'
+type MockLeases_ClaimLease_Call struct {
+	*mock.Call
+}
+
+// ClaimLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - holder string
+//   - lease time.Duration
+func (_e *MockLeases_Expecter) ClaimLease(ctx interface{}, name interface{}, holder interface{}, lease interface{}) *MockLeases_ClaimLease_Call {
+	return &MockLeases_ClaimLease_Call{Call: _e.mock.On("ClaimLease", ctx, name, holder, lease)}
+}
+
+func (_c *MockLeases_ClaimLease_Call) Run(run func(ctx context.Context, name string, holder string, lease time.Duration)) *MockLeases_ClaimLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeases_ClaimLease_Call) Return(b bool, err error) *MockLeases_ClaimLease_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockLeases_ClaimLease_Call) RunAndReturn(run func(ctx context.Context, name string, holder string, lease time.Duration) (bool, error)) *MockLeases_ClaimLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLiveStreams creates a new instance of MockLiveStreams. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLiveStreams(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLiveStreams {
+	mock := &MockLiveStreams{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLiveStreams is an autogenerated mock type for the LiveStreams type
+type MockLiveStreams struct {
+	mock.Mock
+}
+
+type MockLiveStreams_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLiveStreams) EXPECT() *MockLiveStreams_Expecter {
+	return &MockLiveStreams_Expecter{mock: &_m.Mock}
+}
+
+// CreateLiveStream provides a mock function for the type MockLiveStreams
+func (_mock *MockLiveStreams) CreateLiveStream(ctx context.Context, settings model.AssetSettings) (model.LiveStream, error) {
+	ret := _mock.Called(ctx, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLiveStream")
+	}
+
+	var r0 model.LiveStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssetSettings) (model.LiveStream, error)); ok {
+		return returnFunc(ctx, settings)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AssetSettings) model.LiveStream); ok {
+		r0 = returnFunc(ctx, settings)
+	} else {
+		r0 = ret.Get(0).(model.LiveStream)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.AssetSettings) error); ok {
+		r1 = returnFunc(ctx, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLiveStreams_CreateLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLiveStream'
+type MockLiveStreams_CreateLiveStream_Call struct {
+	*mock.Call
+}
+
+// CreateLiveStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - settings model.AssetSettings
+func (_e *MockLiveStreams_Expecter) CreateLiveStream(ctx interface{}, settings interface{}) *MockLiveStreams_CreateLiveStream_Call {
+	return &MockLiveStreams_CreateLiveStream_Call{Call: _e.mock.On("CreateLiveStream", ctx, settings)}
+}
+
+func (_c *MockLiveStreams_CreateLiveStream_Call) Run(run func(ctx context.Context, settings model.AssetSettings)) *MockLiveStreams_CreateLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AssetSettings
+		if args[1] != nil {
+			arg1 = args[1].(model.AssetSettings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLiveStreams_CreateLiveStream_Call) Return(liveStream model.LiveStream, err error) *MockLiveStreams_CreateLiveStream_Call {
+	_c.Call.Return(liveStream, err)
+	return _c
+}
+
+func (_c *MockLiveStreams_CreateLiveStream_Call) RunAndReturn(run func(ctx context.Context, settings model.AssetSettings) (model.LiveStream, error)) *MockLiveStreams_CreateLiveStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLiveStream provides a mock function for the type MockLiveStreams
+func (_mock *MockLiveStreams) DeleteLiveStream(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLiveStream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLiveStreams_DeleteLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLiveStream'
+type MockLiveStreams_DeleteLiveStream_Call struct {
+	*mock.Call
+}
+
+// DeleteLiveStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockLiveStreams_Expecter) DeleteLiveStream(ctx interface{}, id interface{}) *MockLiveStreams_DeleteLiveStream_Call {
+	return &MockLiveStreams_DeleteLiveStream_Call{Call: _e.mock.On("DeleteLiveStream", ctx, id)}
+}
+
+func (_c *MockLiveStreams_DeleteLiveStream_Call) Run(run func(ctx context.Context, id string)) *MockLiveStreams_DeleteLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLiveStreams_DeleteLiveStream_Call) Return(err error) *MockLiveStreams_DeleteLiveStream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLiveStreams_DeleteLiveStream_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockLiveStreams_DeleteLiveStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableLiveStream provides a mock function for the type MockLiveStreams
+func (_mock *MockLiveStreams) DisableLiveStream(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableLiveStream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLiveStreams_DisableLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableLiveStream'
+type MockLiveStreams_DisableLiveStream_Call struct {
+	*mock.Call
+}
+
+// DisableLiveStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockLiveStreams_Expecter) DisableLiveStream(ctx interface{}, id interface{}) *MockLiveStreams_DisableLiveStream_Call {
+	return &MockLiveStreams_DisableLiveStream_Call{Call: _e.mock.On("DisableLiveStream", ctx, id)}
+}
+
+func (_c *MockLiveStreams_DisableLiveStream_Call) Run(run func(ctx context.Context, id string)) *MockLiveStreams_DisableLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLiveStreams_DisableLiveStream_Call) Return(err error) *MockLiveStreams_DisableLiveStream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLiveStreams_DisableLiveStream_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockLiveStreams_DisableLiveStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HydrateLiveStream provides a mock function for the type MockLiveStreams
+func (_mock *MockLiveStreams) HydrateLiveStream(ctx context.Context, stream model.LiveStream) (model.LiveStream, error) {
+	ret := _mock.Called(ctx, stream)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HydrateLiveStream")
+	}
+
+	var r0 model.LiveStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.LiveStream) (model.LiveStream, error)); ok {
+		return returnFunc(ctx, stream)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.LiveStream) model.LiveStream); ok {
+		r0 = returnFunc(ctx, stream)
+	} else {
+		r0 = ret.Get(0).(model.LiveStream)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.LiveStream) error); ok {
+		r1 = returnFunc(ctx, stream)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLiveStreams_HydrateLiveStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HydrateLiveStream'
+type MockLiveStreams_HydrateLiveStream_Call struct {
+	*mock.Call
+}
+
+// HydrateLiveStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream model.LiveStream
+func (_e *MockLiveStreams_Expecter) HydrateLiveStream(ctx interface{}, stream interface{}) *MockLiveStreams_HydrateLiveStream_Call {
+	return &MockLiveStreams_HydrateLiveStream_Call{Call: _e.mock.On("HydrateLiveStream", ctx, stream)}
+}
+
+func (_c *MockLiveStreams_HydrateLiveStream_Call) Run(run func(ctx context.Context, stream model.LiveStream)) *MockLiveStreams_HydrateLiveStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.LiveStream
+		if args[1] != nil {
+			arg1 = args[1].(model.LiveStream)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLiveStreams_HydrateLiveStream_Call) Return(liveStream model.LiveStream, err error) *MockLiveStreams_HydrateLiveStream_Call {
+	_c.Call.Return(liveStream, err)
+	return _c
+}
+
+func (_c *MockLiveStreams_HydrateLiveStream_Call) RunAndReturn(run func(ctx context.Context, stream model.LiveStream) (model.LiveStream, error)) *MockLiveStreams_HydrateLiveStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPlaylists creates a new instance of MockPlaylists. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPlaylists(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPlaylists {
+	mock := &MockPlaylists{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPlaylists is an autogenerated mock type for the Playlists type
+type MockPlaylists struct {
+	mock.Mock
+}
+
+type MockPlaylists_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPlaylists) EXPECT() *MockPlaylists_Expecter {
+	return &MockPlaylists_Expecter{mock: &_m.Mock}
+}
+
+// CreatePlaylist provides a mock function for the type MockPlaylists
+func (_mock *MockPlaylists) CreatePlaylist(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	ret := _mock.Called(ctx, anyPlaylist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlaylist")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) (model.Playlist, error)); ok {
+		return returnFunc(ctx, anyPlaylist)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) model.Playlist); ok {
+		r0 = returnFunc(ctx, anyPlaylist)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Playlist) error); ok {
+		r1 = returnFunc(ctx, anyPlaylist)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlaylists_CreatePlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePlaylist'
+type MockPlaylists_CreatePlaylist_Call struct {
+	*mock.Call
+}
+
+// CreatePlaylist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyPlaylist model.Playlist
+func (_e *MockPlaylists_Expecter) CreatePlaylist(ctx interface{}, anyPlaylist interface{}) *MockPlaylists_CreatePlaylist_Call {
+	return &MockPlaylists_CreatePlaylist_Call{Call: _e.mock.On("CreatePlaylist", ctx, anyPlaylist)}
+}
+
+func (_c *MockPlaylists_CreatePlaylist_Call) Run(run func(ctx context.Context, anyPlaylist model.Playlist)) *MockPlaylists_CreatePlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Playlist
+		if args[1] != nil {
+			arg1 = args[1].(model.Playlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlaylists_CreatePlaylist_Call) Return(playlist model.Playlist, err error) *MockPlaylists_CreatePlaylist_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockPlaylists_CreatePlaylist_Call) RunAndReturn(run func(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)) *MockPlaylists_CreatePlaylist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePlaylist provides a mock function for the type MockPlaylists
+func (_mock *MockPlaylists) DeletePlaylist(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePlaylist")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPlaylists_DeletePlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePlaylist'
+type MockPlaylists_DeletePlaylist_Call struct {
+	*mock.Call
+}
+
+// DeletePlaylist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockPlaylists_Expecter) DeletePlaylist(ctx interface{}, id interface{}) *MockPlaylists_DeletePlaylist_Call {
+	return &MockPlaylists_DeletePlaylist_Call{Call: _e.mock.On("DeletePlaylist", ctx, id)}
+}
+
+func (_c *MockPlaylists_DeletePlaylist_Call) Run(run func(ctx context.Context, id string)) *MockPlaylists_DeletePlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlaylists_DeletePlaylist_Call) Return(err error) *MockPlaylists_DeletePlaylist_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPlaylists_DeletePlaylist_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockPlaylists_DeletePlaylist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlaylist provides a mock function for the type MockPlaylists
+func (_mock *MockPlaylists) GetPlaylist(ctx context.Context, id string) (model.Playlist, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylist")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Playlist, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Playlist); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlaylists_GetPlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlaylist'
+type MockPlaylists_GetPlaylist_Call struct {
+	*mock.Call
+}
+
+// GetPlaylist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockPlaylists_Expecter) GetPlaylist(ctx interface{}, id interface{}) *MockPlaylists_GetPlaylist_Call {
+	return &MockPlaylists_GetPlaylist_Call{Call: _e.mock.On("GetPlaylist", ctx, id)}
+}
+
+func (_c *MockPlaylists_GetPlaylist_Call) Run(run func(ctx context.Context, id string)) *MockPlaylists_GetPlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlaylists_GetPlaylist_Call) Return(playlist model.Playlist, err error) *MockPlaylists_GetPlaylist_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockPlaylists_GetPlaylist_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Playlist, error)) *MockPlaylists_GetPlaylist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertPlaylistVideo provides a mock function for the type MockPlaylists
+func (_mock *MockPlaylists) InsertPlaylistVideo(ctx context.Context, id string, videoID string, position int) (model.Playlist, error) {
+	ret := _mock.Called(ctx, id, videoID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertPlaylistVideo")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) (model.Playlist, error)); ok {
+		return returnFunc(ctx, id, videoID, position)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) model.Playlist); ok {
+		r0 = returnFunc(ctx, id, videoID, position)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = returnFunc(ctx, id, videoID, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlaylists_InsertPlaylistVideo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertPlaylistVideo'
+type MockPlaylists_InsertPlaylistVideo_Call struct {
+	*mock.Call
+}
+
+// InsertPlaylistVideo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - videoID string
+//   - position int
+func (_e *MockPlaylists_Expecter) InsertPlaylistVideo(ctx interface{}, id interface{}, videoID interface{}, position interface{}) *MockPlaylists_InsertPlaylistVideo_Call {
+	return &MockPlaylists_InsertPlaylistVideo_Call{Call: _e.mock.On("InsertPlaylistVideo", ctx, id, videoID, position)}
+}
+
+func (_c *MockPlaylists_InsertPlaylistVideo_Call) Run(run func(ctx context.Context, id string, videoID string, position int)) *MockPlaylists_InsertPlaylistVideo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlaylists_InsertPlaylistVideo_Call) Return(playlist model.Playlist, err error) *MockPlaylists_InsertPlaylistVideo_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockPlaylists_InsertPlaylistVideo_Call) RunAndReturn(run func(ctx context.Context, id string, videoID string, position int) (model.Playlist, error)) *MockPlaylists_InsertPlaylistVideo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPlaylists provides a mock function for the type MockPlaylists
+func (_mock *MockPlaylists) ListPlaylists(ctx context.Context, page int, limit int) ([]model.Playlist, error) {
+	ret := _mock.Called(ctx, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPlaylists")
+	}
+
+	var r0 []model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]model.Playlist, error)); ok {
+		return returnFunc(ctx, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []model.Playlist); ok {
+		r0 = returnFunc(ctx, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Playlist)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlaylists_ListPlaylists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPlaylists'
+type MockPlaylists_ListPlaylists_Call struct {
+	*mock.Call
+}
+
+// ListPlaylists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - limit int
+func (_e *MockPlaylists_Expecter) ListPlaylists(ctx interface{}, page interface{}, limit interface{}) *MockPlaylists_ListPlaylists_Call {
+	return &MockPlaylists_ListPlaylists_Call{Call: _e.mock.On("ListPlaylists", ctx, page, limit)}
+}
+
+func (_c *MockPlaylists_ListPlaylists_Call) Run(run func(ctx context.Context, page int, limit int)) *MockPlaylists_ListPlaylists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlaylists_ListPlaylists_Call) Return(playlists []model.Playlist, err error) *MockPlaylists_ListPlaylists_Call {
+	_c.Call.Return(playlists, err)
+	return _c
+}
+
+func (_c *MockPlaylists_ListPlaylists_Call) RunAndReturn(run func(ctx context.Context, page int, limit int) ([]model.Playlist, error)) *MockPlaylists_ListPlaylists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePlaylistVideo provides a mock function for the type MockPlaylists
+func (_mock *MockPlaylists) RemovePlaylistVideo(ctx context.Context, id string, videoID string) (model.Playlist, error) {
+	ret := _mock.Called(ctx, id, videoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePlaylistVideo")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.Playlist, error)); ok {
+		return returnFunc(ctx, id, videoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.Playlist); ok {
+		r0 = returnFunc(ctx, id, videoID)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, videoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlaylists_RemovePlaylistVideo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePlaylistVideo'
+type MockPlaylists_RemovePlaylistVideo_Call struct {
+	*mock.Call
+}
+
+// RemovePlaylistVideo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - videoID string
+func (_e *MockPlaylists_Expecter) RemovePlaylistVideo(ctx interface{}, id interface{}, videoID interface{}) *MockPlaylists_RemovePlaylistVideo_Call {
+	return &MockPlaylists_RemovePlaylistVideo_Call{Call: _e.mock.On("RemovePlaylistVideo", ctx, id, videoID)}
+}
+
+func (_c *MockPlaylists_RemovePlaylistVideo_Call) Run(run func(ctx context.Context, id string, videoID string)) *MockPlaylists_RemovePlaylistVideo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlaylists_RemovePlaylistVideo_Call) Return(playlist model.Playlist, err error) *MockPlaylists_RemovePlaylistVideo_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockPlaylists_RemovePlaylistVideo_Call) RunAndReturn(run func(ctx context.Context, id string, videoID string) (model.Playlist, error)) *MockPlaylists_RemovePlaylistVideo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePlaylist provides a mock function for the type MockPlaylists
+func (_mock *MockPlaylists) UpdatePlaylist(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error) {
+	ret := _mock.Called(ctx, anyPlaylist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlaylist")
+	}
+
+	var r0 model.Playlist
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) (model.Playlist, error)); ok {
+		return returnFunc(ctx, anyPlaylist)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Playlist) model.Playlist); ok {
+		r0 = returnFunc(ctx, anyPlaylist)
+	} else {
+		r0 = ret.Get(0).(model.Playlist)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Playlist) error); ok {
+		r1 = returnFunc(ctx, anyPlaylist)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlaylists_UpdatePlaylist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlaylist'
+type MockPlaylists_UpdatePlaylist_Call struct {
+	*mock.Call
+}
+
+// UpdatePlaylist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyPlaylist model.Playlist
+func (_e *MockPlaylists_Expecter) UpdatePlaylist(ctx interface{}, anyPlaylist interface{}) *MockPlaylists_UpdatePlaylist_Call {
+	return &MockPlaylists_UpdatePlaylist_Call{Call: _e.mock.On("UpdatePlaylist", ctx, anyPlaylist)}
+}
+
+func (_c *MockPlaylists_UpdatePlaylist_Call) Run(run func(ctx context.Context, anyPlaylist model.Playlist)) *MockPlaylists_UpdatePlaylist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Playlist
+		if args[1] != nil {
+			arg1 = args[1].(model.Playlist)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlaylists_UpdatePlaylist_Call) Return(playlist model.Playlist, err error) *MockPlaylists_UpdatePlaylist_Call {
+	_c.Call.Return(playlist, err)
+	return _c
+}
+
+func (_c *MockPlaylists_UpdatePlaylist_Call) RunAndReturn(run func(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)) *MockPlaylists_UpdatePlaylist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStreams creates a new instance of MockStreams. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreams(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreams {
+	mock := &MockStreams{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStreams is an autogenerated mock type for the Streams type
+type MockStreams struct {
+	mock.Mock
+}
+
+type MockStreams_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreams) EXPECT() *MockStreams_Expecter {
+	return &MockStreams_Expecter{mock: &_m.Mock}
+}
+
+// CreateStream provides a mock function for the type MockStreams
+func (_mock *MockStreams) CreateStream(ctx context.Context, anyStream model.LiveStream) (model.LiveStream, error) {
+	ret := _mock.Called(ctx, anyStream)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStream")
+	}
+
+	var r0 model.LiveStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.LiveStream) (model.LiveStream, error)); ok {
+		return returnFunc(ctx, anyStream)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.LiveStream) model.LiveStream); ok {
+		r0 = returnFunc(ctx, anyStream)
+	} else {
+		r0 = ret.Get(0).(model.LiveStream)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.LiveStream) error); ok {
+		r1 = returnFunc(ctx, anyStream)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreams_CreateStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStream'
+type MockStreams_CreateStream_Call struct {
+	*mock.Call
+}
+
+// CreateStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyStream model.LiveStream
+func (_e *MockStreams_Expecter) CreateStream(ctx interface{}, anyStream interface{}) *MockStreams_CreateStream_Call {
+	return &MockStreams_CreateStream_Call{Call: _e.mock.On("CreateStream", ctx, anyStream)}
+}
+
+func (_c *MockStreams_CreateStream_Call) Run(run func(ctx context.Context, anyStream model.LiveStream)) *MockStreams_CreateStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.LiveStream
+		if args[1] != nil {
+			arg1 = args[1].(model.LiveStream)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreams_CreateStream_Call) Return(liveStream model.LiveStream, err error) *MockStreams_CreateStream_Call {
+	_c.Call.Return(liveStream, err)
+	return _c
+}
+
+func (_c *MockStreams_CreateStream_Call) RunAndReturn(run func(ctx context.Context, anyStream model.LiveStream) (model.LiveStream, error)) *MockStreams_CreateStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStream provides a mock function for the type MockStreams
+func (_mock *MockStreams) DeleteStream(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStreams_DeleteStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStream'
+type MockStreams_DeleteStream_Call struct {
+	*mock.Call
+}
+
+// DeleteStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockStreams_Expecter) DeleteStream(ctx interface{}, id interface{}) *MockStreams_DeleteStream_Call {
+	return &MockStreams_DeleteStream_Call{Call: _e.mock.On("DeleteStream", ctx, id)}
+}
+
+func (_c *MockStreams_DeleteStream_Call) Run(run func(ctx context.Context, id string)) *MockStreams_DeleteStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreams_DeleteStream_Call) Return(err error) *MockStreams_DeleteStream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStreams_DeleteStream_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockStreams_DeleteStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStream provides a mock function for the type MockStreams
+func (_mock *MockStreams) GetStream(ctx context.Context, id string) (model.LiveStream, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStream")
+	}
+
+	var r0 model.LiveStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.LiveStream, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.LiveStream); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.LiveStream)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreams_GetStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStream'
+type MockStreams_GetStream_Call struct {
+	*mock.Call
+}
+
+// GetStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockStreams_Expecter) GetStream(ctx interface{}, id interface{}) *MockStreams_GetStream_Call {
+	return &MockStreams_GetStream_Call{Call: _e.mock.On("GetStream", ctx, id)}
+}
+
+func (_c *MockStreams_GetStream_Call) Run(run func(ctx context.Context, id string)) *MockStreams_GetStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreams_GetStream_Call) Return(liveStream model.LiveStream, err error) *MockStreams_GetStream_Call {
+	_c.Call.Return(liveStream, err)
+	return _c
+}
+
+func (_c *MockStreams_GetStream_Call) RunAndReturn(run func(ctx context.Context, id string) (model.LiveStream, error)) *MockStreams_GetStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStreams provides a mock function for the type MockStreams
+func (_mock *MockStreams) ListStreams(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error) {
+	ret := _mock.Called(ctx, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStreams")
+	}
+
+	var r0 model.LiveStreamList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (model.LiveStreamList, error)); ok {
+		return returnFunc(ctx, cursor, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) model.LiveStreamList); ok {
+		r0 = returnFunc(ctx, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(model.LiveStreamList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreams_ListStreams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStreams'
+type MockStreams_ListStreams_Call struct {
+	*mock.Call
+}
+
+// ListStreams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor string
+//   - limit int
+func (_e *MockStreams_Expecter) ListStreams(ctx interface{}, cursor interface{}, limit interface{}) *MockStreams_ListStreams_Call {
+	return &MockStreams_ListStreams_Call{Call: _e.mock.On("ListStreams", ctx, cursor, limit)}
+}
+
+func (_c *MockStreams_ListStreams_Call) Run(run func(ctx context.Context, cursor string, limit int)) *MockStreams_ListStreams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreams_ListStreams_Call) Return(liveStreamList model.LiveStreamList, err error) *MockStreams_ListStreams_Call {
+	_c.Call.Return(liveStreamList, err)
+	return _c
+}
+
+func (_c *MockStreams_ListStreams_Call) RunAndReturn(run func(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error)) *MockStreams_ListStreams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStream provides a mock function for the type MockStreams
+func (_mock *MockStreams) UpdateStream(ctx context.Context, id string, status string) (model.LiveStream, error) {
+	ret := _mock.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStream")
+	}
+
+	var r0 model.LiveStream
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (model.LiveStream, error)); ok {
+		return returnFunc(ctx, id, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) model.LiveStream); ok {
+		r0 = returnFunc(ctx, id, status)
+	} else {
+		r0 = ret.Get(0).(model.LiveStream)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreams_UpdateStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStream'
+type MockStreams_UpdateStream_Call struct {
+	*mock.Call
+}
+
+// UpdateStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status string
+func (_e *MockStreams_Expecter) UpdateStream(ctx interface{}, id interface{}, status interface{}) *MockStreams_UpdateStream_Call {
+	return &MockStreams_UpdateStream_Call{Call: _e.mock.On("UpdateStream", ctx, id, status)}
+}
+
+func (_c *MockStreams_UpdateStream_Call) Run(run func(ctx context.Context, id string, status string)) *MockStreams_UpdateStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreams_UpdateStream_Call) Return(liveStream model.LiveStream, err error) *MockStreams_UpdateStream_Call {
+	_c.Call.Return(liveStream, err)
+	return _c
+}
+
+func (_c *MockStreams_UpdateStream_Call) RunAndReturn(run func(ctx context.Context, id string, status string) (model.LiveStream, error)) *MockStreams_UpdateStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUploads creates a new instance of MockUploads. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUploads(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUploads {
+	mock := &MockUploads{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUploads is an autogenerated mock type for the Uploads type
+type MockUploads struct {
+	mock.Mock
+}
+
+type MockUploads_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUploads) EXPECT() *MockUploads_Expecter {
+	return &MockUploads_Expecter{mock: &_m.Mock}
+}
+
+// CreateUpload provides a mock function for the type MockUploads
+func (_mock *MockUploads) CreateUpload(ctx context.Context, anyUpload model.Upload) (model.Upload, error) {
+	ret := _mock.Called(ctx, anyUpload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUpload")
+	}
+
+	var r0 model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Upload) (model.Upload, error)); ok {
+		return returnFunc(ctx, anyUpload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Upload) model.Upload); ok {
+		r0 = returnFunc(ctx, anyUpload)
+	} else {
+		r0 = ret.Get(0).(model.Upload)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Upload) error); ok {
+		r1 = returnFunc(ctx, anyUpload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUploads_CreateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUpload'
+type MockUploads_CreateUpload_Call struct {
+	*mock.Call
+}
+
+// CreateUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyUpload model.Upload
+func (_e *MockUploads_Expecter) CreateUpload(ctx interface{}, anyUpload interface{}) *MockUploads_CreateUpload_Call {
+	return &MockUploads_CreateUpload_Call{Call: _e.mock.On("CreateUpload", ctx, anyUpload)}
+}
+
+func (_c *MockUploads_CreateUpload_Call) Run(run func(ctx context.Context, anyUpload model.Upload)) *MockUploads_CreateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Upload
+		if args[1] != nil {
+			arg1 = args[1].(model.Upload)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUploads_CreateUpload_Call) Return(upload model.Upload, err error) *MockUploads_CreateUpload_Call {
+	_c.Call.Return(upload, err)
+	return _c
+}
+
+func (_c *MockUploads_CreateUpload_Call) RunAndReturn(run func(ctx context.Context, anyUpload model.Upload) (model.Upload, error)) *MockUploads_CreateUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpload provides a mock function for the type MockUploads
+func (_mock *MockUploads) GetUpload(ctx context.Context, id string) (model.Upload, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpload")
+	}
+
+	var r0 model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Upload, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Upload); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Upload)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUploads_GetUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpload'
+type MockUploads_GetUpload_Call struct {
+	*mock.Call
+}
+
+// GetUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockUploads_Expecter) GetUpload(ctx interface{}, id interface{}) *MockUploads_GetUpload_Call {
+	return &MockUploads_GetUpload_Call{Call: _e.mock.On("GetUpload", ctx, id)}
+}
+
+func (_c *MockUploads_GetUpload_Call) Run(run func(ctx context.Context, id string)) *MockUploads_GetUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUploads_GetUpload_Call) Return(upload model.Upload, err error) *MockUploads_GetUpload_Call {
+	_c.Call.Return(upload, err)
+	return _c
+}
+
+func (_c *MockUploads_GetUpload_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Upload, error)) *MockUploads_GetUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUploads provides a mock function for the type MockUploads
+func (_mock *MockUploads) ListUploads(ctx context.Context, status string, limit int) ([]model.Upload, error) {
+	ret := _mock.Called(ctx, status, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUploads")
+	}
+
+	var r0 []model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]model.Upload, error)); ok {
+		return returnFunc(ctx, status, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []model.Upload); ok {
+		r0 = returnFunc(ctx, status, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Upload)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, status, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUploads_ListUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUploads'
+type MockUploads_ListUploads_Call struct {
+	*mock.Call
+}
+
+// ListUploads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status string
+//   - limit int
+func (_e *MockUploads_Expecter) ListUploads(ctx interface{}, status interface{}, limit interface{}) *MockUploads_ListUploads_Call {
+	return &MockUploads_ListUploads_Call{Call: _e.mock.On("ListUploads", ctx, status, limit)}
+}
+
+func (_c *MockUploads_ListUploads_Call) Run(run func(ctx context.Context, status string, limit int)) *MockUploads_ListUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUploads_ListUploads_Call) Return(uploads []model.Upload, err error) *MockUploads_ListUploads_Call {
+	_c.Call.Return(uploads, err)
+	return _c
+}
+
+func (_c *MockUploads_ListUploads_Call) RunAndReturn(run func(ctx context.Context, status string, limit int) ([]model.Upload, error)) *MockUploads_ListUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUpload provides a mock function for the type MockUploads
+func (_mock *MockUploads) UpdateUpload(ctx context.Context, id string, status string, assetID string) (model.Upload, error) {
+	ret := _mock.Called(ctx, id, status, assetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUpload")
+	}
+
+	var r0 model.Upload
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (model.Upload, error)); ok {
+		return returnFunc(ctx, id, status, assetID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) model.Upload); ok {
+		r0 = returnFunc(ctx, id, status, assetID)
+	} else {
+		r0 = ret.Get(0).(model.Upload)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, id, status, assetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUploads_UpdateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUpload'
+type MockUploads_UpdateUpload_Call struct {
+	*mock.Call
+}
+
+// UpdateUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status string
+//   - assetID string
+func (_e *MockUploads_Expecter) UpdateUpload(ctx interface{}, id interface{}, status interface{}, assetID interface{}) *MockUploads_UpdateUpload_Call {
+	return &MockUploads_UpdateUpload_Call{Call: _e.mock.On("UpdateUpload", ctx, id, status, assetID)}
+}
+
+func (_c *MockUploads_UpdateUpload_Call) Run(run func(ctx context.Context, id string, status string, assetID string)) *MockUploads_UpdateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUploads_UpdateUpload_Call) Return(upload model.Upload, err error) *MockUploads_UpdateUpload_Call {
+	_c.Call.Return(upload, err)
+	return _c
+}
+
+func (_c *MockUploads_UpdateUpload_Call) RunAndReturn(run func(ctx context.Context, id string, status string, assetID string) (model.Upload, error)) *MockUploads_UpdateUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVideos creates a new instance of MockVideos. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVideos(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVideos {
+	mock := &MockVideos{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockVideos is an autogenerated mock type for the Videos type
+type MockVideos struct {
+	mock.Mock
+}
+
+type MockVideos_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVideos) EXPECT() *MockVideos_Expecter {
+	return &MockVideos_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockVideos
+func (_mock *MockVideos) Create(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	ret := _mock.Called(ctx, anyVideo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) (model.Video, error)); ok {
+		return returnFunc(ctx, anyVideo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) model.Video); ok {
+		r0 = returnFunc(ctx, anyVideo)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video) error); ok {
+		r1 = returnFunc(ctx, anyVideo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockVideos_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyVideo model.Video
+func (_e *MockVideos_Expecter) Create(ctx interface{}, anyVideo interface{}) *MockVideos_Create_Call {
+	return &MockVideos_Create_Call{Call: _e.mock.On("Create", ctx, anyVideo)}
+}
+
+func (_c *MockVideos_Create_Call) Run(run func(ctx context.Context, anyVideo model.Video)) *MockVideos_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Video
+		if args[1] != nil {
+			arg1 = args[1].(model.Video)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_Create_Call) Return(video model.Video, err error) *MockVideos_Create_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_Create_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video) (model.Video, error)) *MockVideos_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateForAsset provides a mock function for the type MockVideos
+func (_mock *MockVideos) CreateForAsset(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	ret := _mock.Called(ctx, anyVideo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateForAsset")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) (model.Video, error)); ok {
+		return returnFunc(ctx, anyVideo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) model.Video); ok {
+		r0 = returnFunc(ctx, anyVideo)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video) error); ok {
+		r1 = returnFunc(ctx, anyVideo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_CreateForAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateForAsset'
+type MockVideos_CreateForAsset_Call struct {
+	*mock.Call
+}
+
+// CreateForAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyVideo model.Video
+func (_e *MockVideos_Expecter) CreateForAsset(ctx interface{}, anyVideo interface{}) *MockVideos_CreateForAsset_Call {
+	return &MockVideos_CreateForAsset_Call{Call: _e.mock.On("CreateForAsset", ctx, anyVideo)}
+}
+
+func (_c *MockVideos_CreateForAsset_Call) Run(run func(ctx context.Context, anyVideo model.Video)) *MockVideos_CreateForAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Video
+		if args[1] != nil {
+			arg1 = args[1].(model.Video)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_CreateForAsset_Call) Return(video model.Video, err error) *MockVideos_CreateForAsset_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_CreateForAsset_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video) (model.Video, error)) *MockVideos_CreateForAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWithJob provides a mock function for the type MockVideos
+func (_mock *MockVideos) CreateWithJob(ctx context.Context, anyVideo model.Video, anyJob model.Job) (model.Video, error) {
+	ret := _mock.Called(ctx, anyVideo, anyJob)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithJob")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video, model.Job) (model.Video, error)); ok {
+		return returnFunc(ctx, anyVideo, anyJob)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video, model.Job) model.Video); ok {
+		r0 = returnFunc(ctx, anyVideo, anyJob)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video, model.Job) error); ok {
+		r1 = returnFunc(ctx, anyVideo, anyJob)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_CreateWithJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithJob'
+type MockVideos_CreateWithJob_Call struct {
+	*mock.Call
+}
+
+// CreateWithJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyVideo model.Video
+//   - anyJob model.Job
+func (_e *MockVideos_Expecter) CreateWithJob(ctx interface{}, anyVideo interface{}, anyJob interface{}) *MockVideos_CreateWithJob_Call {
+	return &MockVideos_CreateWithJob_Call{Call: _e.mock.On("CreateWithJob", ctx, anyVideo, anyJob)}
+}
+
+func (_c *MockVideos_CreateWithJob_Call) Run(run func(ctx context.Context, anyVideo model.Video, anyJob model.Job)) *MockVideos_CreateWithJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Video
+		if args[1] != nil {
+			arg1 = args[1].(model.Video)
+		}
+		var arg2 model.Job
+		if args[2] != nil {
+			arg2 = args[2].(model.Job)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_CreateWithJob_Call) Return(video model.Video, err error) *MockVideos_CreateWithJob_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_CreateWithJob_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video, anyJob model.Job) (model.Video, error)) *MockVideos_CreateWithJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockVideos
+func (_mock *MockVideos) Delete(ctx context.Context, id string) (model.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockVideos_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVideos_Expecter) Delete(ctx interface{}, id interface{}) *MockVideos_Delete_Call {
+	return &MockVideos_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockVideos_Delete_Call) Run(run func(ctx context.Context, id string)) *MockVideos_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_Delete_Call) Return(video model.Video, err error) *MockVideos_Delete_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockVideos_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByAssetID provides a mock function for the type MockVideos
+func (_mock *MockVideos) GetByAssetID(ctx context.Context, assetID string) (model.Video, error) {
+	ret := _mock.Called(ctx, assetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByAssetID")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, assetID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, assetID)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, assetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_GetByAssetID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByAssetID'
+type MockVideos_GetByAssetID_Call struct {
+	*mock.Call
+}
+
+// GetByAssetID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+func (_e *MockVideos_Expecter) GetByAssetID(ctx interface{}, assetID interface{}) *MockVideos_GetByAssetID_Call {
+	return &MockVideos_GetByAssetID_Call{Call: _e.mock.On("GetByAssetID", ctx, assetID)}
+}
+
+func (_c *MockVideos_GetByAssetID_Call) Run(run func(ctx context.Context, assetID string)) *MockVideos_GetByAssetID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_GetByAssetID_Call) Return(video model.Video, err error) *MockVideos_GetByAssetID_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_GetByAssetID_Call) RunAndReturn(run func(ctx context.Context, assetID string) (model.Video, error)) *MockVideos_GetByAssetID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockVideos
+func (_mock *MockVideos) GetByID(ctx context.Context, id string) (model.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockVideos_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVideos_Expecter) GetByID(ctx interface{}, id interface{}) *MockVideos_GetByID_Call {
+	return &MockVideos_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockVideos_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockVideos_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_GetByID_Call) Return(video model.Video, err error) *MockVideos_GetByID_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockVideos_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDWithTrashed provides a mock function for the type MockVideos
+func (_mock *MockVideos) GetByIDWithTrashed(ctx context.Context, id string) (model.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithTrashed")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_GetByIDWithTrashed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithTrashed'
+type MockVideos_GetByIDWithTrashed_Call struct {
+	*mock.Call
+}
+
+// GetByIDWithTrashed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVideos_Expecter) GetByIDWithTrashed(ctx interface{}, id interface{}) *MockVideos_GetByIDWithTrashed_Call {
+	return &MockVideos_GetByIDWithTrashed_Call{Call: _e.mock.On("GetByIDWithTrashed", ctx, id)}
+}
+
+func (_c *MockVideos_GetByIDWithTrashed_Call) Run(run func(ctx context.Context, id string)) *MockVideos_GetByIDWithTrashed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_GetByIDWithTrashed_Call) Return(video model.Video, err error) *MockVideos_GetByIDWithTrashed_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_GetByIDWithTrashed_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockVideos_GetByIDWithTrashed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockVideos
+func (_mock *MockVideos) List(ctx context.Context, opts model.ListOptions) (model.VideoList, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 model.VideoList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ListOptions) (model.VideoList, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.ListOptions) model.VideoList); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(model.VideoList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.ListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockVideos_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts model.ListOptions
+func (_e *MockVideos_Expecter) List(ctx interface{}, opts interface{}) *MockVideos_List_Call {
+	return &MockVideos_List_Call{Call: _e.mock.On("List", ctx, opts)}
+}
+
+func (_c *MockVideos_List_Call) Run(run func(ctx context.Context, opts model.ListOptions)) *MockVideos_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(model.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_List_Call) Return(videoList model.VideoList, err error) *MockVideos_List_Call {
+	_c.Call.Return(videoList, err)
+	return _c
+}
+
+func (_c *MockVideos_List_Call) RunAndReturn(run func(ctx context.Context, opts model.ListOptions) (model.VideoList, error)) *MockVideos_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockVideos
+func (_mock *MockVideos) ListByIDs(ctx context.Context, ids []string) ([]model.Video, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 []model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]model.Video, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []model.Video); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Video)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockVideos_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *MockVideos_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockVideos_ListByIDs_Call {
+	return &MockVideos_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockVideos_ListByIDs_Call) Run(run func(ctx context.Context, ids []string)) *MockVideos_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_ListByIDs_Call) Return(videos []model.Video, err error) *MockVideos_ListByIDs_Call {
+	_c.Call.Return(videos, err)
+	return _c
+}
+
+func (_c *MockVideos_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []string) ([]model.Video, error)) *MockVideos_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIncomplete provides a mock function for the type MockVideos
+func (_mock *MockVideos) ListIncomplete(ctx context.Context, after string, limit int) ([]model.Video, error) {
+	ret := _mock.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIncomplete")
+	}
+
+	var r0 []model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]model.Video, error)); ok {
+		return returnFunc(ctx, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []model.Video); ok {
+		r0 = returnFunc(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Video)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_ListIncomplete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIncomplete'
+type MockVideos_ListIncomplete_Call struct {
+	*mock.Call
+}
+
+// ListIncomplete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after string
+//   - limit int
+func (_e *MockVideos_Expecter) ListIncomplete(ctx interface{}, after interface{}, limit interface{}) *MockVideos_ListIncomplete_Call {
+	return &MockVideos_ListIncomplete_Call{Call: _e.mock.On("ListIncomplete", ctx, after, limit)}
+}
+
+func (_c *MockVideos_ListIncomplete_Call) Run(run func(ctx context.Context, after string, limit int)) *MockVideos_ListIncomplete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_ListIncomplete_Call) Return(videos []model.Video, err error) *MockVideos_ListIncomplete_Call {
+	_c.Call.Return(videos, err)
+	return _c
+}
+
+func (_c *MockVideos_ListIncomplete_Call) RunAndReturn(run func(ctx context.Context, after string, limit int) ([]model.Video, error)) *MockVideos_ListIncomplete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLinked provides a mock function for the type MockVideos
+func (_mock *MockVideos) ListLinked(ctx context.Context, after string, limit int) ([]model.Video, error) {
+	ret := _mock.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLinked")
+	}
+
+	var r0 []model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]model.Video, error)); ok {
+		return returnFunc(ctx, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []model.Video); ok {
+		r0 = returnFunc(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Video)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_ListLinked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLinked'
+type MockVideos_ListLinked_Call struct {
+	*mock.Call
+}
+
+// ListLinked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after string
+//   - limit int
+func (_e *MockVideos_Expecter) ListLinked(ctx interface{}, after interface{}, limit interface{}) *MockVideos_ListLinked_Call {
+	return &MockVideos_ListLinked_Call{Call: _e.mock.On("ListLinked", ctx, after, limit)}
+}
+
+func (_c *MockVideos_ListLinked_Call) Run(run func(ctx context.Context, after string, limit int)) *MockVideos_ListLinked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_ListLinked_Call) Return(videos []model.Video, err error) *MockVideos_ListLinked_Call {
+	_c.Call.Return(videos, err)
+	return _c
+}
+
+func (_c *MockVideos_ListLinked_Call) RunAndReturn(run func(ctx context.Context, after string, limit int) ([]model.Video, error)) *MockVideos_ListLinked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPurgeable provides a mock function for the type MockVideos
+func (_mock *MockVideos) ListPurgeable(ctx context.Context, before time.Time, limit int) ([]model.Video, error) {
+	ret := _mock.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPurgeable")
+	}
+
+	var r0 []model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]model.Video, error)); ok {
+		return returnFunc(ctx, before, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []model.Video); ok {
+		r0 = returnFunc(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Video)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_ListPurgeable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPurgeable'
+type MockVideos_ListPurgeable_Call struct {
+	*mock.Call
+}
+
+// ListPurgeable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockVideos_Expecter) ListPurgeable(ctx interface{}, before interface{}, limit interface{}) *MockVideos_ListPurgeable_Call {
+	return &MockVideos_ListPurgeable_Call{Call: _e.mock.On("ListPurgeable", ctx, before, limit)}
+}
+
+func (_c *MockVideos_ListPurgeable_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockVideos_ListPurgeable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_ListPurgeable_Call) Return(videos []model.Video, err error) *MockVideos_ListPurgeable_Call {
+	_c.Call.Return(videos, err)
+	return _c
+}
+
+func (_c *MockVideos_ListPurgeable_Call) RunAndReturn(run func(ctx context.Context, before time.Time, limit int) ([]model.Video, error)) *MockVideos_ListPurgeable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrash provides a mock function for the type MockVideos
+func (_mock *MockVideos) ListTrash(ctx context.Context, page int, limit int) ([]model.Video, error) {
+	ret := _mock.Called(ctx, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 []model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]model.Video, error)); ok {
+		return returnFunc(ctx, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []model.Video); ok {
+		r0 = returnFunc(ctx, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Video)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type MockVideos_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - limit int
+func (_e *MockVideos_Expecter) ListTrash(ctx interface{}, page interface{}, limit interface{}) *MockVideos_ListTrash_Call {
+	return &MockVideos_ListTrash_Call{Call: _e.mock.On("ListTrash", ctx, page, limit)}
+}
+
+func (_c *MockVideos_ListTrash_Call) Run(run func(ctx context.Context, page int, limit int)) *MockVideos_ListTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_ListTrash_Call) Return(videos []model.Video, err error) *MockVideos_ListTrash_Call {
+	_c.Call.Return(videos, err)
+	return _c
+}
+
+func (_c *MockVideos_ListTrash_Call) RunAndReturn(run func(ctx context.Context, page int, limit int) ([]model.Video, error)) *MockVideos_ListTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTrack provides a mock function for the type MockVideos
+func (_mock *MockVideos) RemoveTrack(ctx context.Context, id string, trackID string) error {
+	ret := _mock.Called(ctx, id, trackID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTrack")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, trackID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVideos_RemoveTrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTrack'
+type MockVideos_RemoveTrack_Call struct {
+	*mock.Call
+}
+
+// RemoveTrack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - trackID string
+func (_e *MockVideos_Expecter) RemoveTrack(ctx interface{}, id interface{}, trackID interface{}) *MockVideos_RemoveTrack_Call {
+	return &MockVideos_RemoveTrack_Call{Call: _e.mock.On("RemoveTrack", ctx, id, trackID)}
+}
+
+func (_c *MockVideos_RemoveTrack_Call) Run(run func(ctx context.Context, id string, trackID string)) *MockVideos_RemoveTrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_RemoveTrack_Call) Return(err error) *MockVideos_RemoveTrack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVideos_RemoveTrack_Call) RunAndReturn(run func(ctx context.Context, id string, trackID string) error) *MockVideos_RemoveTrack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockVideos
+func (_mock *MockVideos) Restore(ctx context.Context, id string) (model.Video, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (model.Video, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) model.Video); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockVideos_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVideos_Expecter) Restore(ctx interface{}, id interface{}) *MockVideos_Restore_Call {
+	return &MockVideos_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockVideos_Restore_Call) Run(run func(ctx context.Context, id string)) *MockVideos_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_Restore_Call) Return(video model.Video, err error) *MockVideos_Restore_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) (model.Video, error)) *MockVideos_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTrack provides a mock function for the type MockVideos
+func (_mock *MockVideos) SaveTrack(ctx context.Context, id string, anyTrack model.Track) error {
+	ret := _mock.Called(ctx, id, anyTrack)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTrack")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Track) error); ok {
+		r0 = returnFunc(ctx, id, anyTrack)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVideos_SaveTrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTrack'
+type MockVideos_SaveTrack_Call struct {
+	*mock.Call
+}
+
+// SaveTrack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - anyTrack model.Track
+func (_e *MockVideos_Expecter) SaveTrack(ctx interface{}, id interface{}, anyTrack interface{}) *MockVideos_SaveTrack_Call {
+	return &MockVideos_SaveTrack_Call{Call: _e.mock.On("SaveTrack", ctx, id, anyTrack)}
+}
+
+func (_c *MockVideos_SaveTrack_Call) Run(run func(ctx context.Context, id string, anyTrack model.Track)) *MockVideos_SaveTrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.Track
+		if args[2] != nil {
+			arg2 = args[2].(model.Track)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_SaveTrack_Call) Return(err error) *MockVideos_SaveTrack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVideos_SaveTrack_Call) RunAndReturn(run func(ctx context.Context, id string, anyTrack model.Track) error) *MockVideos_SaveTrack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockVideos
+func (_mock *MockVideos) Search(ctx context.Context, query string, limit int) ([]model.SearchHit, error) {
+	ret := _mock.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []model.SearchHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]model.SearchHit, error)); ok {
+		return returnFunc(ctx, query, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []model.SearchHit); ok {
+		r0 = returnFunc(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SearchHit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockVideos_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockVideos_Expecter) Search(ctx interface{}, query interface{}, limit interface{}) *MockVideos_Search_Call {
+	return &MockVideos_Search_Call{Call: _e.mock.On("Search", ctx, query, limit)}
+}
+
+func (_c *MockVideos_Search_Call) Run(run func(ctx context.Context, query string, limit int)) *MockVideos_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_Search_Call) Return(searchHits []model.SearchHit, err error) *MockVideos_Search_Call {
+	_c.Call.Return(searchHits, err)
+	return _c
+}
+
+func (_c *MockVideos_Search_Call) RunAndReturn(run func(ctx context.Context, query string, limit int) ([]model.SearchHit, error)) *MockVideos_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trash provides a mock function for the type MockVideos
+func (_mock *MockVideos) Trash(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trash")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVideos_Trash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trash'
+type MockVideos_Trash_Call struct {
+	*mock.Call
+}
+
+// Trash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockVideos_Expecter) Trash(ctx interface{}, id interface{}) *MockVideos_Trash_Call {
+	return &MockVideos_Trash_Call{Call: _e.mock.On("Trash", ctx, id)}
+}
+
+func (_c *MockVideos_Trash_Call) Run(run func(ctx context.Context, id string)) *MockVideos_Trash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_Trash_Call) Return(err error) *MockVideos_Trash_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVideos_Trash_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockVideos_Trash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockVideos
+func (_mock *MockVideos) Update(ctx context.Context, anyVideo model.Video) (model.Video, error) {
+	ret := _mock.Called(ctx, anyVideo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) (model.Video, error)); ok {
+		return returnFunc(ctx, anyVideo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Video) model.Video); ok {
+		r0 = returnFunc(ctx, anyVideo)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Video) error); ok {
+		r1 = returnFunc(ctx, anyVideo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockVideos_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - anyVideo model.Video
+func (_e *MockVideos_Expecter) Update(ctx interface{}, anyVideo interface{}) *MockVideos_Update_Call {
+	return &MockVideos_Update_Call{Call: _e.mock.On("Update", ctx, anyVideo)}
+}
+
+func (_c *MockVideos_Update_Call) Run(run func(ctx context.Context, anyVideo model.Video)) *MockVideos_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Video
+		if args[1] != nil {
+			arg1 = args[1].(model.Video)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_Update_Call) Return(video model.Video, err error) *MockVideos_Update_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_Update_Call) RunAndReturn(run func(ctx context.Context, anyVideo model.Video) (model.Video, error)) *MockVideos_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAllowDownload provides a mock function for the type MockVideos
+func (_mock *MockVideos) UpdateAllowDownload(ctx context.Context, id string, allow bool) error {
+	ret := _mock.Called(ctx, id, allow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAllowDownload")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, id, allow)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVideos_UpdateAllowDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAllowDownload'
+type MockVideos_UpdateAllowDownload_Call struct {
+	*mock.Call
+}
+
+// UpdateAllowDownload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - allow bool
+func (_e *MockVideos_Expecter) UpdateAllowDownload(ctx interface{}, id interface{}, allow interface{}) *MockVideos_UpdateAllowDownload_Call {
+	return &MockVideos_UpdateAllowDownload_Call{Call: _e.mock.On("UpdateAllowDownload", ctx, id, allow)}
+}
+
+func (_c *MockVideos_UpdateAllowDownload_Call) Run(run func(ctx context.Context, id string, allow bool)) *MockVideos_UpdateAllowDownload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_UpdateAllowDownload_Call) Return(err error) *MockVideos_UpdateAllowDownload_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockVideos_UpdateAllowDownload_Call) RunAndReturn(run func(ctx context.Context, id string, allow bool) error) *MockVideos_UpdateAllowDownload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAsset provides a mock function for the type MockVideos
+func (_mock *MockVideos) UpdateAsset(ctx context.Context, id string, asset model.Asset) (model.Video, error) {
+	ret := _mock.Called(ctx, id, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAsset")
+	}
+
+	var r0 model.Video
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Asset) (model.Video, error)); ok {
+		return returnFunc(ctx, id, asset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Asset) model.Video); ok {
+		r0 = returnFunc(ctx, id, asset)
+	} else {
+		r0 = ret.Get(0).(model.Video)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, model.Asset) error); ok {
+		r1 = returnFunc(ctx, id, asset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockVideos_UpdateAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAsset'
+type MockVideos_UpdateAsset_Call struct {
+	*mock.Call
+}
+
+// UpdateAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - asset model.Asset
+func (_e *MockVideos_Expecter) UpdateAsset(ctx interface{}, id interface{}, asset interface{}) *MockVideos_UpdateAsset_Call {
+	return &MockVideos_UpdateAsset_Call{Call: _e.mock.On("UpdateAsset", ctx, id, asset)}
+}
+
+func (_c *MockVideos_UpdateAsset_Call) Run(run func(ctx context.Context, id string, asset model.Asset)) *MockVideos_UpdateAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.Asset
+		if args[2] != nil {
+			arg2 = args[2].(model.Asset)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_UpdateAsset_Call) Return(video model.Video, err error) *MockVideos_UpdateAsset_Call {
+	_c.Call.Return(video, err)
+	return _c
+}
+
+func (_c *MockVideos_UpdateAsset_Call) RunAndReturn(run func(ctx context.Context, id string, asset model.Asset) (model.Video, error)) *MockVideos_UpdateAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateIngestion provides a mock function for the type MockVideos
+func (_mock *MockVideos) UpdateIngestion(ctx context.Context, id string, status model.JobStatus) error {
+	ret := _mock.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIngestion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.JobStatus) error); ok {
+		r0 = returnFunc(ctx, id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockVideos_UpdateIngestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIngestion'
+type MockVideos_UpdateIngestion_Call struct {
+	*mock.Call
+}
+
+// UpdateIngestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - status model.JobStatus
+func (_e *MockVideos_Expecter) UpdateIngestion(ctx interface{}, id interface{}, status interface{}) *MockVideos_UpdateIngestion_Call {
+	return &MockVideos_UpdateIngestion_Call{Call: _e.mock.On("UpdateIngestion", ctx, id, status)}
+}
+
+func (_c *MockVideos_UpdateIngestion_Call) Run(run func(ctx context.Context, id string, status model.JobStatus)) *MockVideos_UpdateIngestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.JobStatus
+		if args[2] != nil {
+			arg2 = args[2].(model.JobStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockVideos_UpdateIngestion_Call) Return(err error) *MockVideos_UpdateIngestion_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockVideos_List_Call) RunAndReturn(run func(ctx context.Context, page int, limit int) ([]model.Video, error)) *MockVideos_List_Call {
+func (_c *MockVideos_UpdateIngestion_Call) RunAndReturn(run func(ctx context.Context, id string, status model.JobStatus) error) *MockVideos_UpdateIngestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,386 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// Mux webhook event types handled by the usecase
+const (
+	EventAssetReady                     = "video.asset.ready"
+	EventAssetErrored                   = "video.asset.errored"
+	EventAssetDeleted                   = "video.asset.deleted"
+	EventAssetLiveStreamCompleted       = "video.asset.live_stream_completed"
+	EventAssetStaticRenditionsReady     = "video.asset.static_renditions.ready"
+	EventAssetStaticRenditionsPreparing = "video.asset.static_renditions.preparing"
+	EventAssetStaticRenditionsErrored   = "video.asset.static_renditions.errored"
+	EventAssetStaticRenditionsDeleted   = "video.asset.static_renditions.deleted"
+	EventTrackCreated                   = "video.asset.track.created"
+	EventTrackReady                     = "video.asset.track.ready"
+	EventTrackErrored                   = "video.asset.track.errored"
+	EventTrackDeleted                   = "video.asset.track.deleted"
+	EventUploadAssetCreated             = "video.upload.asset_created"
+	EventUploadErrored                  = "video.upload.errored"
+	EventUploadCancelled                = "video.upload.cancelled"
+	EventLiveStreamActive               = "video.live_stream.active"
+	EventLiveStreamIdle                 = "video.live_stream.idle"
+	EventLiveStreamDisabled             = "video.live_stream.disabled"
+)
+
+// signatureTolerance is the maximum age of a signed webhook
+const signatureTolerance = 5 * time.Minute
+
+// uploadEvent is the direct upload carried by the upload events
+type uploadEvent struct {
+	ID               string `json:"id"`
+	Status           string `json:"status"`
+	AssetID          string `json:"asset_id"`
+	NewAssetSettings struct {
+		Passthrough string `json:"passthrough"`
+	} `json:"new_asset_settings"`
+}
+
+// assetEvent is the asset carried by the asset events
+type assetEvent struct {
+	model.Asset
+	CreatedAt eventTime `json:"created_at"`
+}
+
+// eventTime is the time of an event in Unix seconds, sent as a number or as a string
+type eventTime string
+
+// UnmarshalJSON reads the time from a JSON string or number
+func (t *eventTime) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*t = eventTime(text)
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	*t = eventTime(number.String())
+
+	return nil
+}
+
+// liveStreamEvent is the live stream carried by the live stream events
+type liveStreamEvent struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// trackEvent is the asset track carried by the track events
+type trackEvent struct {
+	model.Track
+	AssetID string `json:"asset_id"`
+}
+
+type notifications struct {
+	videos    Videos
+	uploads   Uploads
+	captions  Captions
+	streams   Streams
+	secret    string
+	namespace string
+	logger    *logrus.Logger
+}
+
+// Notifications returns the usecase implementation
+func Notifications(
+	v Videos,
+	u Uploads,
+	c Captions,
+	s Streams,
+	secret string,
+	ns string,
+	l *logrus.Logger,
+) notifications {
+	return notifications{
+		videos:    v,
+		uploads:   u,
+		captions:  c,
+		streams:   s,
+		secret:    secret,
+		namespace: ns,
+		logger:    l,
+	}
+}
+
+// Receive verifies a Mux webhook and applies the event to the matching video
+func (u notifications) Receive(ctx context.Context, payload []byte, signature string) error {
+	if !u.verify(payload, signature, time.Now()) {
+		return errorcodes.ErrInvalidSignature
+	}
+
+	var event model.Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	switch event.Type {
+	case EventAssetReady, EventAssetErrored, EventAssetDeleted, EventAssetLiveStreamCompleted,
+		EventAssetStaticRenditionsReady, EventAssetStaticRenditionsPreparing,
+		EventAssetStaticRenditionsErrored, EventAssetStaticRenditionsDeleted:
+		var data assetEvent
+		if err := json.Unmarshal(event.Data, &data); err != nil || len(data.ID) == 0 {
+			return errorcodes.ErrVideoUnprocessable
+		}
+
+		asset := data.Asset
+		asset.CreatedAt = string(data.CreatedAt)
+
+		return u.applyAsset(ctx, event.Type, asset)
+	case EventTrackCreated, EventTrackReady, EventTrackErrored, EventTrackDeleted:
+		var track trackEvent
+		if err := json.Unmarshal(event.Data, &track); err != nil || len(track.ID) == 0 || len(track.AssetID) == 0 {
+			return errorcodes.ErrVideoUnprocessable
+		}
+
+		return u.applyTrack(ctx, event.Type, track)
+	case EventUploadAssetCreated, EventUploadErrored, EventUploadCancelled:
+		var upload uploadEvent
+		if err := json.Unmarshal(event.Data, &upload); err != nil || len(upload.ID) == 0 {
+			return errorcodes.ErrVideoUnprocessable
+		}
+
+		return u.applyUpload(ctx, upload)
+	case EventLiveStreamActive, EventLiveStreamIdle, EventLiveStreamDisabled:
+		var stream liveStreamEvent
+		if err := json.Unmarshal(event.Data, &stream); err != nil || len(stream.ID) == 0 || len(stream.Status) == 0 {
+			return errorcodes.ErrVideoUnprocessable
+		}
+
+		return u.applyLiveStream(ctx, stream)
+	}
+
+	// Events we are not interested in are acknowledged
+	return nil
+}
+
+// applyAsset stores the asset state carried by the event
+func (u notifications) applyAsset(ctx context.Context, eventType string, asset model.Asset) error {
+	video, err := u.videos.GetByAssetID(ctx, asset.ID)
+	if videoID, ok := passthroughVideoID(u.namespace, asset.Passthrough); err == errorcodes.ErrVideoNotFound && ok {
+		// Assets of direct uploads may report before the upload event links them
+		video, err = u.unlinkedVideo(ctx, videoID)
+	}
+	if err == errorcodes.ErrVideoNotFound && len(asset.LiveStreamID) > 0 &&
+		(eventType == EventAssetReady || eventType == EventAssetLiveStreamCompleted) {
+		// The recording of a broadcast joins the library once it can be played
+		return u.createRecording(ctx, asset)
+	}
+	if err != nil {
+		if err == errorcodes.ErrVideoNotFound {
+			// Assets created outside idlemux have no video, nothing to do
+			u.logger.WithField("asset_id", asset.ID).Warn("webhook for unknown asset")
+			return nil
+		}
+
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	if eventType == EventAssetDeleted {
+		asset.Status = "deleted"
+		asset.PlaybackIDs = nil
+	}
+
+	if _, err := u.videos.UpdateAsset(ctx, video.ID, asset); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// createRecording stores the recording of a live stream as a video with the
+// title, description and policy of the live stream. Both the ready and the
+// completed events may get here, from retries or other replicas too, the
+// repository keeps a single video for the asset.
+func (u notifications) createRecording(ctx context.Context, asset model.Asset) error {
+	stream, err := u.streams.GetStream(ctx, asset.LiveStreamID)
+	if err != nil {
+		if err == errorcodes.ErrLiveStreamNotFound {
+			// Live streams created outside idlemux have no record, nothing to do
+			u.logger.WithField("live_stream_id", asset.LiveStreamID).Warn("webhook for unknown live stream")
+			return nil
+		}
+
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	_, err = u.videos.CreateForAsset(ctx, model.Video{
+		Title:        recordingTitle(stream.Title, asset.CreatedAt),
+		Description:  stream.Description,
+		Policy:       stream.Policy,
+		LiveStreamID: stream.ID,
+		Asset:        &asset,
+	})
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// recordingTitle returns the title of a live stream with the date of the
+// broadcast, Mux.com reports the creation time of the asset in Unix seconds
+func recordingTitle(title, createdAt string) string {
+	seconds, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return title
+	}
+
+	return title + " (" + time.Unix(seconds, 0).UTC().Format(time.DateOnly) + ")"
+}
+
+// applyLiveStream stores the status of a live stream, the repository keeps a
+// disabled live stream disabled whatever the event order
+func (u notifications) applyLiveStream(ctx context.Context, event liveStreamEvent) error {
+	if _, err := u.streams.UpdateStream(ctx, event.ID, event.Status); err != nil {
+		if err == errorcodes.ErrLiveStreamNotFound {
+			u.logger.WithField("live_stream_id", event.ID).Warn("webhook for unknown live stream")
+			return nil
+		}
+
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// applyTrack stores the track state carried by the event on the video of its asset
+func (u notifications) applyTrack(ctx context.Context, eventType string, event trackEvent) error {
+	video, err := u.videos.GetByAssetID(ctx, event.AssetID)
+	if err != nil {
+		if err == errorcodes.ErrVideoNotFound {
+			u.logger.WithField("asset_id", event.AssetID).Warn("webhook for unknown asset")
+			return nil
+		}
+
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	if eventType == EventTrackDeleted {
+		err = u.videos.RemoveTrack(ctx, video.ID, event.ID)
+	} else {
+		err = u.videos.SaveTrack(ctx, video.ID, event.Track)
+	}
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	// Mux.com downloaded the uploaded caption file, it is no longer served
+	if eventType == EventTrackReady || eventType == EventTrackErrored {
+		if _, err := uuid.Parse(event.Passthrough); err == nil {
+			if err := u.captions.DeleteCaption(ctx, event.Passthrough); err != nil {
+				u.logger.WithError(err).WithField("caption_id", event.Passthrough).Error("error deleting caption")
+			}
+		}
+	}
+
+	return nil
+}
+
+// unlinkedVideo returns the video with the ID when it has no asset yet, a
+// trashed video is returned as well
+func (u notifications) unlinkedVideo(ctx context.Context, id string) (model.Video, error) {
+	video, err := u.videos.GetByIDWithTrashed(ctx, id)
+	if err != nil {
+		return model.Video{}, err
+	}
+
+	if video.Asset != nil {
+		return model.Video{}, errorcodes.ErrVideoNotFound
+	}
+
+	return video, nil
+}
+
+// applyUpload stores the status of a direct upload and links its asset to the video
+func (u notifications) applyUpload(ctx context.Context, event uploadEvent) error {
+	record, err := u.uploads.GetUpload(ctx, event.ID)
+	if err != nil {
+		if err == errorcodes.ErrUploadNotFound {
+			// Uploads created outside idlemux have no record, nothing to do
+			u.logger.WithField("upload_id", event.ID).Warn("webhook for unknown upload")
+			return nil
+		}
+
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	if _, err := completeUpload(ctx, u.videos, u.uploads, record, event.Status, event.AssetID); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// verify checks the Mux-Signature header: t=<timestamp>,v1=<hex HMAC-SHA256 of "t.payload">
+func (u notifications) verify(payload []byte, signature string, now time.Time) bool {
+	if len(u.secret) == 0 {
+		return false
+	}
+
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(signature, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > signatureTolerance || age < -signatureTolerance {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(u.secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	for _, s := range signatures {
+		decoded, err := hex.DecodeString(s)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,705 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const webhookSecret = "s3cr3t"
+
+// sign builds a Mux-Signature header for the payload
+func sign(secret string, timestamp time.Time, payload string) string {
+	t := fmt.Sprintf("%d", timestamp.Unix())
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t + "." + payload))
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// TestNotifications tests the Notifications constructor function
+func TestNotifications(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	videos := NewMockVideos(t)
+	uploads := NewMockUploads(t)
+	captions := NewMockCaptions(t)
+	streams := NewMockStreams(t)
+
+	usecase := Notifications(videos, uploads, captions, streams, webhookSecret, testNamespace, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, uploads, usecase.uploads)
+	assert.Equal(t, captions, usecase.captions)
+	assert.Equal(t, streams, usecase.streams)
+	assert.Equal(t, webhookSecret, usecase.secret)
+	assert.Equal(t, testNamespace, usecase.namespace)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestNotifications_Receive(t *testing.T) {
+	id := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	assetID := "dd0f697463174c0ca57800847f8559d7"
+	video := model.Video{ID: id, Asset: &model.Asset{ID: assetID}}
+
+	ready := `{"type":"video.asset.ready","id":"e1","data":{"id":"dd0f697463174c0ca57800847f8559d7","status":"ready","duration":23.8,"playback_ids":[{"id":"pb1","policy":"signed"}]}}`
+	deleted := `{"type":"video.asset.deleted","id":"e2","data":{"id":"dd0f697463174c0ca57800847f8559d7","status":"ready","playback_ids":[{"id":"pb1","policy":"signed"}]}}`
+	ignored := `{"type":"video.upload.created","id":"e3","data":{"id":"up1"}}`
+	preparing := `{"type":"video.asset.static_renditions.preparing","id":"e4","data":{"id":"dd0f697463174c0ca57800847f8559d7","status":"ready","static_renditions":{"status":"preparing"}}}`
+
+	type mockReturns struct {
+		getResp    model.Video
+		getErr     error
+		updateWith model.Asset
+		updateErr  error
+	}
+
+	tests := []struct {
+		name      string
+		payload   string
+		signature string
+		mocks     *mockReturns
+		err       error
+	}{
+		{
+			name:      "Asset ready",
+			payload:   ready,
+			signature: sign(webhookSecret, time.Now(), ready),
+			mocks: &mockReturns{
+				getResp: video,
+				updateWith: model.Asset{
+					ID:          assetID,
+					Status:      "ready",
+					Duration:    23.8,
+					PlaybackIDs: []model.PlaybackID{{ID: "pb1", Policy: "signed"}},
+				},
+			},
+		},
+		{
+			name:      "Asset deleted",
+			payload:   deleted,
+			signature: sign(webhookSecret, time.Now(), deleted),
+			mocks: &mockReturns{
+				getResp:    video,
+				updateWith: model.Asset{ID: assetID, Status: "deleted"},
+			},
+		},
+		{
+			name:      "Static renditions preparing",
+			payload:   preparing,
+			signature: sign(webhookSecret, time.Now(), preparing),
+			mocks: &mockReturns{
+				getResp: video,
+				updateWith: model.Asset{
+					ID:               assetID,
+					Status:           "ready",
+					StaticRenditions: &model.StaticRenditions{Status: "preparing"},
+				},
+			},
+		},
+		{
+			name:      "Unknown asset",
+			payload:   ready,
+			signature: sign(webhookSecret, time.Now(), ready),
+			mocks: &mockReturns{
+				getErr: errorcodes.ErrVideoNotFound,
+			},
+		},
+		{
+			name:      "Repository error",
+			payload:   ready,
+			signature: sign(webhookSecret, time.Now(), ready),
+			mocks: &mockReturns{
+				getErr: errors.New("db error"),
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:      "Update error",
+			payload:   deleted,
+			signature: sign(webhookSecret, time.Now(), deleted),
+			mocks: &mockReturns{
+				getResp:    video,
+				updateWith: model.Asset{ID: assetID, Status: "deleted"},
+				updateErr:  errors.New("db error"),
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:      "Ignored event",
+			payload:   ignored,
+			signature: sign(webhookSecret, time.Now(), ignored),
+		},
+		{
+			name:      "Wrong secret",
+			payload:   ready,
+			signature: sign("other", time.Now(), ready),
+			err:       errorcodes.ErrInvalidSignature,
+		},
+		{
+			name:      "Expired signature",
+			payload:   ready,
+			signature: sign(webhookSecret, time.Now().Add(-time.Hour), ready),
+			err:       errorcodes.ErrInvalidSignature,
+		},
+		{
+			name:      "Missing signature",
+			payload:   ready,
+			signature: "",
+			err:       errorcodes.ErrInvalidSignature,
+		},
+		{
+			name:      "Malformed payload",
+			payload:   `{"type":`,
+			signature: sign(webhookSecret, time.Now(), `{"type":`),
+			err:       errorcodes.ErrVideoUnprocessable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &notifications{
+				videos,
+				NewMockUploads(t),
+				NewMockCaptions(t),
+				NewMockStreams(t),
+				webhookSecret,
+				testNamespace,
+				testLogger,
+			}
+
+			ctx := context.Background()
+
+			if tt.mocks != nil {
+				videos.On("GetByAssetID", ctx, assetID).Return(tt.mocks.getResp, tt.mocks.getErr)
+				if tt.mocks.getErr == nil {
+					videos.On("UpdateAsset", ctx, id, mock.MatchedBy(func(asset model.Asset) bool {
+						return assert.ObjectsAreEqual(tt.mocks.updateWith, asset)
+					})).Return(video, tt.mocks.updateErr)
+				}
+			}
+
+			err := usecase.Receive(ctx, []byte(tt.payload), tt.signature)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+		})
+	}
+}
+
+func TestNotifications_ReceiveUpload(t *testing.T) {
+	id := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	uploadID := "OA02dANZ67tOl1e6EVpn02OE"
+	assetID := "dd0f697463174c0ca57800847f8559d7"
+	record := model.Upload{ID: uploadID, VideoID: id, Status: model.UploadWaiting}
+
+	created := `{"type":"video.upload.asset_created","id":"e1","data":{"id":"OA02dANZ67tOl1e6EVpn02OE","status":"asset_created","asset_id":"dd0f697463174c0ca57800847f8559d7","new_asset_settings":{"passthrough":"idlemux:4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"}}}`
+	cancelled := `{"type":"video.upload.cancelled","id":"e2","data":{"id":"OA02dANZ67tOl1e6EVpn02OE","status":"cancelled"}}`
+	ready := `{"type":"video.asset.ready","id":"e3","data":{"id":"dd0f697463174c0ca57800847f8559d7","status":"ready","passthrough":"idlemux:4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"}}`
+	missing := `{"type":"video.upload.errored","id":"e4","data":{"status":"errored"}}`
+
+	tests := []struct {
+		name    string
+		payload string
+		mocks   func(ctx context.Context, videos *MockVideos, uploads *MockUploads)
+		err     error
+	}{
+		{
+			name:    "Asset created links the video",
+			payload: created,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				uploads.On("GetUpload", ctx, uploadID).Return(record, nil)
+				videos.On("GetByIDWithTrashed", ctx, id).Return(model.Video{ID: id}, nil)
+				videos.On("UpdateAsset", ctx, id, model.Asset{ID: assetID, Status: "preparing"}).Return(model.Video{ID: id}, nil)
+				uploads.On("UpdateUpload", ctx, uploadID, model.UploadAssetCreated, assetID).Return(record, nil)
+			},
+		},
+		{
+			name:    "Asset created after the asset event",
+			payload: created,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				uploads.On("GetUpload", ctx, uploadID).Return(record, nil)
+				videos.On("GetByIDWithTrashed", ctx, id).Return(model.Video{ID: id, Asset: &model.Asset{ID: assetID}}, nil)
+				uploads.On("UpdateUpload", ctx, uploadID, model.UploadAssetCreated, assetID).Return(record, nil)
+			},
+		},
+		{
+			name:    "Upload cancelled",
+			payload: cancelled,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				uploads.On("GetUpload", ctx, uploadID).Return(record, nil)
+				uploads.On("UpdateUpload", ctx, uploadID, model.UploadCancelled, "").Return(record, nil)
+			},
+		},
+		{
+			name:    "Unknown upload",
+			payload: cancelled,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				uploads.On("GetUpload", ctx, uploadID).Return(model.Upload{}, errorcodes.ErrUploadNotFound)
+			},
+		},
+		{
+			name:    "Repository error",
+			payload: created,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				uploads.On("GetUpload", ctx, uploadID).Return(model.Upload{}, errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:    "Update error",
+			payload: cancelled,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				uploads.On("GetUpload", ctx, uploadID).Return(record, nil)
+				uploads.On("UpdateUpload", ctx, uploadID, model.UploadCancelled, "").Return(model.Upload{}, errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:    "Asset ready before the upload event",
+			payload: ready,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				videos.On("GetByIDWithTrashed", ctx, id).Return(model.Video{ID: id}, nil)
+				videos.On("UpdateAsset", ctx, id, model.Asset{ID: assetID, Status: "ready", Passthrough: testNamespace + ":" + id}).Return(model.Video{ID: id}, nil)
+			},
+		},
+		{
+			name:    "Asset ready for a video trashed while uploading",
+			payload: ready,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				videos.On("GetByIDWithTrashed", ctx, id).Return(model.Video{ID: id, DeletedAt: "2024-05-01 10:00:00 +0000 UTC"}, nil)
+				videos.On("UpdateAsset", ctx, id, model.Asset{ID: assetID, Status: "ready", Passthrough: testNamespace + ":" + id}).Return(model.Video{ID: id}, nil)
+			},
+		},
+		{
+			name:    "Asset of another deployment",
+			payload: `{"type":"video.asset.ready","id":"e5","data":{"id":"dd0f697463174c0ca57800847f8559d7","status":"ready","passthrough":"staging:4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"}}`,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+		},
+		{
+			name:    "Passthrough of a video with another asset",
+			payload: ready,
+			mocks: func(ctx context.Context, videos *MockVideos, uploads *MockUploads) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				videos.On("GetByIDWithTrashed", ctx, id).Return(model.Video{ID: id, Asset: &model.Asset{ID: "other"}}, nil)
+			},
+		},
+		{
+			name:    "Missing upload ID",
+			payload: missing,
+			err:     errorcodes.ErrVideoUnprocessable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			uploads := NewMockUploads(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &notifications{
+				videos,
+				uploads,
+				NewMockCaptions(t),
+				NewMockStreams(t),
+				webhookSecret,
+				testNamespace,
+				testLogger,
+			}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, videos, uploads)
+			}
+
+			err := usecase.Receive(ctx, []byte(tt.payload), sign(webhookSecret, time.Now(), tt.payload))
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+		})
+	}
+}
+
+func TestNotifications_ReceiveTrack(t *testing.T) {
+	id := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	assetID := "dd0f697463174c0ca57800847f8559d7"
+	captionID := "8d7f1c1e-3a0b-4b8e-9f59-1f6c3c1d2e4a"
+	video := model.Video{ID: id, Asset: &model.Asset{ID: assetID}}
+
+	ready := `{"type":"video.asset.track.ready","id":"e1","data":{"id":"en","asset_id":"dd0f697463174c0ca57800847f8559d7","type":"text","text_type":"subtitles","status":"ready","language_code":"en","passthrough":"8d7f1c1e-3a0b-4b8e-9f59-1f6c3c1d2e4a"}}`
+	generated := `{"type":"video.asset.track.created","id":"e2","data":{"id":"auto","asset_id":"dd0f697463174c0ca57800847f8559d7","type":"text","text_source":"generated_vod","status":"preparing","language_code":"en"}}`
+	deleted := `{"type":"video.asset.track.deleted","id":"e3","data":{"id":"en","asset_id":"dd0f697463174c0ca57800847f8559d7","type":"text"}}`
+	missing := `{"type":"video.asset.track.ready","id":"e4","data":{"id":"en"}}`
+
+	tests := []struct {
+		name    string
+		payload string
+		mocks   func(ctx context.Context, videos *MockVideos, captions *MockCaptions)
+		err     error
+	}{
+		{
+			name:    "Ready track removes the uploaded caption",
+			payload: ready,
+			mocks: func(ctx context.Context, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByAssetID", ctx, assetID).Return(video, nil)
+				videos.On("SaveTrack", ctx, id, model.Track{
+					ID:           "en",
+					Type:         "text",
+					TextType:     "subtitles",
+					Status:       "ready",
+					LanguageCode: "en",
+					Passthrough:  captionID,
+				}).Return(nil)
+				captions.On("DeleteCaption", ctx, captionID).Return(nil)
+			},
+		},
+		{
+			name:    "Generated track is stored",
+			payload: generated,
+			mocks: func(ctx context.Context, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByAssetID", ctx, assetID).Return(video, nil)
+				videos.On("SaveTrack", ctx, id, model.Track{
+					ID:           "auto",
+					Type:         "text",
+					TextSource:   "generated_vod",
+					Status:       "preparing",
+					LanguageCode: "en",
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "Deleted track is removed",
+			payload: deleted,
+			mocks: func(ctx context.Context, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByAssetID", ctx, assetID).Return(video, nil)
+				videos.On("RemoveTrack", ctx, id, "en").Return(nil)
+			},
+		},
+		{
+			name:    "Unknown asset",
+			payload: deleted,
+			mocks: func(ctx context.Context, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+		},
+		{
+			name:    "Update error",
+			payload: deleted,
+			mocks: func(ctx context.Context, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByAssetID", ctx, assetID).Return(video, nil)
+				videos.On("RemoveTrack", ctx, id, "en").Return(errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:    "Missing asset ID",
+			payload: missing,
+			err:     errorcodes.ErrVideoUnprocessable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			captions := NewMockCaptions(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &notifications{
+				videos,
+				NewMockUploads(t),
+				captions,
+				NewMockStreams(t),
+				webhookSecret,
+				testNamespace,
+				testLogger,
+			}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, videos, captions)
+			}
+
+			err := usecase.Receive(ctx, []byte(tt.payload), sign(webhookSecret, time.Now(), tt.payload))
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+		})
+	}
+}
+
+func TestNotifications_ReceiveLiveStream(t *testing.T) {
+	id := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	assetID := "rec0f697463174c0ca57800847f8559d7"
+	streamID := "ls1"
+	stream := model.LiveStream{ID: streamID, Title: "Weekly webinar", Description: "Britpop 101", Policy: "signed"}
+
+	ready := `{"type":"video.asset.ready","id":"e1","data":{"id":"rec0f697463174c0ca57800847f8559d7","status":"ready","created_at":"1792195200","live_stream_id":"ls1","playback_ids":[{"id":"pb1","policy":"signed"}]}}`
+	completed := `{"type":"video.asset.live_stream_completed","id":"e2","data":{"id":"rec0f697463174c0ca57800847f8559d7","status":"ready","duration":3600.5,"live_stream_id":"ls1"}}`
+	errored := `{"type":"video.asset.errored","id":"e3","data":{"id":"rec0f697463174c0ca57800847f8559d7","status":"errored","live_stream_id":"ls1"}}`
+	active := `{"type":"video.live_stream.active","id":"e4","data":{"id":"ls1","status":"active"}}`
+	missing := `{"type":"video.live_stream.idle","id":"e5","data":{"status":"idle"}}`
+	idle := `{"type":"video.live_stream.idle","id":"e6","data":{"id":"ls1","status":"idle"}}`
+
+	recording := model.Asset{
+		ID:           assetID,
+		Status:       "ready",
+		CreatedAt:    "1792195200",
+		LiveStreamID: streamID,
+		PlaybackIDs:  []model.PlaybackID{{ID: "pb1", Policy: "signed"}},
+	}
+
+	tests := []struct {
+		name    string
+		payload string
+		mocks   func(ctx context.Context, videos *MockVideos, streams *MockStreams)
+		err     error
+	}{
+		{
+			name:    "Recording ready",
+			payload: ready,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				streams.On("GetStream", ctx, streamID).Return(stream, nil)
+				videos.On("CreateForAsset", ctx, model.Video{
+					Title:        "Weekly webinar (2026-10-17)",
+					Description:  "Britpop 101",
+					Policy:       "signed",
+					LiveStreamID: streamID,
+					Asset:        &recording,
+				}).Return(model.Video{ID: id}, nil)
+			},
+		},
+		{
+			name:    "Recording ready with a numeric creation time",
+			payload: `{"type":"video.asset.ready","id":"e6","data":{"id":"rec0f697463174c0ca57800847f8559d7","status":"ready","created_at":1792195200,"live_stream_id":"ls1","playback_ids":[{"id":"pb1","policy":"signed"}]}}`,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				streams.On("GetStream", ctx, streamID).Return(stream, nil)
+				videos.On("CreateForAsset", ctx, model.Video{
+					Title:        "Weekly webinar (2026-10-17)",
+					Description:  "Britpop 101",
+					Policy:       "signed",
+					LiveStreamID: streamID,
+					Asset:        &recording,
+				}).Return(model.Video{ID: id}, nil)
+			},
+		},
+		{
+			name:    "Recording of an unknown live stream",
+			payload: ready,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				streams.On("GetStream", ctx, streamID).Return(model.LiveStream{}, errorcodes.ErrLiveStreamNotFound)
+			},
+		},
+		{
+			name:    "Recording create error",
+			payload: ready,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				streams.On("GetStream", ctx, streamID).Return(stream, nil)
+				videos.On("CreateForAsset", ctx, mock.Anything).Return(model.Video{}, errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:    "Broadcast completed",
+			payload: completed,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{ID: id}, nil)
+				videos.On("UpdateAsset", ctx, id, model.Asset{
+					ID:           assetID,
+					Status:       "ready",
+					Duration:     3600.5,
+					LiveStreamID: streamID,
+				}).Return(model.Video{ID: id}, nil)
+			},
+		},
+		{
+			name:    "Errored recording is not stored",
+			payload: errored,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+		},
+		{
+			name:    "Live stream active",
+			payload: active,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				streams.On("UpdateStream", ctx, streamID, "active").Return(stream, nil)
+			},
+		},
+		{
+			name:    "Idle after disable keeps the live stream disabled",
+			payload: idle,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				disabled := stream
+				disabled.Status = model.LiveStreamDisabled
+				streams.On("UpdateStream", ctx, streamID, "idle").Return(disabled, nil)
+			},
+		},
+		{
+			name:    "Unknown live stream",
+			payload: active,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				streams.On("UpdateStream", ctx, streamID, "active").Return(model.LiveStream{}, errorcodes.ErrLiveStreamNotFound)
+			},
+		},
+		{
+			name:    "Live stream update error",
+			payload: active,
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				streams.On("UpdateStream", ctx, streamID, "active").Return(model.LiveStream{}, errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:    "Missing live stream ID",
+			payload: missing,
+			err:     errorcodes.ErrVideoUnprocessable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			streams := NewMockStreams(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &notifications{
+				videos,
+				NewMockUploads(t),
+				NewMockCaptions(t),
+				streams,
+				webhookSecret,
+				testNamespace,
+				testLogger,
+			}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, videos, streams)
+			}
+
+			err := usecase.Receive(ctx, []byte(tt.payload), sign(webhookSecret, time.Now(), tt.payload))
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+		})
+	}
+}
+
+func TestNotifications_ReceiveRecordingEvents(t *testing.T) {
+	id := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	assetID := "rec0f697463174c0ca57800847f8559d7"
+	streamID := "ls1"
+	stream := model.LiveStream{ID: streamID, Title: "Weekly webinar", Policy: "signed"}
+	recording := model.Video{ID: id, LiveStreamID: streamID, Asset: &model.Asset{ID: assetID}}
+
+	ready := `{"type":"video.asset.ready","id":"e1","data":{"id":"rec0f697463174c0ca57800847f8559d7","status":"ready","live_stream_id":"ls1"}}`
+	completed := `{"type":"video.asset.live_stream_completed","id":"e2","data":{"id":"rec0f697463174c0ca57800847f8559d7","status":"ready","duration":3600.5,"live_stream_id":"ls1"}}`
+
+	tests := []struct {
+		name  string
+		mocks func(ctx context.Context, videos *MockVideos, streams *MockStreams)
+	}{
+		{
+			name: "Second event updates the recording",
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound).Once()
+				streams.On("GetStream", ctx, streamID).Return(stream, nil).Once()
+				videos.On("CreateForAsset", ctx, mock.Anything).Return(recording, nil).Once()
+				videos.On("GetByAssetID", ctx, assetID).Return(recording, nil).Once()
+				videos.On("UpdateAsset", ctx, id, mock.Anything).Return(recording, nil).Once()
+			},
+		},
+		{
+			name: "Concurrent events keep a single recording",
+			mocks: func(ctx context.Context, videos *MockVideos, streams *MockStreams) {
+				// Both lookups run before either video is stored
+				videos.On("GetByAssetID", ctx, assetID).Return(model.Video{}, errorcodes.ErrVideoNotFound).Twice()
+				streams.On("GetStream", ctx, streamID).Return(stream, nil).Twice()
+				videos.On("CreateForAsset", ctx, mock.Anything).Return(recording, nil).Twice()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			videos := NewMockVideos(t)
+			streams := NewMockStreams(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &notifications{
+				videos,
+				NewMockUploads(t),
+				NewMockCaptions(t),
+				streams,
+				webhookSecret,
+				testNamespace,
+				testLogger,
+			}
+
+			ctx := context.Background()
+			tt.mocks(ctx, videos, streams)
+
+			for _, payload := range []string{ready, completed} {
+				err := usecase.Receive(ctx, []byte(payload), sign(webhookSecret, time.Now(), payload))
+
+				assert.NoError(t, err, "Got unexpected error")
+			}
+
+			videos.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// passthrough returns the passthrough of an asset created for the video, the
+// namespace tells apart the deployments sharing a Mux.com environment
+func passthrough(namespace, videoID string) string {
+	return namespace + ":" + videoID
+}
+
+// passthroughVideoID returns the video ID carried by the passthrough of an
+// asset created by the deployment with the namespace. Assets of other apps or
+// deployments, and those created before namespaces, report false.
+func passthroughVideoID(namespace, passthrough string) (string, bool) {
+	id, found := strings.CutPrefix(passthrough, namespace+":")
+	if !found {
+		return "", false
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		return "", false
+	}
+
+	return id, true
+}
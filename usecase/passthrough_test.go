@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testNamespace is the passthrough namespace of the assets in the tests
+const testNamespace = "idlemux"
+
+func TestPassthroughVideoID(t *testing.T) {
+	id := "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+
+	tests := []struct {
+		name        string
+		passthrough string
+		want        string
+		ok          bool
+	}{
+		{"Created by the deployment", passthrough(testNamespace, id), id, true},
+		{"Another deployment", passthrough("staging", id), "", false},
+		{"Without namespace", id, "", false},
+		{"Not a video ID", passthrough(testNamespace, "created in the dashboard"), "", false},
+		{"Empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := passthroughVideoID(testNamespace, tt.passthrough)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
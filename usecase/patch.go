@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// errPatchNotObject is returned when a merge patch is not a JSON object
+var errPatchNotObject = errors.New("merge patch must be a JSON object")
+
+// mergePatch applies a JSON Merge Patch (RFC 7396) to a JSON document
+func mergePatch(document, patch []byte) ([]byte, error) {
+	var target map[string]interface{}
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+
+	var changes interface{}
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return nil, err
+	}
+
+	object, ok := changes.(map[string]interface{})
+	if !ok {
+		return nil, errPatchNotObject
+	}
+
+	return json.Marshal(mergeObject(target, object))
+}
+
+// mergeObject merges patch into target, null values remove members
+func mergeObject(target, patch map[string]interface{}) map[string]interface{} {
+	if target == nil {
+		target = map[string]interface{}{}
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+
+		if object, ok := value.(map[string]interface{}); ok {
+			current, _ := target[key].(map[string]interface{})
+			target[key] = mergeObject(current, object)
+			continue
+		}
+
+		target[key] = value
+	}
+
+	return target
+}
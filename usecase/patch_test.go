@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMergePatch covers the examples from RFC 7396 appendix A
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		patch    string
+		want     string
+		wantErr  bool
+	}{
+		{"Replace member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`, false},
+		{"Add member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`, false},
+		{"Remove member", `{"a":"b"}`, `{"a":null}`, `{}`, false},
+		{"Replace array", `{"a":["b"]}`, `{"a":["c","d"]}`, `{"a":["c","d"]}`, false},
+		{"Nested object", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`, false},
+		{"Object into scalar", `{"a":"b"}`, `{"a":{"c":"d"}}`, `{"a":{"c":"d"}}`, false},
+		{"Patch is not an object", `{"a":"b"}`, `["c"]`, ``, true},
+		{"Malformed patch", `{"a":"b"}`, `{"a":`, ``, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergePatch([]byte(tt.document), []byte(tt.patch))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// purgeBatch is the number of documents handled on each pass
+const purgeBatch = 100
+
+type purger struct {
+	assets    Assets
+	videos    Videos
+	cleanups  Cleanups
+	retention time.Duration
+	logger    *logrus.Logger
+}
+
+// Purger returns the usecase implementation
+func Purger(
+	a Assets,
+	v Videos,
+	c Cleanups,
+	retention time.Duration,
+	l *logrus.Logger,
+) purger {
+	return purger{
+		assets:    a,
+		videos:    v,
+		cleanups:  c,
+		retention: retention,
+		logger:    l,
+	}
+}
+
+// Run purges on every interval until the context is done
+func (u purger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.Purge(ctx); err != nil {
+			u.logger.WithError(err).Error("purge failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge hard deletes the videos trashed longer than the retention,
+// and retries the pending Mux asset cleanups
+func (u purger) Purge(ctx context.Context) error {
+	before := time.Now().Add(-u.retention)
+
+	expired, err := u.videos.ListPurgeable(ctx, before, purgeBatch)
+	if err != nil {
+		return err
+	}
+
+	for _, video := range expired {
+		deleted, err := u.videos.Delete(ctx, video.ID)
+		if err != nil {
+			if err == errorcodes.ErrVideoNotFound {
+				continue
+			}
+			return err
+		}
+
+		if deleted.Asset != nil && len(deleted.Asset.ID) > 0 {
+			removeAsset(ctx, u.assets, u.cleanups, u.logger, deleted.ID, deleted.Asset.ID)
+		}
+	}
+
+	pending, err := u.cleanups.ListCleanups(ctx, purgeBatch)
+	if err != nil {
+		return err
+	}
+
+	for _, cleanup := range pending {
+		err := u.assets.Delete(ctx, cleanup.AssetID)
+		if err != nil && err != errorcodes.ErrAssetNotFound {
+			if err := u.cleanups.RetryCleanup(ctx, cleanup.ID, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := u.cleanups.DeleteCleanup(ctx, cleanup.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeAsset deletes a Mux asset, recording a pending cleanup when Mux fails
+// so the billable asset is not leaked once the document is gone
+func removeAsset(ctx context.Context, assets Assets, cleanups Cleanups, logger *logrus.Logger, videoID, assetID string) {
+	err := assets.Delete(ctx, assetID)
+	if err == nil || err == errorcodes.ErrAssetNotFound {
+		return
+	}
+
+	logger.WithError(err).WithField("asset_id", assetID).Error("asset deletion failed, recording cleanup")
+
+	_, err = cleanups.CreateCleanup(ctx, model.Cleanup{
+		AssetID:  assetID,
+		VideoID:  videoID,
+		Reason:   err.Error(),
+		Attempts: 1,
+	})
+	if err != nil {
+		logger.WithError(err).WithField("asset_id", assetID).Error("error recording cleanup")
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// TestPurger tests the Purger constructor function
+func TestPurger(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	assets := NewMockAssets(t)
+	videos := NewMockVideos(t)
+	cleanups := NewMockCleanups(t)
+
+	usecase := Purger(assets, videos, cleanups, time.Hour, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, assets, usecase.assets)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, cleanups, usecase.cleanups)
+	assert.Equal(t, time.Hour, usecase.retention)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestPurger_Purge(t *testing.T) {
+	id := "bc7acb34-a7e6-4eac-87bf-8d01ad06b330"
+	assetID := "dd0f697463174c0ca57800847f8559d7"
+	expired := model.Video{ID: id, Asset: &model.Asset{ID: assetID}}
+
+	t.Run("Purges expired videos and retries cleanups", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		cleanups := NewMockCleanups(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &purger{assets, videos, cleanups, time.Hour, testLogger}
+		ctx := context.Background()
+
+		videos.On("ListPurgeable", ctx, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) >= time.Hour
+		}), purgeBatch).Return([]model.Video{expired}, nil)
+		videos.On("Delete", ctx, id).Return(expired, nil)
+		assets.On("Delete", ctx, assetID).Return(errors.New("mux unavailable"))
+		cleanups.On("CreateCleanup", ctx, mock.AnythingOfType("model.Cleanup")).Return(model.Cleanup{}, nil)
+
+		cleanups.On("ListCleanups", ctx, purgeBatch).Return([]model.Cleanup{
+			{ID: "c1", AssetID: "gone"},
+			{ID: "c2", AssetID: "deleted"},
+			{ID: "c3", AssetID: "failing"},
+		}, nil)
+		assets.On("Delete", ctx, "gone").Return(errorcodes.ErrAssetNotFound)
+		assets.On("Delete", ctx, "deleted").Return(nil)
+		assets.On("Delete", ctx, "failing").Return(errors.New("mux unavailable"))
+		cleanups.On("DeleteCleanup", ctx, "c1").Return(nil)
+		cleanups.On("DeleteCleanup", ctx, "c2").Return(nil)
+		cleanups.On("RetryCleanup", ctx, "c3", "mux unavailable").Return(nil)
+
+		assert.NoError(t, usecase.Purge(ctx))
+	})
+
+	t.Run("Already purged", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		videos := NewMockVideos(t)
+		cleanups := NewMockCleanups(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &purger{assets, videos, cleanups, time.Hour, testLogger}
+		ctx := context.Background()
+
+		videos.On("ListPurgeable", ctx, mock.Anything, purgeBatch).Return([]model.Video{expired}, nil)
+		videos.On("Delete", ctx, id).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+		cleanups.On("ListCleanups", ctx, purgeBatch).Return(nil, nil)
+
+		assert.NoError(t, usecase.Purge(ctx))
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		videos := NewMockVideos(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &purger{NewMockAssets(t), videos, NewMockCleanups(t), time.Hour, testLogger}
+		ctx := context.Background()
+
+		videos.On("ListPurgeable", ctx, mock.Anything, purgeBatch).Return(nil, errors.New("db error"))
+
+		assert.EqualError(t, usecase.Purge(ctx), "db error")
+	})
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// reconcileBatch is the number of assets and videos read on each page
+const reconcileBatch = 100
+
+type reconciler struct {
+	assets    Assets
+	videos    Videos
+	cleanups  Cleanups
+	namespace string
+	logger    *logrus.Logger
+}
+
+// Reconciler returns the usecase implementation
+func Reconciler(
+	a Assets,
+	v Videos,
+	c Cleanups,
+	ns string,
+	l *logrus.Logger,
+) reconciler {
+	return reconciler{
+		assets:    a,
+		videos:    v,
+		cleanups:  c,
+		namespace: ns,
+		logger:    l,
+	}
+}
+
+// Run compares the Mux.com assets with the videos linking them. It reports
+// the assets no video links, the videos whose asset is missing or errored and
+// the videos whose policy differs from the one of their asset. With fix, the
+// orphaned assets are deleted and the videos store the state of their asset.
+// Unlinked assets not created for a video of the deployment are reported as
+// foreign and kept.
+func (u reconciler) Run(ctx context.Context, fix bool) (model.ReconcileReport, error) {
+	report := model.ReconcileReport{
+		OrphanedAssets:   []model.Mismatch{},
+		ForeignAssets:    []model.Mismatch{},
+		MissingAssets:    []model.Mismatch{},
+		ErroredAssets:    []model.Mismatch{},
+		PolicyMismatches: []model.Mismatch{},
+	}
+
+	// Every asset is listed before anything is deleted, deletions would shift the pages
+	var listed []model.Asset
+	assets := make(map[string]model.Asset)
+	for page := 1; ; page++ {
+		list, err := u.assets.List(ctx, page, reconcileBatch)
+		if err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return report, err
+		}
+
+		for _, asset := range list {
+			assets[asset.ID] = asset
+		}
+		listed = append(listed, list...)
+		report.Assets += len(list)
+
+		if len(list) < reconcileBatch {
+			break
+		}
+	}
+
+	after := ""
+	for {
+		videos, err := u.videos.ListLinked(ctx, after, reconcileBatch)
+		if err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return report, err
+		}
+
+		if len(videos) == 0 {
+			break
+		}
+
+		for _, video := range videos {
+			after = video.ID
+			report.Videos++
+
+			asset, found := assets[video.Asset.ID]
+			delete(assets, video.Asset.ID)
+
+			u.video(ctx, &report, video, asset, found, fix)
+		}
+	}
+
+	// The assets still in the map are linked by no video, trashed or not
+	now := time.Now()
+	for _, asset := range listed {
+		if _, unlinked := assets[asset.ID]; !unlinked || recent(asset, now) || len(asset.LiveStreamID) > 0 {
+			// A job or an upload may still link it, a live stream recording is
+			// linked by its webhook and never removed here
+			continue
+		}
+
+		mismatch := model.Mismatch{
+			AssetID: asset.ID,
+			Detail:  fmt.Sprintf("asset %s, passthrough %q", asset.Status, asset.Passthrough),
+		}
+
+		// Other apps or deployments may share the Mux.com environment
+		videoID, ok := passthroughVideoID(u.namespace, asset.Passthrough)
+		if !ok {
+			report.ForeignAssets = append(report.ForeignAssets, mismatch)
+			continue
+		}
+
+		if fix {
+			// A failed deletion is recorded as a cleanup and retried by the purger
+			removeAsset(ctx, u.assets, u.cleanups, u.logger, videoID, asset.ID)
+			mismatch.Fixed = true
+			report.Fixed++
+		}
+
+		report.OrphanedAssets = append(report.OrphanedAssets, mismatch)
+	}
+
+	return report, nil
+}
+
+// video compares a video with its asset and records the mismatch found
+func (u reconciler) video(
+	ctx context.Context,
+	report *model.ReconcileReport,
+	video model.Video,
+	asset model.Asset,
+	found bool,
+	fix bool,
+) {
+	if !found {
+		// The asset may have been created after the listing
+		var err error
+		asset, err = u.assets.GetByID(ctx, video.Asset.ID)
+		if err == errorcodes.ErrAssetNotFound {
+			asset = model.Asset{ID: video.Asset.ID, Status: "deleted"}
+		} else if err != nil {
+			u.logger.WithError(err).WithField("video_id", video.ID).Error("error retrieving asset")
+			report.Failed++
+			return
+		}
+	}
+
+	var list *[]model.Mismatch
+	var detail string
+	// A broken video already marked is reported, but not updated again
+	marked := video.Asset.Status == asset.Status
+	switch {
+	case asset.Status == "deleted":
+		list = &report.MissingAssets
+		detail = "asset not found"
+	case asset.Status == "errored":
+		list = &report.ErroredAssets
+		detail = "asset errored"
+	case len(asset.PlaybackIDs) > 0 && asset.PlaybackIDs[0].Policy != video.Policy:
+		list = &report.PolicyMismatches
+		detail = fmt.Sprintf("video %s, asset %s", video.Policy, asset.PlaybackIDs[0].Policy)
+		marked = false
+	default:
+		return
+	}
+
+	mismatch := model.Mismatch{
+		VideoID: video.ID,
+		AssetID: asset.ID,
+		Detail:  detail,
+	}
+
+	if fix && !marked {
+		if _, err := u.videos.UpdateAsset(ctx, video.ID, asset); err != nil {
+			u.logger.WithError(err).WithField("video_id", video.ID).Error("error updating video")
+			report.Failed++
+		} else {
+			mismatch.Fixed = true
+			report.Fixed++
+		}
+	}
+
+	*list = append(*list, mismatch)
+}
+
+// recent reports whether an asset was created within the orphan grace period
+func recent(asset model.Asset, now time.Time) bool {
+	// Mux.com reports the creation time in Unix seconds
+	created, err := strconv.ParseInt(asset.CreatedAt, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return now.Sub(time.Unix(created, 0)) < orphanGrace
+}
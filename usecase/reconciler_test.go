@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// TestReconciler tests the Reconciler constructor function
+func TestReconciler(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	assets := NewMockAssets(t)
+	videos := NewMockVideos(t)
+	cleanups := NewMockCleanups(t)
+
+	usecase := Reconciler(assets, videos, cleanups, testNamespace, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, assets, usecase.assets)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, cleanups, usecase.cleanups)
+	assert.Equal(t, testNamespace, usecase.namespace)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestReconciler_Run(t *testing.T) {
+	old := strconv.FormatInt(time.Now().Add(-2*orphanGrace).Unix(), 10)
+	fresh := strconv.FormatInt(time.Now().Unix(), 10)
+
+	ready := model.Asset{
+		ID:          "ready-asset",
+		Status:      "ready",
+		CreatedAt:   old,
+		PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "signed"}},
+	}
+	public := model.Asset{
+		ID:          "public-asset",
+		Status:      "ready",
+		CreatedAt:   old,
+		PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "public"}},
+	}
+	errored := model.Asset{ID: "errored-asset", Status: "errored", CreatedAt: old}
+	orphan := model.Asset{ID: "orphan-asset", Status: "ready", CreatedAt: old, Passthrough: testNamespace + ":" + firstID}
+	foreign := model.Asset{ID: "foreign-asset", Status: "ready", CreatedAt: old, Passthrough: "created in the dashboard"}
+	staging := model.Asset{ID: "staging-asset", Status: "ready", CreatedAt: old, Passthrough: "staging:" + firstID}
+	pending := model.Asset{ID: "pending-asset", Status: "preparing", CreatedAt: fresh}
+	recording := model.Asset{ID: "recording-asset", Status: "preparing", CreatedAt: old, LiveStreamID: "stream"}
+	missing := model.Asset{ID: "missing-asset", Status: "deleted"}
+
+	videos := []model.Video{
+		{ID: "a", Policy: "signed", Asset: &model.Asset{ID: "ready-asset", Status: "ready"}},
+		{ID: "b", Policy: "signed", Asset: &model.Asset{ID: "public-asset", Status: "ready"}},
+		{ID: "c", Policy: "signed", Asset: &model.Asset{ID: "errored-asset", Status: "preparing"}},
+		{ID: "d", Policy: "signed", Asset: &model.Asset{ID: "missing-asset", Status: "ready"}},
+		{ID: "e", Policy: "signed", Asset: &model.Asset{ID: "marked-asset", Status: "deleted"}},
+	}
+
+	setup := func(assets *MockAssets, repository *MockVideos, ctx context.Context) {
+		assets.On("List", ctx, 1, reconcileBatch).Return([]model.Asset{ready, public, errored, orphan, foreign, staging, pending, recording}, nil)
+		repository.On("ListLinked", ctx, "", reconcileBatch).Return(videos, nil)
+		repository.On("ListLinked", ctx, "e", reconcileBatch).Return([]model.Video{}, nil)
+		assets.On("GetByID", ctx, "missing-asset").Return(model.Asset{}, errorcodes.ErrAssetNotFound)
+		assets.On("GetByID", ctx, "marked-asset").Return(model.Asset{}, errorcodes.ErrAssetNotFound)
+	}
+
+	t.Run("Reports without fixing", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		repository := NewMockVideos(t)
+		cleanups := NewMockCleanups(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &reconciler{assets, repository, cleanups, testNamespace, testLogger}
+		ctx := context.Background()
+
+		setup(assets, repository, ctx)
+
+		report, err := usecase.Run(ctx, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ReconcileReport{
+			Assets: 8,
+			Videos: 5,
+			OrphanedAssets: []model.Mismatch{
+				{AssetID: "orphan-asset", Detail: `asset ready, passthrough "idlemux:bc7acb34-a7e6-4eac-87bf-8d01ad06b330"`},
+			},
+			ForeignAssets: []model.Mismatch{
+				{AssetID: "foreign-asset", Detail: `asset ready, passthrough "created in the dashboard"`},
+				{AssetID: "staging-asset", Detail: `asset ready, passthrough "staging:bc7acb34-a7e6-4eac-87bf-8d01ad06b330"`},
+			},
+			MissingAssets: []model.Mismatch{
+				{VideoID: "d", AssetID: "missing-asset", Detail: "asset not found"},
+				{VideoID: "e", AssetID: "marked-asset", Detail: "asset not found"},
+			},
+			ErroredAssets: []model.Mismatch{
+				{VideoID: "c", AssetID: "errored-asset", Detail: "asset errored"},
+			},
+			PolicyMismatches: []model.Mismatch{
+				{VideoID: "b", AssetID: "public-asset", Detail: "video signed, asset public"},
+			},
+		}, report)
+	})
+
+	t.Run("Fixes orphans and broken videos", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		repository := NewMockVideos(t)
+		cleanups := NewMockCleanups(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &reconciler{assets, repository, cleanups, testNamespace, testLogger}
+		ctx := context.Background()
+
+		setup(assets, repository, ctx)
+		repository.On("UpdateAsset", ctx, "b", public).Return(model.Video{}, nil)
+		repository.On("UpdateAsset", ctx, "c", errored).Return(model.Video{}, errors.New("connection refused"))
+		repository.On("UpdateAsset", ctx, "d", missing).Return(model.Video{}, nil)
+		assets.On("Delete", ctx, "orphan-asset").Return(nil)
+
+		report, err := usecase.Run(ctx, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Fixed)
+		assert.Equal(t, 1, report.Failed)
+		assert.True(t, report.OrphanedAssets[0].Fixed)
+		assert.False(t, report.ForeignAssets[0].Fixed, "Foreign assets are never deleted")
+		assert.False(t, report.ForeignAssets[1].Fixed, "Assets of other deployments are never deleted")
+		assert.True(t, report.PolicyMismatches[0].Fixed)
+		assert.False(t, report.ErroredAssets[0].Fixed)
+		assert.True(t, report.MissingAssets[0].Fixed)
+		assert.False(t, report.MissingAssets[1].Fixed)
+	})
+
+	t.Run("Asset lookup error", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		repository := NewMockVideos(t)
+		cleanups := NewMockCleanups(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &reconciler{assets, repository, cleanups, testNamespace, testLogger}
+		ctx := context.Background()
+
+		assets.On("List", ctx, 1, reconcileBatch).Return([]model.Asset{}, nil)
+		repository.On("ListLinked", ctx, "", reconcileBatch).Return([]model.Video{videos[0]}, nil)
+		repository.On("ListLinked", ctx, "a", reconcileBatch).Return([]model.Video{}, nil)
+		assets.On("GetByID", ctx, "ready-asset").Return(model.Asset{}, errors.New("mux unavailable"))
+
+		report, err := usecase.Run(ctx, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Failed)
+		assert.Empty(t, report.MissingAssets)
+	})
+
+	t.Run("Mux.com error", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		repository := NewMockVideos(t)
+		cleanups := NewMockCleanups(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &reconciler{assets, repository, cleanups, testNamespace, testLogger}
+		ctx := context.Background()
+
+		assets.On("List", ctx, 1, reconcileBatch).Return(nil, errors.New("mux unavailable"))
+
+		_, err := usecase.Run(ctx, false)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		assets := NewMockAssets(t)
+		repository := NewMockVideos(t)
+		cleanups := NewMockCleanups(t)
+
+		testLogger := logrus.New()
+		testLogger.Out = io.Discard
+
+		usecase := &reconciler{assets, repository, cleanups, testNamespace, testLogger}
+		ctx := context.Background()
+
+		assets.On("List", ctx, 1, reconcileBatch).Return([]model.Asset{}, nil)
+		repository.On("ListLinked", ctx, "", reconcileBatch).Return(nil, errors.New("connection refused"))
+
+		_, err := usecase.Run(ctx, false)
+
+		assert.Error(t, err)
+	})
+}
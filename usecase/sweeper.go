@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	orphanGrace = time.Hour // age of an unlinked asset before it is an orphan, jobs and uploads link theirs sooner
+	sweepBatch  = 100       // number of Mux.com assets listed on each page
+	sweepLease  = "sweeper" // name of the lease held by the replica that sweeps
+)
+
+type sweeper struct {
+	assets    Assets
+	videos    Videos
+	cleanups  Cleanups
+	leases    Leases
+	holder    string
+	namespace string
+	logger    *logrus.Logger
+}
+
+// Sweeper returns the usecase implementation, each instance holds the lease
+// under its own ID
+func Sweeper(
+	a Assets,
+	v Videos,
+	c Cleanups,
+	ls Leases,
+	ns string,
+	l *logrus.Logger,
+) sweeper {
+	return sweeper{
+		assets:    a,
+		videos:    v,
+		cleanups:  c,
+		leases:    ls,
+		holder:    uuid.New().String(),
+		namespace: ns,
+		logger:    l,
+	}
+}
+
+// Run sweeps the orphaned assets on every interval until the context is done,
+// only the replica holding the lease sweeps
+func (u sweeper) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			u.sweepLeased(ctx, interval)
+		}
+	}
+}
+
+// sweepLeased sweeps when the lease is claimed for this replica, it is held
+// until the next interval. It returns the number of assets removed.
+func (u sweeper) sweepLeased(ctx context.Context, lease time.Duration) int {
+	claimed, err := u.leases.ClaimLease(ctx, sweepLease, u.holder, lease)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return 0
+	}
+
+	if !claimed {
+		u.logger.Debug("another replica is sweeping orphaned assets")
+		return 0
+	}
+
+	return u.Sweep(ctx)
+}
+
+// Sweep deletes the Mux.com assets created for a video that never linked
+// them, e.g. when the service stopped between creating the asset and storing
+// it. It returns the number of assets removed.
+func (u sweeper) Sweep(ctx context.Context) int {
+	var removed int
+	now := time.Now()
+
+	// Removed assets shift the later pages, those skipped are found by the next sweep
+	for page := 1; ; page++ {
+		assets, err := u.assets.List(ctx, page, sweepBatch)
+		if err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return removed
+		}
+
+		for _, asset := range assets {
+			videoID, orphaned := u.orphaned(ctx, asset, now)
+			if !orphaned {
+				continue
+			}
+
+			u.logger.WithFields(logrus.Fields{
+				"asset_id": asset.ID,
+				"video_id": videoID,
+			}).Warn("removing orphaned asset")
+
+			removeAsset(ctx, u.assets, u.cleanups, u.logger, videoID, asset.ID)
+			removed++
+		}
+
+		if len(assets) < sweepBatch {
+			return removed
+		}
+	}
+}
+
+// orphaned reports whether an asset was created for a video by this
+// deployment, is past the grace period and no video, trashed or not, links
+// it. The ID of the video it was created for is returned.
+func (u sweeper) orphaned(ctx context.Context, asset model.Asset, now time.Time) (string, bool) {
+	videoID, ok := passthroughVideoID(u.namespace, asset.Passthrough)
+	if !ok {
+		return "", false
+	}
+
+	// Mux.com reports the creation time in Unix seconds
+	created, err := strconv.ParseInt(asset.CreatedAt, 10, 64)
+	if err != nil || now.Sub(time.Unix(created, 0)) < orphanGrace {
+		return "", false
+	}
+
+	_, err = u.videos.GetByAssetID(ctx, asset.ID)
+	if err == errorcodes.ErrVideoNotFound {
+		return videoID, true
+	}
+	if err != nil {
+		u.logger.WithError(err).WithField("asset_id", asset.ID).Error("error checking asset owner")
+	}
+
+	return "", false
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// TestSweeper tests the Sweeper constructor function
+func TestSweeper(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	assets := NewMockAssets(t)
+	videos := NewMockVideos(t)
+	cleanups := NewMockCleanups(t)
+	leases := NewMockLeases(t)
+
+	usecase := Sweeper(assets, videos, cleanups, leases, testNamespace, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, assets, usecase.assets)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, cleanups, usecase.cleanups)
+	assert.Equal(t, leases, usecase.leases)
+	assert.NotEmpty(t, usecase.holder)
+	assert.Equal(t, testNamespace, usecase.namespace)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestSweeper_Sweep(t *testing.T) {
+	old := strconv.FormatInt(time.Now().Add(-2*orphanGrace).Unix(), 10)
+	recent := strconv.FormatInt(time.Now().Unix(), 10)
+
+	orphan := model.Asset{ID: "orphan", Passthrough: testNamespace + ":" + firstID, CreatedAt: old}
+	linked := model.Asset{ID: "linked", Passthrough: testNamespace + ":" + secondID, CreatedAt: old}
+	linking := model.Asset{ID: "linking", Passthrough: testNamespace + ":" + thirdID, CreatedAt: recent}
+	foreign := model.Asset{ID: "foreign", Passthrough: "created in the dashboard", CreatedAt: old}
+	staging := model.Asset{ID: "staging", Passthrough: "staging:" + firstID, CreatedAt: old}
+	legacy := model.Asset{ID: "legacy", Passthrough: firstID, CreatedAt: old}
+	unknown := model.Asset{ID: "unknown", Passthrough: testNamespace + ":" + secondID, CreatedAt: old}
+
+	tests := []struct {
+		name  string
+		mocks func(ctx context.Context, assets *MockAssets, videos *MockVideos, cleanups *MockCleanups)
+		want  int
+	}{
+		{
+			name: "Only orphans are removed",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, cleanups *MockCleanups) {
+				assets.On("List", ctx, 1, sweepBatch).Return([]model.Asset{orphan, linked, linking, foreign, staging, legacy}, nil)
+				videos.On("GetByAssetID", ctx, "orphan").Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				videos.On("GetByAssetID", ctx, "linked").Return(model.Video{ID: secondID}, nil)
+				assets.On("Delete", ctx, "orphan").Return(nil)
+			},
+			want: 1,
+		},
+		{
+			name: "Every page is swept",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, cleanups *MockCleanups) {
+				full := make([]model.Asset, sweepBatch)
+				for i := range full {
+					full[i] = linking
+				}
+				assets.On("List", ctx, 1, sweepBatch).Return(full, nil)
+				assets.On("List", ctx, 2, sweepBatch).Return([]model.Asset{orphan}, nil)
+				videos.On("GetByAssetID", ctx, "orphan").Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				assets.On("Delete", ctx, "orphan").Return(nil)
+			},
+			want: 1,
+		},
+		{
+			name: "Failed deletion is recorded",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, cleanups *MockCleanups) {
+				assets.On("List", ctx, 1, sweepBatch).Return([]model.Asset{orphan}, nil)
+				videos.On("GetByAssetID", ctx, "orphan").Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				assets.On("Delete", ctx, "orphan").Return(errors.New("mux error"))
+				cleanups.On("CreateCleanup", ctx, model.Cleanup{AssetID: "orphan", VideoID: firstID, Reason: "mux error", Attempts: 1}).Return(model.Cleanup{}, nil)
+			},
+			want: 1,
+		},
+		{
+			name: "Repository error keeps the asset",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, cleanups *MockCleanups) {
+				assets.On("List", ctx, 1, sweepBatch).Return([]model.Asset{unknown}, nil)
+				videos.On("GetByAssetID", ctx, "unknown").Return(model.Video{}, errors.New("db error"))
+			},
+		},
+		{
+			name: "Mux.com error",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, cleanups *MockCleanups) {
+				assets.On("List", ctx, 1, sweepBatch).Return(nil, errors.New("mux error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+			cleanups := NewMockCleanups(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &sweeper{assets, videos, cleanups, NewMockLeases(t), firstID, testNamespace, testLogger}
+
+			ctx := context.Background()
+			tt.mocks(ctx, assets, videos, cleanups)
+
+			assert.Equal(t, tt.want, usecase.Sweep(ctx))
+		})
+	}
+}
+
+func TestSweeper_SweepLeased(t *testing.T) {
+	tests := []struct {
+		name     string
+		claimed  bool
+		claimErr error
+		sweeps   bool
+	}{
+		{"Lease claimed sweeps", true, nil, true},
+		{"Lease held by another replica", false, nil, false},
+		{"Lease error", false, errors.New("db error"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			leases := NewMockLeases(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &sweeper{assets, NewMockVideos(t), NewMockCleanups(t), leases, firstID, testNamespace, testLogger}
+
+			ctx := context.Background()
+			leases.On("ClaimLease", ctx, sweepLease, firstID, time.Hour).Return(tt.claimed, tt.claimErr)
+			if tt.sweeps {
+				assets.On("List", ctx, 1, sweepBatch).Return([]model.Asset{}, nil)
+			}
+
+			assert.Equal(t, 0, usecase.sweepLeased(ctx, time.Hour))
+		})
+	}
+}
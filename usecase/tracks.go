@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	maxCaptionSize  = 1 << 20 // largest uploaded caption file, in bytes
+	maxTrackName    = 64      // longest track name, in characters
+	maxTrackURL     = 2048    // longest caption file URL, in bytes
+	captionsPath    = "/captions/"
+	captionsFileExt = ".vtt"
+	maxAudioInputs  = 10 // most alternate audio files ingested with a video
+)
+
+type tracks struct {
+	assets    Assets
+	videos    Videos
+	captions  Captions
+	publicURL string
+	logger    *logrus.Logger
+}
+
+// Tracks returns the usecase implementation, publicURL is the address Mux.com
+// downloads the uploaded caption files from
+func Tracks(
+	a Assets,
+	v Videos,
+	c Captions,
+	publicURL string,
+	l *logrus.Logger,
+) tracks {
+	return tracks{
+		assets:    a,
+		videos:    v,
+		captions:  c,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		logger:    l,
+	}
+}
+
+// Create method adds a caption, subtitle or audio track to the asset of a
+// video. Mux.com downloads the file from the URL of the request, uploaded
+// content is converted to WebVTT and served to Mux.com until the track is ready.
+func (u tracks) Create(ctx context.Context, id string, request model.TrackRequest) (model.Track, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Track{}, errorcodes.ErrInvalidID
+	}
+
+	if err := u.validateTrackRequest(request); err != nil {
+		return model.Track{}, err
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Track{}, err
+	}
+
+	if video.Asset == nil {
+		return model.Track{}, errorcodes.ErrNoPlayback
+	}
+
+	// Mux.com only adds tracks to an asset done preparing
+	if video.Asset.Status != "ready" {
+		return model.Track{}, errorcodes.ErrAssetNotReady
+	}
+
+	track := model.Track{
+		Type:           model.TrackText,
+		LanguageCode:   request.LanguageCode,
+		Name:           request.Name,
+		ClosedCaptions: request.ClosedCaptions,
+	}
+	if request.Type == model.TrackAudio {
+		track.Type = model.TrackAudio
+	}
+
+	fileURL := request.URL
+	if len(request.Content) > 0 {
+		content, err := toVTT(request.Content)
+		if err != nil {
+			return model.Track{}, err
+		}
+
+		caption, err := u.captions.CreateCaption(ctx, model.Caption{VideoID: id, Content: content})
+		if err != nil {
+			u.logger.WithError(err).Error(err.Error())
+			return model.Track{}, err
+		}
+
+		// The caption ID travels as passthrough, so the file is removed once the track is ready
+		fileURL = u.publicURL + captionsPath + caption.ID + captionsFileExt
+		track.Passthrough = caption.ID
+	}
+
+	response, err := u.assets.CreateTrack(ctx, video.Asset.ID, fileURL, track)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		u.removeCaption(ctx, track.Passthrough)
+		return model.Track{}, err
+	}
+
+	// The track webhooks store it as well, a failure here is repaired by them
+	if err := u.videos.SaveTrack(ctx, id, response); err != nil {
+		u.logger.WithError(err).WithField("video_id", id).Error("error storing track")
+	}
+
+	return response, nil
+}
+
+// List method returns the caption, subtitle and audio tracks stored on a
+// video, nothing is signed. The WebVTT URLs come with the video itself.
+func (u tracks) List(ctx context.Context, id string) ([]model.Track, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, errorcodes.ErrInvalidID
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return nil, err
+	}
+
+	if video.Asset == nil {
+		return []model.Track{}, nil
+	}
+
+	response := playbackTracks(video.Asset.Tracks)
+	if response == nil {
+		return []model.Track{}, nil
+	}
+
+	return response, nil
+}
+
+// Delete method removes a caption, subtitle or audio track from the asset of a
+// video. The primary audio track comes from the video file and is kept.
+func (u tracks) Delete(ctx context.Context, id, trackID string) error {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return errorcodes.ErrInvalidID
+	}
+
+	video, err := u.videos.GetByID(ctx, id)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	if video.Asset == nil {
+		return errorcodes.ErrTrackNotFound
+	}
+
+	var track *model.Track
+	for i, t := range video.Asset.Tracks {
+		if t.ID == trackID && (t.Type == model.TrackText || (t.Type == model.TrackAudio && !t.Primary)) {
+			track = &video.Asset.Tracks[i]
+		}
+	}
+	if track == nil {
+		return errorcodes.ErrTrackNotFound
+	}
+
+	// A track already gone from Mux.com is only removed from the video
+	err = u.assets.DeleteTrack(ctx, video.Asset.ID, trackID)
+	if err != nil && err != errorcodes.ErrTrackNotFound {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	if err := u.videos.RemoveTrack(ctx, id, trackID); err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return err
+	}
+
+	u.removeCaption(ctx, track.Passthrough)
+
+	return nil
+}
+
+// Caption method returns an uploaded caption file
+func (u tracks) Caption(ctx context.Context, id string) (model.Caption, error) {
+	// Validate UUID format
+	if _, err := uuid.Parse(id); err != nil {
+		return model.Caption{}, errorcodes.ErrInvalidID
+	}
+
+	caption, err := u.captions.GetCaption(ctx, id)
+	if err != nil {
+		if err != errorcodes.ErrCaptionNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.Caption{}, err
+	}
+
+	return caption, nil
+}
+
+// removeCaption deletes the uploaded caption file of a track, if any
+func (u tracks) removeCaption(ctx context.Context, id string) {
+	if _, err := uuid.Parse(id); err != nil {
+		return
+	}
+
+	if err := u.captions.DeleteCaption(ctx, id); err != nil {
+		u.logger.WithError(err).WithField("caption_id", id).Error("error deleting caption")
+	}
+}
+
+// validateTrackRequest checks that the request carries either a file URL or
+// its content, and the language of the track. Audio files are only downloaded
+// from a URL.
+func (u tracks) validateTrackRequest(request model.TrackRequest) error {
+	if (len(request.URL) > 0) == (len(request.Content) > 0) {
+		return errorcodes.ErrInvalidTrack
+	}
+
+	switch request.Type {
+	case "", model.TrackText:
+	case model.TrackAudio:
+		if len(request.Content) > 0 || request.ClosedCaptions {
+			return errorcodes.ErrInvalidTrack
+		}
+	default:
+		return errorcodes.ErrInvalidTrack
+	}
+
+	if !languageCode.MatchString(request.LanguageCode) {
+		return errorcodes.ErrInvalidTrack
+	}
+
+	if utf8.RuneCountInString(request.Name) > maxTrackName {
+		return errorcodes.ErrInvalidTrack
+	}
+
+	if len(request.URL) > 0 && !validFileURL(request.URL) {
+		return errorcodes.ErrInvalidTrack
+	}
+
+	if len(request.Content) > 0 {
+		// Uploaded files are served to Mux.com from the public URL
+		if len(u.publicURL) == 0 {
+			u.logger.Warn("caption uploads need PUBLIC_URL")
+			return errorcodes.ErrInvalidTrack
+		}
+
+		if len(request.Content) > maxCaptionSize {
+			return errorcodes.ErrInvalidTrack
+		}
+	}
+
+	return nil
+}
+
+// validateAudioInputs checks the alternate audio files ingested with a video,
+// each needs its URL and language
+func validateAudioInputs(inputs []model.AudioInput) error {
+	if len(inputs) > maxAudioInputs {
+		return errorcodes.ErrVideoUnprocessable
+	}
+
+	for _, input := range inputs {
+		if !validFileURL(input.URL) || !languageCode.MatchString(input.LanguageCode) ||
+			utf8.RuneCountInString(input.Name) > maxTrackName {
+			return errorcodes.ErrVideoUnprocessable
+		}
+	}
+
+	return nil
+}
+
+// validFileURL reports whether Mux.com can download a track file from the URL
+func validFileURL(raw string) bool {
+	if len(raw) > maxTrackURL {
+		return false
+	}
+
+	parsed, err := url.Parse(raw)
+
+	return err == nil && parsed.Host != "" && (parsed.Scheme == "http" || parsed.Scheme == "https")
+}
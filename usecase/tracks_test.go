@@ -0,0 +1,509 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	trackVideoID = "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	captionID    = "8d7f1c1e-3a0b-4b8e-9f59-1f6c3c1d2e4a"
+	publicURL    = "https://idlemux.example.com"
+)
+
+// TestTracks tests the Tracks constructor function
+func TestTracks(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	assets := NewMockAssets(t)
+	videos := NewMockVideos(t)
+	captions := NewMockCaptions(t)
+
+	usecase := Tracks(assets, videos, captions, publicURL+"/", logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, assets, usecase.assets)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, captions, usecase.captions)
+	assert.Equal(t, publicURL, usecase.publicURL)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestTracks_Create(t *testing.T) {
+	video := model.Video{ID: trackVideoID, Asset: &model.Asset{ID: "asset", Status: "ready"}}
+	srt := "1\n00:00:01,000 --> 00:00:04,000\nWonderwall\n"
+	vtt := "WEBVTT\n\n1\n00:00:01.000 --> 00:00:04.000\nWonderwall\n"
+	created := model.Track{ID: "track", Type: "text", TextType: "subtitles", Status: "preparing", LanguageCode: "en", Name: "English"}
+
+	tests := []struct {
+		name      string
+		id        string
+		request   model.TrackRequest
+		publicURL string
+		mocks     func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions)
+		want      model.Track
+		err       error
+	}{
+		{
+			name:    "Track from a URL",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "en", Name: "English", ClosedCaptions: true},
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("CreateTrack", ctx, "asset", "https://example.com/en.vtt", model.Track{Type: "text", LanguageCode: "en", Name: "English", ClosedCaptions: true}).Return(created, nil)
+				videos.On("SaveTrack", ctx, trackVideoID, created).Return(nil)
+			},
+			want: created,
+		},
+		{
+			name:    "Audio track from a URL",
+			id:      trackVideoID,
+			request: model.TrackRequest{Type: "audio", URL: "https://example.com/es.m4a", LanguageCode: "es", Name: "Español"},
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("CreateTrack", ctx, "asset", "https://example.com/es.m4a", model.Track{Type: "audio", LanguageCode: "es", Name: "Español"}).Return(created, nil)
+				videos.On("SaveTrack", ctx, trackVideoID, created).Return(nil)
+			},
+			want: created,
+		},
+		{
+			name:      "Uploaded SRT is converted and served",
+			id:        trackVideoID,
+			request:   model.TrackRequest{Content: srt, LanguageCode: "en"},
+			publicURL: publicURL,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				captions.On("CreateCaption", ctx, model.Caption{VideoID: trackVideoID, Content: vtt}).Return(model.Caption{ID: captionID}, nil)
+				assets.On("CreateTrack", ctx, "asset", publicURL+"/captions/"+captionID+".vtt", model.Track{Type: "text", LanguageCode: "en", Passthrough: captionID}).Return(created, nil)
+				videos.On("SaveTrack", ctx, trackVideoID, created).Return(nil)
+			},
+			want: created,
+		},
+		{
+			name:    "Store error is repaired by the webhooks",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "en"},
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("CreateTrack", ctx, "asset", "https://example.com/en.vtt", mock.Anything).Return(created, nil)
+				videos.On("SaveTrack", ctx, trackVideoID, created).Return(errors.New("db error"))
+			},
+			want: created,
+		},
+		{
+			name:      "Mux.com error removes the caption",
+			id:        trackVideoID,
+			request:   model.TrackRequest{Content: srt, LanguageCode: "en"},
+			publicURL: publicURL,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				captions.On("CreateCaption", ctx, mock.Anything).Return(model.Caption{ID: captionID}, nil)
+				assets.On("CreateTrack", ctx, "asset", mock.Anything, mock.Anything).Return(model.Track{}, errors.New("mux error"))
+				captions.On("DeleteCaption", ctx, captionID).Return(nil)
+			},
+			err: errors.New("mux error"),
+		},
+		{
+			name:      "Invalid caption file",
+			id:        trackVideoID,
+			request:   model.TrackRequest{Content: "Wonderwall", LanguageCode: "en"},
+			publicURL: publicURL,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+			},
+			err: errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Upload without a public URL",
+			id:      trackVideoID,
+			request: model.TrackRequest{Content: srt, LanguageCode: "en"},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:      "Caption file too large",
+			id:        trackVideoID,
+			request:   model.TrackRequest{Content: strings.Repeat("a", maxCaptionSize+1), LanguageCode: "en"},
+			publicURL: publicURL,
+			err:       errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "URL and content",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", Content: srt, LanguageCode: "en"},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:      "Audio track from content",
+			id:        trackVideoID,
+			request:   model.TrackRequest{Type: "audio", Content: srt, LanguageCode: "es"},
+			publicURL: publicURL,
+			err:       errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Closed captions audio track",
+			id:      trackVideoID,
+			request: model.TrackRequest{Type: "audio", URL: "https://example.com/es.m4a", LanguageCode: "es", ClosedCaptions: true},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Unknown track type",
+			id:      trackVideoID,
+			request: model.TrackRequest{Type: "video", URL: "https://example.com/es.mp4", LanguageCode: "es"},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Neither URL nor content",
+			id:      trackVideoID,
+			request: model.TrackRequest{LanguageCode: "en"},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Invalid language code",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "English"},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Invalid URL",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "file:///etc/en.vtt", LanguageCode: "en"},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Name too long",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "en", Name: strings.Repeat("a", maxTrackName+1)},
+			err:     errorcodes.ErrInvalidTrack,
+		},
+		{
+			name:    "Asset not ready",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "en"},
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{ID: trackVideoID, Asset: &model.Asset{ID: "asset", Status: "preparing"}}, nil)
+			},
+			err: errorcodes.ErrAssetNotReady,
+		},
+		{
+			name:    "Video without asset",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "en"},
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{ID: trackVideoID}, nil)
+			},
+			err: errorcodes.ErrNoPlayback,
+		},
+		{
+			name:    "Not found",
+			id:      trackVideoID,
+			request: model.TrackRequest{URL: "https://example.com/en.vtt", LanguageCode: "en"},
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+			err: errorcodes.ErrVideoNotFound,
+		},
+		{
+			name: "Invalid ID",
+			id:   "123",
+			err:  errorcodes.ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+			captions := NewMockCaptions(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &tracks{assets, videos, captions, tt.publicURL, testLogger}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, assets, videos, captions)
+			}
+
+			got, err := usecase.Create(ctx, tt.id, tt.request)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
+
+func TestTracks_List(t *testing.T) {
+	video := model.Track{ID: "video-track", Type: "video"}
+	english := model.Track{ID: "en", Type: "text", Status: "ready", LanguageCode: "en"}
+	spanish := model.Track{ID: "es", Type: "audio", Status: "ready", LanguageCode: "es"}
+	hydrated := english
+	hydrated.URL = "https://stream.mux.com/playback/text/en.vtt"
+	asset := model.Asset{
+		ID:          "asset",
+		Status:      "ready",
+		PlaybackIDs: []model.PlaybackID{{ID: "playback", Policy: "public"}},
+		Tracks:      []model.Track{video, english, spanish},
+	}
+
+	tests := []struct {
+		name  string
+		id    string
+		mocks func(ctx context.Context, assets *MockAssets, videos *MockVideos)
+		want  []model.Track
+		err   error
+	}{
+		{
+			name: "Text and audio tracks are listed",
+			id:   trackVideoID,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{ID: trackVideoID, Asset: &asset}, nil)
+			},
+			want: []model.Track{english, spanish},
+		},
+		{
+			name: "Asset without tracks",
+			id:   trackVideoID,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{ID: trackVideoID, Asset: &model.Asset{ID: "asset"}}, nil)
+			},
+			want: []model.Track{},
+		},
+		{
+			name: "Video without asset",
+			id:   trackVideoID,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{ID: trackVideoID}, nil)
+			},
+			want: []model.Track{},
+		},
+		{
+			name: "Not found",
+			id:   trackVideoID,
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+			err: errorcodes.ErrVideoNotFound,
+		},
+		{
+			name: "Invalid ID",
+			id:   "123",
+			err:  errorcodes.ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &tracks{assets, videos, NewMockCaptions(t), publicURL, testLogger}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, assets, videos)
+			}
+
+			got, err := usecase.List(ctx, tt.id)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
+
+func TestTracks_Delete(t *testing.T) {
+	uploaded := model.Track{ID: "en", Type: "text", Passthrough: captionID}
+	generated := model.Track{ID: "auto", Type: "text", TextSource: "generated_vod"}
+	primary := model.Track{ID: "primary", Type: "audio", Primary: true}
+	spanish := model.Track{ID: "es", Type: "audio"}
+	video := model.Video{ID: trackVideoID, Asset: &model.Asset{ID: "asset", Tracks: []model.Track{uploaded, generated, primary, spanish}}}
+
+	tests := []struct {
+		name    string
+		trackID string
+		mocks   func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions)
+		err     error
+	}{
+		{
+			name:    "Uploaded track and its caption are removed",
+			trackID: "en",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("DeleteTrack", ctx, "asset", "en").Return(nil)
+				videos.On("RemoveTrack", ctx, trackVideoID, "en").Return(nil)
+				captions.On("DeleteCaption", ctx, captionID).Return(nil)
+			},
+		},
+		{
+			name:    "Track gone from Mux.com",
+			trackID: "auto",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("DeleteTrack", ctx, "asset", "auto").Return(errorcodes.ErrTrackNotFound)
+				videos.On("RemoveTrack", ctx, trackVideoID, "auto").Return(nil)
+			},
+		},
+		{
+			name:    "Mux.com error",
+			trackID: "auto",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("DeleteTrack", ctx, "asset", "auto").Return(errors.New("mux error"))
+			},
+			err: errors.New("mux error"),
+		},
+		{
+			name:    "Repository error",
+			trackID: "auto",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("DeleteTrack", ctx, "asset", "auto").Return(nil)
+				videos.On("RemoveTrack", ctx, trackVideoID, "auto").Return(errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+		{
+			name:    "Alternate audio track is removed",
+			trackID: "es",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+				assets.On("DeleteTrack", ctx, "asset", "es").Return(nil)
+				videos.On("RemoveTrack", ctx, trackVideoID, "es").Return(nil)
+			},
+		},
+		{
+			name:    "Primary audio track is kept",
+			trackID: "primary",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(video, nil)
+			},
+			err: errorcodes.ErrTrackNotFound,
+		},
+		{
+			name:    "Video without asset",
+			trackID: "en",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{ID: trackVideoID}, nil)
+			},
+			err: errorcodes.ErrTrackNotFound,
+		},
+		{
+			name:    "Not found",
+			trackID: "en",
+			mocks: func(ctx context.Context, assets *MockAssets, videos *MockVideos, captions *MockCaptions) {
+				videos.On("GetByID", ctx, trackVideoID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+			},
+			err: errorcodes.ErrVideoNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := NewMockAssets(t)
+			videos := NewMockVideos(t)
+			captions := NewMockCaptions(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &tracks{assets, videos, captions, publicURL, testLogger}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, assets, videos, captions)
+			}
+
+			err := usecase.Delete(ctx, trackVideoID, tt.trackID)
+
+			if tt.err != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.err.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+		})
+	}
+}
+
+func TestTracks_Caption(t *testing.T) {
+	caption := model.Caption{ID: captionID, VideoID: trackVideoID, Content: "WEBVTT\n"}
+
+	tests := []struct {
+		name  string
+		id    string
+		mocks func(ctx context.Context, captions *MockCaptions)
+		want  model.Caption
+		err   error
+	}{
+		{
+			name: "Success",
+			id:   captionID,
+			mocks: func(ctx context.Context, captions *MockCaptions) {
+				captions.On("GetCaption", ctx, captionID).Return(caption, nil)
+			},
+			want: caption,
+		},
+		{
+			name: "Not found",
+			id:   captionID,
+			mocks: func(ctx context.Context, captions *MockCaptions) {
+				captions.On("GetCaption", ctx, captionID).Return(model.Caption{}, errorcodes.ErrCaptionNotFound)
+			},
+			err: errorcodes.ErrCaptionNotFound,
+		},
+		{
+			name: "Invalid ID",
+			id:   "123",
+			err:  errorcodes.ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captions := NewMockCaptions(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &tracks{NewMockAssets(t), NewMockVideos(t), captions, publicURL, testLogger}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, captions)
+			}
+
+			got, err := usecase.Caption(ctx, tt.id)
+
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err, "Error doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.want, got, "Response doesn't match expected")
+		})
+	}
+}
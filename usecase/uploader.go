@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+// uploadBatch is the number of waiting uploads polled on each run
+const uploadBatch = 100
+
+type uploader struct {
+	directUploads DirectUploads
+	videos        Videos
+	uploads       Uploads
+	namespace     string
+	logger        *logrus.Logger
+}
+
+// Uploader returns the usecase implementation
+func Uploader(
+	d DirectUploads,
+	v Videos,
+	u Uploads,
+	ns string,
+	l *logrus.Logger,
+) uploader {
+	return uploader{
+		directUploads: d,
+		videos:        v,
+		uploads:       u,
+		namespace:     ns,
+		logger:        l,
+	}
+}
+
+// Create method stores the video and returns the URL its source file is uploaded to
+func (u uploader) Create(ctx context.Context, request model.UploadRequest) (model.Upload, error) {
+	anyVideo := request.Video
+	anyVideo.Tags = normalizeTags(anyVideo.Tags)
+	if err := validate(anyVideo); err != nil {
+		return model.Upload{}, err
+	}
+
+	// The source comes from the browser, not from a URL or an existing asset
+	if len(anyVideo.SourceURL) > 0 || anyVideo.Asset != nil {
+		return model.Upload{}, errorcodes.ErrVideoUnprocessable
+	}
+	anyVideo = withoutServerFields(anyVideo)
+
+	var isPublic bool
+	switch anyVideo.Policy {
+	case "public":
+		isPublic = true
+	case "signed":
+		isPublic = false
+	default:
+		return model.Upload{}, errorcodes.ErrVideoUnprocessable
+	}
+
+	video, err := u.videos.Create(ctx, anyVideo)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Upload{}, err
+	}
+
+	// The video ID travels as passthrough, so the asset can be linked back
+	directUpload, err := u.directUploads.CreateDirectUpload(ctx, model.AssetSettings{
+		Public:            isPublic,
+		MP4Support:        video.AllowDownload,
+		GeneratedCaptions: video.GeneratedCaptions,
+		AudioInputs:       video.AudioInputs,
+		Passthrough:       passthrough(u.namespace, video.ID),
+	}, request.CorsOrigin)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		if _, err := u.videos.Delete(ctx, video.ID); err != nil {
+			u.logger.WithError(err).WithField("video_id", video.ID).Error("error removing video of a failed upload")
+		}
+		return model.Upload{}, errorcodes.ErrIngestionFailed
+	}
+
+	directUpload.VideoID = video.ID
+	directUpload.CorsOrigin = request.CorsOrigin
+
+	response, err := u.uploads.CreateUpload(ctx, directUpload)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return model.Upload{}, err
+	}
+
+	return response, nil
+}
+
+// GetByID method returns an upload, a waiting upload is refreshed from Mux.com
+func (u uploader) GetByID(ctx context.Context, id string) (model.Upload, error) {
+	response, err := u.uploads.GetUpload(ctx, id)
+	if err != nil {
+		if err != errorcodes.ErrUploadNotFound {
+			u.logger.WithError(err).Error(err.Error())
+		}
+		return model.Upload{}, err
+	}
+
+	if response.Status != model.UploadWaiting {
+		return response, nil
+	}
+
+	return u.sync(ctx, response)
+}
+
+// Run polls the waiting uploads on every interval until the context is done.
+// It covers the webhooks that never arrive.
+func (u uploader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			u.Sync(ctx)
+		}
+	}
+}
+
+// Sync refreshes the waiting uploads from Mux.com
+func (u uploader) Sync(ctx context.Context) {
+	waiting, err := u.uploads.ListUploads(ctx, model.UploadWaiting, uploadBatch)
+	if err != nil {
+		u.logger.WithError(err).Error(err.Error())
+		return
+	}
+
+	for _, record := range waiting {
+		if _, err := u.sync(ctx, record); err != nil {
+			u.logger.WithError(err).WithField("upload_id", record.ID).Error("error syncing upload")
+		}
+	}
+}
+
+// sync applies the Mux.com state of a waiting upload, the stored one is
+// returned when Mux.com can't be reached
+func (u uploader) sync(ctx context.Context, record model.Upload) (model.Upload, error) {
+	directUpload, err := u.directUploads.GetDirectUpload(ctx, record.ID)
+	if err != nil {
+		u.logger.WithError(err).WithField("upload_id", record.ID).Warn("error retrieving direct upload")
+		return record, nil
+	}
+
+	if directUpload.Status == record.Status {
+		return record, nil
+	}
+
+	return completeUpload(ctx, u.videos, u.uploads, record, directUpload.Status, directUpload.AssetID)
+}
+
+// completeUpload stores the new status of an upload, and links the created
+// asset to the video unless an asset event did it first
+func completeUpload(ctx context.Context, videos Videos, uploads Uploads, record model.Upload, status, assetID string) (model.Upload, error) {
+	if status == model.UploadAssetCreated && len(assetID) > 0 {
+		// A video trashed while uploading is linked too, so restoring it
+		// brings its asset back and the sweeper never sees the asset as orphaned
+		video, err := videos.GetByIDWithTrashed(ctx, record.VideoID)
+		if err != nil && err != errorcodes.ErrVideoNotFound {
+			return model.Upload{}, err
+		}
+
+		if err == nil && video.Asset == nil {
+			asset := model.Asset{ID: assetID, Status: "preparing"}
+			if _, err := videos.UpdateAsset(ctx, record.VideoID, asset); err != nil {
+				return model.Upload{}, err
+			}
+		}
+	}
+
+	return uploads.UpdateUpload(ctx, record.ID, status, assetID)
+}
@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/javiertlopez/idlemux/errorcodes"
+	"github.com/javiertlopez/idlemux/model"
+)
+
+const (
+	uploadID      = "OA02dANZ67tOl1e6EVpn02OE"
+	uploadVideoID = "4e5bf8f2-9c50-4576-b9d4-1d1fd0705885"
+	uploadAssetID = "dd0f697463174c0ca57800847f8559d7"
+	uploadURL     = "https://storage.googleapis.com/video-storage-us-east1-uploads/OA02dANZ67tOl1e6EVpn02OE"
+)
+
+// TestUploader tests the Uploader constructor function
+func TestUploader(t *testing.T) {
+	logger := logrus.New()
+	logger.Out = io.Discard
+	directUploads := NewMockDirectUploads(t)
+	videos := NewMockVideos(t)
+	uploads := NewMockUploads(t)
+
+	usecase := Uploader(directUploads, videos, uploads, testNamespace, logger)
+
+	assert.NotNil(t, usecase)
+	assert.Equal(t, directUploads, usecase.directUploads)
+	assert.Equal(t, videos, usecase.videos)
+	assert.Equal(t, uploads, usecase.uploads)
+	assert.Equal(t, testNamespace, usecase.namespace)
+	assert.Equal(t, logger, usecase.logger)
+}
+
+func TestUploader_Create(t *testing.T) {
+	video := model.Video{Title: "Live Forever", Description: "Oasis", Policy: "signed"}
+	stored := video
+	stored.ID = uploadVideoID
+	settings := model.AssetSettings{Passthrough: testNamespace + ":" + uploadVideoID}
+	direct := model.Upload{ID: uploadID, URL: uploadURL, Status: model.UploadWaiting}
+	record := model.Upload{
+		ID:         uploadID,
+		VideoID:    uploadVideoID,
+		URL:        uploadURL,
+		Status:     model.UploadWaiting,
+		CorsOrigin: "https://example.com",
+	}
+
+	tests := []struct {
+		name      string
+		request   model.UploadRequest
+		mocks     func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads)
+		expected  model.Upload
+		wantedErr error
+	}{
+		{
+			name:    "Success",
+			request: model.UploadRequest{Video: video, CorsOrigin: "https://example.com"},
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				v.On("Create", ctx, video).Return(stored, nil)
+				d.On("CreateDirectUpload", ctx, settings, "https://example.com").Return(direct, nil)
+				u.On("CreateUpload", ctx, record).Return(record, nil)
+			},
+			expected: record,
+		},
+		{
+			name:      "Missing description",
+			request:   model.UploadRequest{Video: model.Video{Title: "Live Forever", Policy: "public"}},
+			wantedErr: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:      "Source URL is not allowed",
+			request:   model.UploadRequest{Video: model.Video{Title: "Live Forever", Description: "Oasis", Policy: "public", SourceURL: "https://example.com/video.mp4"}},
+			wantedErr: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:      "Unknown policy",
+			request:   model.UploadRequest{Video: model.Video{Title: "Live Forever", Description: "Oasis", Policy: "private"}},
+			wantedErr: errorcodes.ErrVideoUnprocessable,
+		},
+		{
+			name:    "Video repository error",
+			request: model.UploadRequest{Video: video},
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				v.On("Create", ctx, video).Return(model.Video{}, errors.New("db error"))
+			},
+			wantedErr: errors.New("db error"),
+		},
+		{
+			name:    "Mux.com error removes the video",
+			request: model.UploadRequest{Video: video},
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				v.On("Create", ctx, video).Return(stored, nil)
+				d.On("CreateDirectUpload", ctx, settings, "").Return(model.Upload{}, errors.New("mux error"))
+				v.On("Delete", ctx, uploadVideoID).Return(stored, nil)
+			},
+			wantedErr: errorcodes.ErrIngestionFailed,
+		},
+		{
+			name:    "Upload repository error",
+			request: model.UploadRequest{Video: video, CorsOrigin: "https://example.com"},
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				v.On("Create", ctx, video).Return(stored, nil)
+				d.On("CreateDirectUpload", ctx, settings, "https://example.com").Return(direct, nil)
+				u.On("CreateUpload", ctx, record).Return(model.Upload{}, errors.New("db error"))
+			},
+			wantedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			directUploads := NewMockDirectUploads(t)
+			videos := NewMockVideos(t)
+			uploads := NewMockUploads(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &uploader{directUploads, videos, uploads, testNamespace, testLogger}
+
+			ctx := context.Background()
+			if tt.mocks != nil {
+				tt.mocks(ctx, directUploads, videos, uploads)
+			}
+
+			got, err := usecase.Create(ctx, tt.request)
+
+			if tt.wantedErr != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.wantedErr.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestUploader_GetByID(t *testing.T) {
+	waiting := model.Upload{ID: uploadID, VideoID: uploadVideoID, Status: model.UploadWaiting}
+	created := model.Upload{ID: uploadID, VideoID: uploadVideoID, Status: model.UploadAssetCreated, AssetID: uploadAssetID}
+
+	tests := []struct {
+		name      string
+		mocks     func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads)
+		expected  model.Upload
+		wantedErr error
+	}{
+		{
+			name: "Completed upload is not refreshed",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(created, nil)
+			},
+			expected: created,
+		},
+		{
+			name: "Still waiting",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(waiting, nil)
+				d.On("GetDirectUpload", ctx, uploadID).Return(model.Upload{ID: uploadID, Status: model.UploadWaiting}, nil)
+			},
+			expected: waiting,
+		},
+		{
+			name: "Asset created links the video",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(waiting, nil)
+				d.On("GetDirectUpload", ctx, uploadID).Return(model.Upload{ID: uploadID, Status: model.UploadAssetCreated, AssetID: uploadAssetID}, nil)
+				v.On("GetByIDWithTrashed", ctx, uploadVideoID).Return(model.Video{ID: uploadVideoID}, nil)
+				v.On("UpdateAsset", ctx, uploadVideoID, model.Asset{ID: uploadAssetID, Status: "preparing"}).Return(model.Video{ID: uploadVideoID}, nil)
+				u.On("UpdateUpload", ctx, uploadID, model.UploadAssetCreated, uploadAssetID).Return(created, nil)
+			},
+			expected: created,
+		},
+		{
+			name: "Trashed video is linked",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(waiting, nil)
+				d.On("GetDirectUpload", ctx, uploadID).Return(model.Upload{ID: uploadID, Status: model.UploadAssetCreated, AssetID: uploadAssetID}, nil)
+				v.On("GetByIDWithTrashed", ctx, uploadVideoID).Return(model.Video{ID: uploadVideoID, DeletedAt: "2024-05-01 10:00:00 +0000 UTC"}, nil)
+				v.On("UpdateAsset", ctx, uploadVideoID, model.Asset{ID: uploadAssetID, Status: "preparing"}).Return(model.Video{ID: uploadVideoID}, nil)
+				u.On("UpdateUpload", ctx, uploadID, model.UploadAssetCreated, uploadAssetID).Return(created, nil)
+			},
+			expected: created,
+		},
+		{
+			name: "Deleted video keeps no asset",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(waiting, nil)
+				d.On("GetDirectUpload", ctx, uploadID).Return(model.Upload{ID: uploadID, Status: model.UploadAssetCreated, AssetID: uploadAssetID}, nil)
+				v.On("GetByIDWithTrashed", ctx, uploadVideoID).Return(model.Video{}, errorcodes.ErrVideoNotFound)
+				u.On("UpdateUpload", ctx, uploadID, model.UploadAssetCreated, uploadAssetID).Return(created, nil)
+			},
+			expected: created,
+		},
+		{
+			name: "Mux.com unavailable returns the stored upload",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(waiting, nil)
+				d.On("GetDirectUpload", ctx, uploadID).Return(model.Upload{}, errors.New("mux error"))
+			},
+			expected: waiting,
+		},
+		{
+			name: "Not found",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(model.Upload{}, errorcodes.ErrUploadNotFound)
+			},
+			wantedErr: errorcodes.ErrUploadNotFound,
+		},
+		{
+			name: "Link error",
+			mocks: func(ctx context.Context, d *MockDirectUploads, v *MockVideos, u *MockUploads) {
+				u.On("GetUpload", ctx, uploadID).Return(waiting, nil)
+				d.On("GetDirectUpload", ctx, uploadID).Return(model.Upload{ID: uploadID, Status: model.UploadAssetCreated, AssetID: uploadAssetID}, nil)
+				v.On("GetByIDWithTrashed", ctx, uploadVideoID).Return(model.Video{}, errors.New("db error"))
+			},
+			wantedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			directUploads := NewMockDirectUploads(t)
+			videos := NewMockVideos(t)
+			uploads := NewMockUploads(t)
+
+			testLogger := logrus.New()
+			testLogger.Out = io.Discard
+
+			usecase := &uploader{directUploads, videos, uploads, testNamespace, testLogger}
+
+			ctx := context.Background()
+			tt.mocks(ctx, directUploads, videos, uploads)
+
+			got, err := usecase.GetByID(ctx, uploadID)
+
+			if tt.wantedErr != nil {
+				assert.Error(t, err, "Expected an error but got none")
+				assert.Equal(t, tt.wantedErr.Error(), err.Error(), "Error message doesn't match")
+				return
+			}
+
+			assert.NoError(t, err, "Got unexpected error")
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestUploader_Sync(t *testing.T) {
+	first := model.Upload{ID: uploadID, VideoID: uploadVideoID, Status: model.UploadWaiting}
+	second := model.Upload{ID: "second", VideoID: firstID, Status: model.UploadWaiting}
+
+	directUploads := NewMockDirectUploads(t)
+	videos := NewMockVideos(t)
+	uploads := NewMockUploads(t)
+
+	testLogger := logrus.New()
+	testLogger.Out = io.Discard
+
+	usecase := &uploader{directUploads, videos, uploads, testNamespace, testLogger}
+	ctx := context.Background()
+
+	uploads.On("ListUploads", ctx, model.UploadWaiting, uploadBatch).Return([]model.Upload{first, second}, nil)
+	// A failing upload doesn't stop the rest
+	directUploads.On("GetDirectUpload", ctx, uploadID).Return(model.Upload{ID: uploadID, Status: model.UploadTimedOut}, nil)
+	uploads.On("UpdateUpload", ctx, uploadID, model.UploadTimedOut, "").Return(model.Upload{}, errors.New("db error"))
+	directUploads.On("GetDirectUpload", ctx, "second").Return(model.Upload{ID: "second", Status: model.UploadCancelled}, nil)
+	uploads.On("UpdateUpload", ctx, "second", model.UploadCancelled, "").Return(model.Upload{}, nil)
+
+	usecase.Sync(ctx)
+}
@@ -2,19 +2,120 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/javiertlopez/idlemux/model"
 )
 
 // Assets interface
 type Assets interface {
-	Create(ctx context.Context, source string, public bool) (model.Asset, error)
+	Chapters(ctx context.Context, asset model.Asset, chapters []model.Chapter, opts model.HydrateOptions) ([]model.Chapter, error)
+	Create(ctx context.Context, source string, settings model.AssetSettings) (model.Asset, error)
+	CreateTrack(ctx context.Context, assetID, url string, track model.Track) (model.Track, error)
+	Delete(ctx context.Context, id string) error
+	DeleteTrack(ctx context.Context, assetID, trackID string) error
+	EnableRenditions(ctx context.Context, id string) (model.Asset, error)
 	GetByID(ctx context.Context, id string) (model.Asset, error)
+	Hydrate(ctx context.Context, asset model.Asset, opts model.HydrateOptions) (model.Asset, error)
+	Image(ctx context.Context, asset model.Asset, opts model.ImageOptions) (model.Image, error)
+	List(ctx context.Context, page, limit int) ([]model.Asset, error)
+	Token(ctx context.Context, asset model.Asset, request model.TokenRequest) (model.PlaybackToken, error)
+}
+
+// DirectUploads interface
+type DirectUploads interface {
+	CreateDirectUpload(ctx context.Context, settings model.AssetSettings, corsOrigin string) (model.Upload, error)
+	GetDirectUpload(ctx context.Context, id string) (model.Upload, error)
+}
+
+// LiveStreams interface
+type LiveStreams interface {
+	CreateLiveStream(ctx context.Context, settings model.AssetSettings) (model.LiveStream, error)
+	DeleteLiveStream(ctx context.Context, id string) error
+	DisableLiveStream(ctx context.Context, id string) error
+	HydrateLiveStream(ctx context.Context, stream model.LiveStream) (model.LiveStream, error)
 }
 
 // Videos interface
 type Videos interface {
 	Create(ctx context.Context, anyVideo model.Video) (model.Video, error)
+	CreateForAsset(ctx context.Context, anyVideo model.Video) (model.Video, error)
+	CreateWithJob(ctx context.Context, anyVideo model.Video, anyJob model.Job) (model.Video, error)
+	Delete(ctx context.Context, id string) (model.Video, error)
 	GetByID(ctx context.Context, id string) (model.Video, error)
-	List(ctx context.Context, page, limit int) ([]model.Video, error)
+	GetByAssetID(ctx context.Context, assetID string) (model.Video, error)
+	GetByIDWithTrashed(ctx context.Context, id string) (model.Video, error)
+	List(ctx context.Context, opts model.ListOptions) (model.VideoList, error)
+	ListByIDs(ctx context.Context, ids []string) ([]model.Video, error)
+	ListIncomplete(ctx context.Context, after string, limit int) ([]model.Video, error)
+	ListLinked(ctx context.Context, after string, limit int) ([]model.Video, error)
+	ListPurgeable(ctx context.Context, before time.Time, limit int) ([]model.Video, error)
+	ListTrash(ctx context.Context, page, limit int) ([]model.Video, error)
+	RemoveTrack(ctx context.Context, id string, trackID string) error
+	Restore(ctx context.Context, id string) (model.Video, error)
+	SaveTrack(ctx context.Context, id string, anyTrack model.Track) error
+	Search(ctx context.Context, query string, limit int) ([]model.SearchHit, error)
+	Trash(ctx context.Context, id string) error
+	Update(ctx context.Context, anyVideo model.Video) (model.Video, error)
+	UpdateAllowDownload(ctx context.Context, id string, allow bool) error
+	UpdateAsset(ctx context.Context, id string, asset model.Asset) (model.Video, error)
+	UpdateIngestion(ctx context.Context, id string, status model.JobStatus) error
+}
+
+// Cleanups interface
+type Cleanups interface {
+	CreateCleanup(ctx context.Context, anyCleanup model.Cleanup) (model.Cleanup, error)
+	DeleteCleanup(ctx context.Context, id string) error
+	ListCleanups(ctx context.Context, limit int) ([]model.Cleanup, error)
+	RetryCleanup(ctx context.Context, id string, reason string) error
+}
+
+// Captions interface
+type Captions interface {
+	CreateCaption(ctx context.Context, anyCaption model.Caption) (model.Caption, error)
+	DeleteCaption(ctx context.Context, id string) error
+	GetCaption(ctx context.Context, id string) (model.Caption, error)
+}
+
+// Playlists interface
+type Playlists interface {
+	CreatePlaylist(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)
+	DeletePlaylist(ctx context.Context, id string) error
+	GetPlaylist(ctx context.Context, id string) (model.Playlist, error)
+	InsertPlaylistVideo(ctx context.Context, id, videoID string, position int) (model.Playlist, error)
+	ListPlaylists(ctx context.Context, page, limit int) ([]model.Playlist, error)
+	RemovePlaylistVideo(ctx context.Context, id, videoID string) (model.Playlist, error)
+	UpdatePlaylist(ctx context.Context, anyPlaylist model.Playlist) (model.Playlist, error)
+}
+
+// Uploads interface
+type Uploads interface {
+	CreateUpload(ctx context.Context, anyUpload model.Upload) (model.Upload, error)
+	GetUpload(ctx context.Context, id string) (model.Upload, error)
+	ListUploads(ctx context.Context, status string, limit int) ([]model.Upload, error)
+	UpdateUpload(ctx context.Context, id, status, assetID string) (model.Upload, error)
+}
+
+// Streams interface
+type Streams interface {
+	CreateStream(ctx context.Context, anyStream model.LiveStream) (model.LiveStream, error)
+	DeleteStream(ctx context.Context, id string) error
+	GetStream(ctx context.Context, id string) (model.LiveStream, error)
+	ListStreams(ctx context.Context, cursor string, limit int) (model.LiveStreamList, error)
+	UpdateStream(ctx context.Context, id, status string) (model.LiveStream, error)
+}
+
+// Leases interface
+type Leases interface {
+	ClaimLease(ctx context.Context, name, holder string, lease time.Duration) (bool, error)
+}
+
+// Jobs interface
+type Jobs interface {
+	ClaimJob(ctx context.Context, lease time.Duration) (model.Job, error)
+	CreateJob(ctx context.Context, anyJob model.Job) (model.Job, error)
+	DeleteJob(ctx context.Context, id string) error
+	FailJob(ctx context.Context, id string, reason string) error
+	RequeueJob(ctx context.Context, videoID string) (model.Job, error)
+	RetryJob(ctx context.Context, id string, reason string, runAt time.Time) error
 }